@@ -24,7 +24,6 @@ import (
 	"math/big"
 
 	"github.com/aaronwinter/celo-blockchain/accounts"
-	"github.com/aaronwinter/celo-blockchain/accounts/external"
 	"github.com/aaronwinter/celo-blockchain/accounts/keystore"
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/core/types"
@@ -116,8 +115,10 @@ func NewKeyedTransactorWithChainId(key *ecdsa.PrivateKey, chainId *big.Int) *Tra
 }
 
 // NewClefTransactor is a utility method to easily create a transaction signer
-// with a clef backend.
-func NewClefTransactor(clef *external.ExternalSigner, account accounts.Account) *TransactOpts {
+// with a clef backend, usually an *external.ExternalSigner. The wallet is not
+// typed as such so that the core contract bindings, used by the node itself,
+// don't depend on the external signer.
+func NewClefTransactor(clef accounts.Wallet, account accounts.Account) *TransactOpts {
 	return &TransactOpts{
 		From: account.Address,
 		Signer: func(signer types.Signer, address common.Address, transaction *types.Transaction) (*types.Transaction, error) {
@@ -39,6 +39,10 @@ var (
 	// This error is returned by WaitDeployed if contract creation leaves an
 	// empty contract behind.
 	ErrNoCodeAfterDeploy = errors.New("no contract code after deployment")
+
+	// This error is raised when attempting to execute a contract method on a
+	// backend that doesn't implement ContractExecutor.
+	ErrNoExecutor = errors.New("backend does not support executing contract methods")
 )
 
 // ContractCaller defines the methods needed to allow operating with contract on a read
@@ -62,6 +66,15 @@ type PendingContractCaller interface {
 	PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error)
 }
 
+// ContractExecutor defines the methods needed to run contract methods directly on
+// the state of the backend, without sending a transaction, as done by the node
+// for system calls. Execute will try to discover this interface.
+type ContractExecutor interface {
+	// ExecuteContract runs a potentially state changing contract call with the
+	// specified data as the input.
+	ExecuteContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error)
+}
+
 // ContractTransactor defines the methods needed to allow operating with contract
 // on a write only basis. Beside the transacting method, the remainder are helpers
 // used when the user does not provide some needed values, but rather leaves it up
@@ -100,6 +113,14 @@ type ContractFilterer interface {
 	SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
 }
 
+// ChainHeadReader defines the methods needed to know the extent of the chain, to
+// page through its logs. FilterLogs and WatchLogs will try to discover it.
+type ChainHeadReader interface {
+	// HeaderByNumber returns the header of the given block, the latest one if
+	// number is nil.
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
 // DeployBackend wraps the operations needed by WaitMined and WaitDeployed.
 type DeployBackend interface {
 	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
@@ -39,6 +39,7 @@ type CallOpts struct {
 	Pending     bool            // Whether to operate on the pending state or the last known one
 	From        common.Address  // Optional the sender address, otherwise the first account is used
 	BlockNumber *big.Int        // Optional the block number on which the call should be performed
+	GasLimit    uint64          // Optional gas limit of the call (0 = backend default)
 	Context     context.Context // Network context to support cancellation and timeouts (nil = no timeout)
 }
 
@@ -63,8 +64,9 @@ type TransactOpts struct {
 // FilterOpts is the collection of options to fine tune filtering for events
 // within a bound contract.
 type FilterOpts struct {
-	Start uint64  // Start of the queried range
-	End   *uint64 // End of the range (nil = latest)
+	Start    uint64  // Start of the queried range
+	End      *uint64 // End of the range (nil = latest)
+	PageSize uint64  // Maximum number of blocks queried at once (0 = whole range)
 
 	Context context.Context // Network context to support cancellation and timeouts (nil = no timeout)
 }
@@ -72,8 +74,9 @@ type FilterOpts struct {
 // WatchOpts is the collection of options to fine tune subscribing for events
 // within a bound contract.
 type WatchOpts struct {
-	Start   *uint64         // Start of the queried range (nil = latest)
-	Context context.Context // Network context to support cancellation and timeouts (nil = no timeout)
+	Start    *uint64         // Start of the queried range (nil = latest)
+	PageSize uint64          // Maximum number of blocks queried at once while catching up from Start (0 = whole range)
+	Context  context.Context // Network context to support cancellation and timeouts (nil = no timeout)
 }
 
 // BoundContract is the base wrapper object that reflects a contract on the
@@ -143,7 +146,7 @@ func (c *BoundContract) Call(opts *CallOpts, result interface{}, method string,
 		return err
 	}
 	var (
-		msg    = ethereum.CallMsg{From: opts.From, To: &c.address, Data: input}
+		msg    = ethereum.CallMsg{From: opts.From, To: &c.address, Gas: opts.GasLimit, Data: input}
 		ctx    = ensureContext(opts.Context)
 		code   []byte
 		output []byte
@@ -179,6 +182,33 @@ func (c *BoundContract) Call(opts *CallOpts, result interface{}, method string,
 	return c.abi.Unpack(result, method, output)
 }
 
+// Execute runs the (paid) contract method with params as input values directly
+// on the state of the backend, without creating a transaction, and sets the
+// output to result. Only backends implementing ContractExecutor support it.
+func (c *BoundContract) Execute(opts *TransactOpts, result interface{}, method string, params ...interface{}) error {
+	executor, ok := c.backend.(ContractExecutor)
+	if !ok {
+		return ErrNoExecutor
+	}
+	// Don't crash on a lazy user
+	if opts == nil {
+		opts = new(TransactOpts)
+	}
+	input, err := c.abi.Pack(method, params...)
+	if err != nil {
+		return err
+	}
+	msg := ethereum.CallMsg{From: opts.From, To: &c.address, Gas: opts.GasLimit, Value: opts.Value, Data: input}
+	output, err := executor.ExecuteContract(ensureContext(opts.Context), msg)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	return c.abi.Unpack(result, method, output)
+}
+
 // Transact invokes the (paid) contract method with params as input values.
 func (c *BoundContract) Transact(opts *TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
 	// Otherwise pack up the parameters and invoke the contract
@@ -351,82 +381,241 @@ func (c *BoundContract) LogEventName(log types.Log) (eventName string, ok bool,
 
 // FilterLogs filters contract logs for past blocks, returning the necessary
 // channels to construct a strongly typed bound iterator on top of them.
+//
+// With a PageSize, the range is queried in pages as the logs are consumed, any
+// failure past the first page being reported through the subscription. The end
+// of the range is resolved upfront if the backend is a ChainHeadReader.
 func (c *BoundContract) FilterLogs(opts *FilterOpts, name string, query ...[]interface{}) (chan types.Log, event.Subscription, error) {
 	// Don't crash on a lazy user
 	if opts == nil {
 		opts = new(FilterOpts)
 	}
-	// Append the event selector to the query parameters and construct the topic set
-	query = append([][]interface{}{{c.abi.Events[name].ID}}, query...)
-
-	topics, err := makeTopics(query...)
+	config, err := c.filterQuery(name, query...)
 	if err != nil {
 		return nil, nil, err
 	}
+	ctx := ensureContext(opts.Context)
+
+	// Resolve the end of the range, required to page through it
+	end := opts.End
+	if end == nil && opts.PageSize > 0 {
+		if reader, ok := chainHeadReader(c.backend); ok {
+			head, err := reader.HeaderByNumber(ctx, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			number := head.Number.Uint64()
+			end = &number
+		}
+	}
 	// Start the background filtering
 	logs := make(chan types.Log, 128)
 
-	config := ethereum.FilterQuery{
-		Addresses: []common.Address{c.address},
-		Topics:    topics,
-		FromBlock: new(big.Int).SetUint64(opts.Start),
-	}
-	if opts.End != nil {
-		config.ToBlock = new(big.Int).SetUint64(*opts.End)
+	if end == nil || opts.PageSize == 0 || *end < opts.Start {
+		config.FromBlock = new(big.Int).SetUint64(opts.Start)
+		if end != nil {
+			config.ToBlock = new(big.Int).SetUint64(*end)
+		}
+		/* TODO(karalabe): Replace the rest of the method below with this when supported
+		sub, err := c.filterer.SubscribeFilterLogs(ensureContext(opts.Context), config, logs)
+		*/
+		buff, err := c.backend.FilterLogs(ctx, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return logs, event.NewSubscription(func(quit <-chan struct{}) error {
+			deliverLogs(buff, logs, quit)
+			return nil
+		}), nil
 	}
-	/* TODO(karalabe): Replace the rest of the method below with this when supported
-	sub, err := c.filterer.SubscribeFilterLogs(ensureContext(opts.Context), config, logs)
-	*/
-	buff, err := c.backend.FilterLogs(ensureContext(opts.Context), config)
+	// Retrieve the first page upfront to report invalid queries directly
+	first, err := c.filterPage(ctx, config, opts.Start, *end, opts.PageSize)
 	if err != nil {
 		return nil, nil, err
 	}
-	sub, err := event.NewSubscription(func(quit <-chan struct{}) error {
-		for _, log := range buff {
-			select {
-			case logs <- log:
-			case <-quit:
-				return nil
-			}
+	return logs, event.NewSubscription(func(quit <-chan struct{}) error {
+		if !deliverLogs(first, logs, quit) {
+			return nil
 		}
-		return nil
+		return c.filterPages(ctx, config, opts.Start+opts.PageSize, *end, opts.PageSize, logs, quit)
 	}), nil
-
-	if err != nil {
-		return nil, nil, err
-	}
-	return logs, sub, nil
 }
 
 // WatchLogs filters subscribes to contract logs for future blocks, returning a
 // subscription object that can be used to tear down the watcher.
+//
+// Logs reverted by chain reorganisations are delivered again with Removed set.
+// With a Start, and if the backend is a ChainHeadReader, the past logs are first
+// retrieved up to the current head (in pages of PageSize blocks) before handing
+// over to the live subscription, the logs of the overlapping blocks being only
+// delivered once. Live logs arriving meanwhile are buffered until the handover.
 func (c *BoundContract) WatchLogs(opts *WatchOpts, name string, query ...[]interface{}) (chan types.Log, event.Subscription, error) {
 	// Don't crash on a lazy user
 	if opts == nil {
 		opts = new(WatchOpts)
 	}
-	// Append the event selector to the query parameters and construct the topic set
-	query = append([][]interface{}{{c.abi.Events[name].ID}}, query...)
-
-	topics, err := makeTopics(query...)
+	config, err := c.filterQuery(name, query...)
 	if err != nil {
 		return nil, nil, err
 	}
+	ctx := ensureContext(opts.Context)
+
 	// Start the background filtering
 	logs := make(chan types.Log, 128)
 
-	config := ethereum.FilterQuery{
-		Addresses: []common.Address{c.address},
-		Topics:    topics,
+	reader, ok := chainHeadReader(c.backend)
+	if opts.Start == nil || !ok {
+		if opts.Start != nil {
+			config.FromBlock = new(big.Int).SetUint64(*opts.Start)
+		}
+		sub, err := c.backend.SubscribeFilterLogs(ctx, config, logs)
+		if err != nil {
+			return nil, nil, err
+		}
+		return logs, sub, nil
 	}
-	if opts.Start != nil {
-		config.FromBlock = new(big.Int).SetUint64(*opts.Start)
+	// Subscribe before looking up the head, so no block is missed in between
+	live := make(chan types.Log, 128)
+	sub, err := c.backend.SubscribeFilterLogs(ctx, config, live)
+	if err != nil {
+		return nil, nil, err
 	}
-	sub, err := c.backend.SubscribeFilterLogs(ensureContext(opts.Context), config, logs)
+	header, err := reader.HeaderByNumber(ctx, nil)
 	if err != nil {
+		sub.Unsubscribe()
 		return nil, nil, err
 	}
-	return logs, sub, nil
+	head := header.Number.Uint64()
+
+	return logs, event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+
+		pageSize := opts.PageSize
+		if pageSize == 0 && head >= *opts.Start {
+			pageSize = head - *opts.Start + 1
+		}
+		// Retrieve the past logs in the background, buffering the live ones meanwhile
+		// so the upstream subscription is not held up
+		var (
+			pending []types.Log
+			done    = make(chan error, 1)
+			stop    = make(chan struct{})
+		)
+		defer close(stop)
+		go func() {
+			done <- c.filterPages(ctx, config, *opts.Start, head, pageSize, logs, stop)
+		}()
+		for backfilling := true; backfilling; {
+			select {
+			case log := <-live:
+				pending = append(pending, log)
+			case err := <-done:
+				if err != nil {
+					return err
+				}
+				backfilling = false
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+		for {
+			var log types.Log
+			if len(pending) > 0 {
+				log, pending = pending[0], pending[1:]
+			} else {
+				select {
+				case log = <-live:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			}
+			// Skip the logs already retrieved, but not their removal
+			if log.BlockNumber < *opts.Start || (!log.Removed && log.BlockNumber <= head) {
+				continue
+			}
+			// Deliver the logs of the blocks replacing the retrieved ones
+			if log.Removed && log.BlockNumber <= head && log.BlockNumber > 0 {
+				head = log.BlockNumber - 1
+			}
+			select {
+			case logs <- log:
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// filterQuery returns the query of the logs of the named event, matching the
+// values of its indexed arguments.
+func (c *BoundContract) filterQuery(name string, query ...[]interface{}) (ethereum.FilterQuery, error) {
+	// Append the event selector to the query parameters and construct the topic set
+	query = append([][]interface{}{{c.abi.Events[name].ID}}, query...)
+
+	topics, err := makeTopics(query...)
+	if err != nil {
+		return ethereum.FilterQuery{}, err
+	}
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{c.address},
+		Topics:    topics,
+	}, nil
+}
+
+// filterPage retrieves the logs of the page of blocks starting at start, ending
+// at end at the latest.
+func (c *BoundContract) filterPage(ctx context.Context, config ethereum.FilterQuery, start, end, pageSize uint64) ([]types.Log, error) {
+	last := start + pageSize - 1
+	if last > end || last < start {
+		last = end
+	}
+	config.FromBlock = new(big.Int).SetUint64(start)
+	config.ToBlock = new(big.Int).SetUint64(last)
+	return c.backend.FilterLogs(ctx, config)
+}
+
+// filterPages delivers the logs of the blocks from start to end, retrieving them
+// by pages of pageSize blocks.
+func (c *BoundContract) filterPages(ctx context.Context, config ethereum.FilterQuery, start, end, pageSize uint64, logs chan<- types.Log, quit <-chan struct{}) error {
+	for from := start; from <= end && from >= start; from += pageSize {
+		buff, err := c.filterPage(ctx, config, from, end, pageSize)
+		if err != nil {
+			return err
+		}
+		if !deliverLogs(buff, logs, quit) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// chainHeadReader returns the ChainHeadReader of the backend, looking through
+// subscription managers.
+func chainHeadReader(backend ContractBackend) (ChainHeadReader, bool) {
+	if manager, ok := backend.(*SubscriptionManager); ok {
+		backend = manager.ContractBackend
+	}
+	reader, ok := backend.(ChainHeadReader)
+	return reader, ok
+}
+
+// deliverLogs sends the logs to the channel, returning false if the subscription
+// got terminated meanwhile.
+func deliverLogs(buff []types.Log, logs chan<- types.Log, quit <-chan struct{}) bool {
+	for _, log := range buff {
+		select {
+		case logs <- log:
+		case <-quit:
+			return false
+		}
+	}
+	return true
 }
 
 // UnpackLog unpacks a retrieved log into the provided output structure.
@@ -502,3 +691,9 @@ func (txo *TxObject) EstimateGas() (uint64, error) {
 func (txo *TxObject) Transaction() (*types.Transaction, error) {
 	return txo.c.TransactionFor(txo.opts, txo.method, txo.params...)
 }
+
+// Execute runs the method on the state of the backend instead of sending a
+// transaction, setting its output to result (nil to discard it).
+func (txo *TxObject) Execute(result interface{}) error {
+	return txo.c.Execute(txo.opts, result, txo.method, txo.params...)
+}
@@ -14,22 +14,26 @@
 // You should have received a copy of the GNU Lesser General Public License
 // along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
 
-package bind_v2
+package bind_v2_test
 
 import (
 	"bytes"
 	"context"
 	"math/big"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	ethereum "github.com/aaronwinter/celo-blockchain"
 	"github.com/aaronwinter/celo-blockchain/accounts/abi"
 	"github.com/aaronwinter/celo-blockchain/accounts/abi/bind"
+	"github.com/aaronwinter/celo-blockchain/accounts/abi/bind_v2"
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
 	"github.com/aaronwinter/celo-blockchain/core/types"
 	"github.com/aaronwinter/celo-blockchain/crypto"
+	"github.com/aaronwinter/celo-blockchain/event"
 	"github.com/aaronwinter/celo-blockchain/rlp"
 )
 
@@ -345,3 +349,242 @@ func TestUnpackIndexedBytesTyLogIntoMap(t *testing.T) {
 		t.Error("unpacked map does not match expected map")
 	}
 }
+
+type mockExecutor struct {
+	bind_v2.ContractBackend
+	call ethereum.CallMsg
+}
+
+func (me *mockExecutor) ExecuteContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	me.call = call
+	return common.LeftPadBytes([]byte{42}, 32), nil
+}
+
+func TestExecute(t *testing.T) {
+	parsedAbi, _ := abi.JSON(strings.NewReader(`[{"inputs":[{"name":"value","type":"uint256"}],"name":"update","outputs":[{"name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"}]`))
+	address := common.HexToAddress("0x01")
+
+	me := &mockExecutor{}
+	opts := &bind_v2.TransactOpts{From: common.HexToAddress("0x02"), GasLimit: 100000, Value: big.NewInt(1)}
+
+	var result *big.Int
+	if err := bind_v2.NewBoundContract(address, parsedAbi, me).TxObj(opts, "update", big.NewInt(7)).Execute(&result); err != nil {
+		t.Fatalf("failed to execute: %v", err)
+	}
+	if result.Uint64() != 42 {
+		t.Errorf("result mismatch: have %v, want 42", result)
+	}
+	if *me.call.To != address || me.call.From != opts.From || me.call.Gas != opts.GasLimit || me.call.Value != opts.Value {
+		t.Errorf("call mismatch: %+v", me.call)
+	}
+	// Backends without an executor can't run methods
+	if err := bind_v2.NewBoundContract(address, parsedAbi, nil).Execute(opts, nil, "update", big.NewInt(7)); err != bind_v2.ErrNoExecutor {
+		t.Errorf("error mismatch: have %v, want %v", err, bind_v2.ErrNoExecutor)
+	}
+}
+
+const updatedEventABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"value","type":"uint256"}],"name":"Updated","type":"event"}]`
+
+// mockLogBackend serves the logs of a chain, and delivers the live ones to its
+// subscriptions.
+type mockLogBackend struct {
+	bind_v2.ContractBackend
+	head    uint64
+	logs    []types.Log
+	queries []ethereum.FilterQuery
+
+	live          chan types.Log
+	fail          chan error
+	subscriptions int32 // Number of subscriptions made
+	active        int32 // Number of subscriptions not torn down
+}
+
+func newMockLogBackend(head uint64, logs []types.Log) *mockLogBackend {
+	return &mockLogBackend{head: head, logs: logs, live: make(chan types.Log), fail: make(chan error)}
+}
+
+func (mb *mockLogBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	mb.queries = append(mb.queries, query)
+
+	to := mb.head
+	if query.ToBlock != nil {
+		to = query.ToBlock.Uint64()
+	}
+	var logs []types.Log
+	for _, log := range mb.logs {
+		if log.BlockNumber >= query.FromBlock.Uint64() && log.BlockNumber <= to {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func (mb *mockLogBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	atomic.AddInt32(&mb.subscriptions, 1)
+	atomic.AddInt32(&mb.active, 1)
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer atomic.AddInt32(&mb.active, -1)
+		for {
+			select {
+			case log := <-mb.live:
+				select {
+				case ch <- log:
+				case <-quit:
+					return nil
+				}
+			case err := <-mb.fail:
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (mb *mockLogBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(mb.head)}, nil
+}
+
+// updatedLog returns an Updated log of the contract at address.
+func updatedLog(address common.Address, number uint64, value int64) types.Log {
+	parsedAbi, _ := abi.JSON(strings.NewReader(updatedEventABI))
+	return types.Log{
+		Address:     address,
+		Topics:      []common.Hash{parsedAbi.Events["Updated"].ID, common.BigToHash(big.NewInt(value))},
+		BlockNumber: number,
+	}
+}
+
+// receiveLogs returns the block numbers of the n next logs, flagging the removed
+// ones with a minus sign.
+func receiveLogs(t *testing.T, logs chan types.Log, n int) []int64 {
+	var numbers []int64
+	for len(numbers) < n {
+		select {
+		case log := <-logs:
+			number := int64(log.BlockNumber)
+			if log.Removed {
+				number = -number
+			}
+			numbers = append(numbers, number)
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for logs, have %v", numbers)
+		}
+	}
+	return numbers
+}
+
+func TestFilterLogsPages(t *testing.T) {
+	parsedAbi, _ := abi.JSON(strings.NewReader(updatedEventABI))
+	address := common.HexToAddress("0x01")
+
+	var chain []types.Log
+	for i := uint64(1); i <= 10; i++ {
+		chain = append(chain, updatedLog(address, i, int64(i)))
+	}
+	backend := newMockLogBackend(10, chain)
+	bc := bind_v2.NewBoundContract(address, parsedAbi, backend)
+
+	logs, sub, err := bc.FilterLogs(&bind_v2.FilterOpts{Start: 2, PageSize: 4}, "Updated")
+	if err != nil {
+		t.Fatalf("failed to filter logs: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	if have, want := receiveLogs(t, logs, 9), []int64{2, 3, 4, 5, 6, 7, 8, 9, 10}; !equalNumbers(have, want) {
+		t.Errorf("logs mismatch: have %v, want %v", have, want)
+	}
+	if err := <-sub.Err(); err != nil {
+		t.Errorf("filtering failed: %v", err)
+	}
+	var pages [][2]uint64
+	for _, query := range backend.queries {
+		pages = append(pages, [2]uint64{query.FromBlock.Uint64(), query.ToBlock.Uint64()})
+	}
+	if want := [][2]uint64{{2, 5}, {6, 9}, {10, 10}}; len(pages) != len(want) || pages[0] != want[0] || pages[1] != want[1] || pages[2] != want[2] {
+		t.Errorf("pages mismatch: have %v, want %v", pages, want)
+	}
+}
+
+func TestWatchLogsCatchUp(t *testing.T) {
+	parsedAbi, _ := abi.JSON(strings.NewReader(updatedEventABI))
+	address := common.HexToAddress("0x01")
+
+	var chain []types.Log
+	for i := uint64(1); i <= 5; i++ {
+		chain = append(chain, updatedLog(address, i, int64(i)))
+	}
+	backend := newMockLogBackend(5, chain)
+	bc := bind_v2.NewBoundContract(address, parsedAbi, backend)
+
+	start := uint64(2)
+	logs, sub, err := bc.WatchLogs(&bind_v2.WatchOpts{Start: &start, PageSize: 2}, "Updated")
+	if err != nil {
+		t.Fatalf("failed to watch logs: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	// Deliver the head again, then reorg it
+	removed := updatedLog(address, 5, 5)
+	removed.Removed = true
+	for _, log := range []types.Log{updatedLog(address, 1, 1), updatedLog(address, 5, 5), removed, updatedLog(address, 5, 50), updatedLog(address, 6, 6)} {
+		go func(log types.Log) { backend.live <- log }(log)
+		time.Sleep(10 * time.Millisecond)
+	}
+	if have, want := receiveLogs(t, logs, 7), []int64{2, 3, 4, 5, -5, 5, 6}; !equalNumbers(have, want) {
+		t.Errorf("logs mismatch: have %v, want %v", have, want)
+	}
+}
+
+// Tests that the live logs are drained while the past ones are being retrieved.
+func TestWatchLogsBuffersLive(t *testing.T) {
+	parsedAbi, _ := abi.JSON(strings.NewReader(updatedEventABI))
+	address := common.HexToAddress("0x01")
+
+	var chain []types.Log
+	for i := uint64(1); i <= 200; i++ {
+		chain = append(chain, updatedLog(address, i, int64(i)))
+	}
+	backend := newMockLogBackend(200, chain)
+	bc := bind_v2.NewBoundContract(address, parsedAbi, backend)
+
+	start := uint64(1)
+	logs, sub, err := bc.WatchLogs(&bind_v2.WatchOpts{Start: &start, PageSize: 10}, "Updated")
+	if err != nil {
+		t.Fatalf("failed to watch logs: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	// Without reading any log, the backfill stalls but the live logs (including
+	// some of already retrieved blocks) must still be accepted
+	for i := uint64(151); i <= 500; i++ {
+		select {
+		case backend.live <- updatedLog(address, i, int64(i)):
+		case <-time.After(time.Second):
+			t.Fatalf("live log #%d not accepted", i)
+		}
+	}
+	have := receiveLogs(t, logs, 500)
+	for i, number := range have {
+		if number != int64(i+1) {
+			t.Fatalf("log %d mismatch: have block %d, want %d", i, number, i+1)
+		}
+	}
+	select {
+	case log := <-logs:
+		t.Errorf("unexpected log of block %d", log.BlockNumber)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func equalNumbers(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,254 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package bind_v2
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	ethereum "github.com/aaronwinter/celo-blockchain"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+)
+
+// watcherQueueSize is the number of logs buffered for each watcher of a shared
+// subscription, before the watcher is considered stalled and terminated.
+const watcherQueueSize = 128
+
+// ErrWatcherQueueOverflow is returned to the watchers of a shared subscription
+// not keeping up with its logs.
+var ErrWatcherQueueOverflow = errors.New("watcher queue overflow, logs dropped")
+
+// SubscriptionManager is a contract backend sharing a single log subscription of
+// the wrapped backend among all the watchers of the contracts bound to it, e.g.
+// to follow the events of many contracts without a connection per event.
+//
+// The shared subscription follows all the logs of the chain, which are matched
+// locally against the queries of the watchers. It is set up with the first
+// watcher and torn down with the last one. A failure of the shared subscription
+// terminates all the watchers with the error. Logs are delivered to each watcher
+// through its own queue, so that a stalled watcher doesn't hold up the others:
+// it is terminated with ErrWatcherQueueOverflow once its queue is full.
+type SubscriptionManager struct {
+	ContractBackend
+
+	subs      map[*managedSubscription]struct{} // Watchers of the shared subscription
+	quit      chan struct{}                     // Quit channel of the shared subscription (nil = not running)
+	setupLock sync.Mutex                        // Serialises the setup of the shared subscription
+	lock      sync.Mutex
+}
+
+// NewSubscriptionManager creates a contract backend sharing the log subscription
+// of backend.
+func NewSubscriptionManager(backend ContractBackend) *SubscriptionManager {
+	return &SubscriptionManager{
+		ContractBackend: backend,
+		subs:            make(map[*managedSubscription]struct{}),
+	}
+}
+
+// SubscribeFilterLogs implements ContractFilterer, delivering the logs of the
+// shared subscription matching query to ch.
+func (m *SubscriptionManager) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	sub := &managedSubscription{
+		manager: m,
+		query:   query,
+		sink:    ch,
+		queue:   make(chan types.Log, watcherQueueSize),
+		err:     make(chan error, 1),
+		quit:    make(chan struct{}),
+	}
+	go sub.forward()
+
+	m.setupLock.Lock()
+	defer m.setupLock.Unlock()
+
+	// Join the shared subscription if running
+	m.lock.Lock()
+	if m.quit != nil {
+		m.subs[sub] = struct{}{}
+		m.lock.Unlock()
+		return sub, nil
+	}
+	m.lock.Unlock()
+
+	// Otherwise set it up, following all the logs of the chain
+	logs := make(chan types.Log, 128)
+	upstream, err := m.ContractBackend.SubscribeFilterLogs(ctx, ethereum.FilterQuery{}, logs)
+	if err != nil {
+		close(sub.quit)
+		return nil, err
+	}
+	quit := make(chan struct{})
+
+	m.lock.Lock()
+	m.quit = quit
+	m.subs[sub] = struct{}{}
+	m.lock.Unlock()
+
+	go m.loop(upstream, logs, quit)
+	return sub, nil
+}
+
+// loop dispatches the logs of the shared subscription to the watchers, until
+// it fails or the last watcher leaves.
+func (m *SubscriptionManager) loop(upstream ethereum.Subscription, logs chan types.Log, quit chan struct{}) {
+	defer upstream.Unsubscribe()
+
+	for {
+		select {
+		case log := <-logs:
+			m.lock.Lock()
+			subs := make([]*managedSubscription, 0, len(m.subs))
+			for sub := range m.subs {
+				subs = append(subs, sub)
+			}
+			m.lock.Unlock()
+
+			for _, sub := range subs {
+				if !matchLog(sub.query, log) {
+					continue
+				}
+				select {
+				case sub.queue <- log:
+				default:
+					m.remove(sub, ErrWatcherQueueOverflow)
+				}
+			}
+		case err := <-upstream.Err():
+			m.lock.Lock()
+			if m.quit == quit {
+				for sub := range m.subs {
+					m.terminate(sub, err)
+				}
+				m.quit = nil
+			}
+			m.lock.Unlock()
+			return
+
+		case <-quit:
+			return
+		}
+	}
+}
+
+// remove unregisters a watcher, delivering err to it if not nil, and tears down
+// the shared subscription with the last one.
+func (m *SubscriptionManager) remove(sub *managedSubscription, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.subs[sub]; !ok {
+		return
+	}
+	m.terminate(sub, err)
+
+	if len(m.subs) == 0 && m.quit != nil {
+		close(m.quit)
+		m.quit = nil
+	}
+}
+
+// terminate unregisters a watcher, delivering err to it if not nil before closing
+// its error channel. The lock must be held.
+func (m *SubscriptionManager) terminate(sub *managedSubscription, err error) {
+	delete(m.subs, sub)
+	if err != nil {
+		sub.err <- err
+	}
+	close(sub.err)
+	close(sub.quit)
+}
+
+// managedSubscription is a watcher of the shared subscription of a manager.
+type managedSubscription struct {
+	manager *SubscriptionManager
+	query   ethereum.FilterQuery
+	sink    chan<- types.Log
+	queue   chan types.Log // Logs pending delivery to the sink
+
+	err  chan error    // Failure of the watcher, closed once terminated
+	quit chan struct{} // Closed once terminated, to stop pending deliveries
+}
+
+// forward delivers the queued logs to the sink until the watcher is terminated.
+func (s *managedSubscription) forward() {
+	for {
+		select {
+		case log := <-s.queue:
+			select {
+			case s.sink <- log:
+			case <-s.quit:
+				return
+			}
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// Err implements ethereum.Subscription.
+func (s *managedSubscription) Err() <-chan error {
+	return s.err
+}
+
+// Unsubscribe implements ethereum.Subscription.
+func (s *managedSubscription) Unsubscribe() {
+	s.manager.remove(s, nil)
+}
+
+// matchLog returns whether the log matches the addresses, topics and block range
+// of the query.
+func matchLog(query ethereum.FilterQuery, log types.Log) bool {
+	if query.FromBlock != nil && query.FromBlock.Sign() >= 0 && log.BlockNumber < query.FromBlock.Uint64() {
+		return false
+	}
+	if query.ToBlock != nil && query.ToBlock.Sign() >= 0 && log.BlockNumber > query.ToBlock.Uint64() {
+		return false
+	}
+	if len(query.Addresses) > 0 && !includesAddress(query.Addresses, log.Address) {
+		return false
+	}
+	if len(query.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, topics := range query.Topics {
+		if len(topics) > 0 && !includesHash(topics, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func includesAddress(addresses []common.Address, a common.Address) bool {
+	for _, addr := range addresses {
+		if addr == a {
+			return true
+		}
+	}
+	return false
+}
+
+func includesHash(hashes []common.Hash, h common.Hash) bool {
+	for _, hash := range hashes {
+		if hash == h {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package bind_v2_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ethereum "github.com/aaronwinter/celo-blockchain"
+	"github.com/aaronwinter/celo-blockchain/accounts/abi"
+	"github.com/aaronwinter/celo-blockchain/accounts/abi/bind_v2"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+)
+
+func TestSubscriptionManager(t *testing.T) {
+	parsedAbi, _ := abi.JSON(strings.NewReader(updatedEventABI))
+	backend := newMockLogBackend(0, nil)
+	manager := bind_v2.NewSubscriptionManager(backend)
+
+	var (
+		addressA = common.HexToAddress("0x01")
+		addressB = common.HexToAddress("0x02")
+	)
+	logsA, subA, err := bind_v2.NewBoundContract(addressA, parsedAbi, manager).WatchLogs(nil, "Updated")
+	if err != nil {
+		t.Fatalf("failed to watch logs: %v", err)
+	}
+	logsB, subB, err := bind_v2.NewBoundContract(addressB, parsedAbi, manager).WatchLogs(nil, "Updated", []interface{}{big.NewInt(7)})
+	if err != nil {
+		t.Fatalf("failed to watch logs: %v", err)
+	}
+	if n := atomic.LoadInt32(&backend.subscriptions); n != 1 {
+		t.Fatalf("subscriptions mismatch: have %d, want 1", n)
+	}
+	// Each watcher only receives the logs matching its query
+	for _, log := range []types.Log{updatedLog(addressB, 1, 1), updatedLog(addressA, 2, 1), updatedLog(addressB, 3, 7)} {
+		backend.live <- log
+	}
+	if have := receiveLogs(t, logsA, 1); have[0] != 2 {
+		t.Errorf("watcher A logs mismatch: have %v, want [2]", have)
+	}
+	if have := receiveLogs(t, logsB, 1); have[0] != 3 {
+		t.Errorf("watcher B logs mismatch: have %v, want [3]", have)
+	}
+	// The shared subscription is torn down with the last watcher, and set up again
+	subA.Unsubscribe()
+	subB.Unsubscribe()
+	for i := 0; atomic.LoadInt32(&backend.active) > 0; i++ {
+		if i == 100 {
+			t.Fatalf("shared subscription not torn down")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	_, sub, err := bind_v2.NewBoundContract(addressA, parsedAbi, manager).WatchLogs(nil, "Updated")
+	if err != nil {
+		t.Fatalf("failed to watch logs: %v", err)
+	}
+	if n := atomic.LoadInt32(&backend.subscriptions); n != 2 {
+		t.Fatalf("subscriptions mismatch: have %d, want 2", n)
+	}
+	// Failures of the shared subscription terminate the watchers
+	failure := errors.New("connection lost")
+	backend.fail <- failure
+
+	select {
+	case err := <-sub.Err():
+		if err != failure {
+			t.Errorf("error mismatch: have %v, want %v", err, failure)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for failure")
+	}
+	if _, ok := <-sub.Err(); ok {
+		t.Errorf("error channel not closed after the failure")
+	}
+	sub.Unsubscribe()
+}
+
+// Tests that a stalled watcher doesn't hold up the others, but gets terminated
+// once its queue is full.
+func TestSubscriptionManagerStalledWatcher(t *testing.T) {
+	backend := newMockLogBackend(0, nil)
+	manager := bind_v2.NewSubscriptionManager(backend)
+
+	address := common.HexToAddress("0x01")
+	query := ethereum.FilterQuery{Addresses: []common.Address{address}}
+
+	stalled, err := manager.SubscribeFilterLogs(context.Background(), query, make(chan types.Log))
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+	logs := make(chan types.Log, 1)
+	sub, err := manager.SubscribeFilterLogs(context.Background(), query, logs)
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	// The active watcher receives all the logs while the stalled one doesn't read any
+	for i := uint64(1); i <= 300; i++ {
+		select {
+		case backend.live <- updatedLog(address, i, 1):
+		case <-time.After(time.Second):
+			t.Fatalf("log %d not dispatched", i)
+		}
+		if have := receiveLogs(t, logs, 1); have[0] != int64(i) {
+			t.Fatalf("log mismatch: have %v, want [%d]", have, i)
+		}
+	}
+	select {
+	case err := <-stalled.Err():
+		if err != bind_v2.ErrWatcherQueueOverflow {
+			t.Errorf("error mismatch: have %v, want %v", err, bind_v2.ErrWatcherQueueOverflow)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for the stalled watcher to be terminated")
+	}
+	if _, ok := <-stalled.Err(); ok {
+		t.Errorf("error channel not closed after the failure")
+	}
+	stalled.Unsubscribe()
+}
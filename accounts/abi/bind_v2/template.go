@@ -88,6 +88,7 @@ package {{.Package}}
 import (
 	"math/big"
 	"strings"
+	"sync"
 
 	ethereum "github.com/aaronwinter/celo-blockchain"
 	"github.com/aaronwinter/celo-blockchain/accounts/abi"
@@ -102,7 +103,7 @@ var (
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
-	_ = abi.U256
+	_ = abi.JSON
 	_ = bind.Bind
 	_ = common.Big1
 	_ = types.BloomLookup
@@ -189,13 +190,22 @@ var (
 	  return bind.NewBoundContract(address, *parsed, contract), nil
 	}
 
-	// Parse{{.Type}}ABI parses the ABI
+	var (
+		{{decapitalise .Type}}ABIOnce   sync.Once
+		{{decapitalise .Type}}ParsedABI *abi.ABI
+		{{decapitalise .Type}}ABIErr    error
+	)
+
+	// Parse{{.Type}}ABI parses the ABI, only once as it is shared by all the bound contracts
 	func Parse{{.Type}}ABI() (*abi.ABI, error) {
-	  parsed, err := abi.JSON(strings.NewReader({{.Type}}ABI))
-	  if err != nil {
-	    return nil, err
+	  {{decapitalise .Type}}ABIOnce.Do(func() {
+	    parsed, err := abi.JSON(strings.NewReader({{.Type}}ABI))
+	    {{decapitalise .Type}}ParsedABI, {{decapitalise .Type}}ABIErr = &parsed, err
+	  })
+	  if {{decapitalise .Type}}ABIErr != nil {
+	    return nil, {{decapitalise .Type}}ABIErr
 	  }
-	  return &parsed, nil
+	  return {{decapitalise .Type}}ParsedABI, nil
 	}
 
 	// Call invokes the (constant) contract method with params as input values and
@@ -38,11 +38,12 @@ type Account struct {
 }
 
 const (
-	MimetypeDataWithValidator = "data/validator"
-	MimetypeTypedData         = "data/typed"
-	MimetypeTextPlain         = "text/plain"
-	MimetypeIstanbul          = "application/x-istanbul-msg"
-	MimetypeIstanbulHeader    = "application/x-istanbul-header"
+	MimetypeDataWithValidator  = "data/validator"
+	MimetypeTypedData          = "data/typed"
+	MimetypeTextPlain          = "text/plain"
+	MimetypeIstanbul           = "application/x-istanbul-msg"
+	MimetypeIstanbulHeader     = "application/x-istanbul-header"
+	MimetypeIstanbulRandomSeed = "application/x-istanbul-random-seed"
 )
 
 // Wallet represents a software or hardware wallet that might contain one or more
@@ -153,8 +154,10 @@ type Wallet interface {
 	// SignTextWithPassphrase is identical to Signtext, but also takes a password
 	SignTextWithPassphrase(account Account, passphrase string, hash []byte) ([]byte, error)
 
-	// SignBLS generates a BLS signature over the provided data with a direct or composite hasher
-	SignBLS(account Account, msg []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error)
+	// SignBLS generates a BLS signature over the provided data with a direct or composite hasher.
+	// The BLS key of the account is selected by its public key; the empty public key selects the
+	// key derived from the account's ECDSA key.
+	SignBLS(account Account, publicKey blscrypto.SerializedPublicKey, msg []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error)
 
 	GenerateProofOfPossession(account Account, address common.Address) ([]byte, []byte, error)
 	GenerateProofOfPossessionBLS(account Account, address common.Address) ([]byte, []byte, error)
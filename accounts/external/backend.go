@@ -27,6 +27,7 @@ import (
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
 	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/crypto"
 	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
 	"github.com/aaronwinter/celo-blockchain/event"
 	"github.com/aaronwinter/celo-blockchain/log"
@@ -171,11 +172,20 @@ func (api *ExternalSigner) SignData(account accounts.Account, mimeType string, d
 	return res, nil
 }
 
-// SignHash is not implemented for the external signer
+// SignHash requests the external signer to sign a hash as is. Clef only signs the
+// istanbul randomness seed hash this way, which validators sign when proposing.
 //
 // DEPRECATED, use SignData in future releases.
 func (api *ExternalSigner) SignHash(account accounts.Account, hash []byte) ([]byte, error) {
-	panic("SignHash not implemented for the external signer")
+	var res hexutil.Bytes
+	var signAddress = common.NewMixedcaseAddress(account.Address)
+	if err := api.client.Call(&res, "account_signData",
+		accounts.MimetypeIstanbulRandomSeed,
+		&signAddress, // Need to use the pointer here, because of how MarshalJSON is defined
+		hexutil.Encode(hash)); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (api *ExternalSigner) SignText(account accounts.Account, text []byte) ([]byte, error) {
@@ -235,24 +245,68 @@ func (api *ExternalSigner) SignDataWithPassphrase(account accounts.Account, pass
 	return nil, fmt.Errorf("password-operations not supported on external signers")
 }
 
+// Decrypt requests the external signer to decrypt an ECIES ciphertext, e.g. an
+// encrypted enode URL from an istanbul announce message.
 func (api *ExternalSigner) Decrypt(account accounts.Account, c, s1, s2 []byte) ([]byte, error) {
-	return nil, accounts.ErrNotSupported
+	var res hexutil.Bytes
+	var signAddress = common.NewMixedcaseAddress(account.Address)
+	if err := api.client.Call(&res, "account_decrypt",
+		&signAddress, // Need to use the pointer here, because of how MarshalJSON is defined
+		hexutil.Bytes(c), hexutil.Bytes(s1), hexutil.Bytes(s2)); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
-func (api *ExternalSigner) SignBLS(account accounts.Account, msg []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
-	return blscrypto.SerializedSignature{}, accounts.ErrNotSupported
+// SignBLS requests the external signer to sign a consensus message with the BLS
+// key of the account.
+func (api *ExternalSigner) SignBLS(account accounts.Account, publicKey blscrypto.SerializedPublicKey, msg []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
+	var res hexutil.Bytes
+	var signAddress = common.NewMixedcaseAddress(account.Address)
+	args := []interface{}{
+		&signAddress, // Need to use the pointer here, because of how MarshalJSON is defined
+		hexutil.Bytes(msg), hexutil.Bytes(extraData), useComposite, cip22,
+	}
+	// The public key is only sent when set, so that older signers keep working
+	if publicKey != (blscrypto.SerializedPublicKey{}) {
+		args = append(args, publicKey)
+	}
+	if err := api.client.Call(&res, "account_signBLS", args...); err != nil {
+		return blscrypto.SerializedSignature{}, err
+	}
+	return blscrypto.SerializedSignatureFromBytes(res)
+}
+
+// proofOfPossessionResult represents the proof-of-possession returned by clef.
+type proofOfPossessionResult struct {
+	PublicKey hexutil.Bytes `json:"publicKey"`
+	Signature hexutil.Bytes `json:"signature"`
 }
 
 func (api *ExternalSigner) GenerateProofOfPossession(account accounts.Account, address common.Address) ([]byte, []byte, error) {
-	return nil, nil, accounts.ErrNotSupported
+	return api.generateProofOfPossession("account_generateProofOfPossession", account, address)
 }
 
 func (api *ExternalSigner) GenerateProofOfPossessionBLS(account accounts.Account, address common.Address) ([]byte, []byte, error) {
-	return nil, nil, accounts.ErrNotSupported
+	return api.generateProofOfPossession("account_generateProofOfPossessionBLS", account, address)
+}
+
+func (api *ExternalSigner) generateProofOfPossession(method string, account accounts.Account, address common.Address) ([]byte, []byte, error) {
+	var res proofOfPossessionResult
+	var signAddress = common.NewMixedcaseAddress(account.Address)
+	if err := api.client.Call(&res, method, &signAddress, address); err != nil {
+		return nil, nil, err
+	}
+	return res.PublicKey, res.Signature, nil
 }
 
 func (api *ExternalSigner) GetPublicKey(account accounts.Account) (*ecdsa.PublicKey, error) {
-	return nil, accounts.ErrNotSupported
+	var res hexutil.Bytes
+	var signAddress = common.NewMixedcaseAddress(account.Address)
+	if err := api.client.Call(&res, "account_publicKey", &signAddress); err != nil {
+		return nil, err
+	}
+	return crypto.UnmarshalPubkey(res)
 }
 
 func (api *ExternalSigner) listAccounts() ([]common.Address, error) {
@@ -0,0 +1,316 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package keystore
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/aaronwinter/celo-blockchain/accounts"
+	"github.com/aaronwinter/celo-blockchain/common"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/celo-org/celo-bls-go/bls"
+	"github.com/pborman/uuid"
+)
+
+// blsKeyDir is the directory of the keystore holding the BLS key files. Being a
+// subdirectory, its files are not mistaken for accounts.
+const blsKeyDir = "bls"
+
+var (
+	// ErrUnknownBLSKey is returned when asked to use a BLS key which is neither
+	// stored for the account nor derived from its ECDSA key.
+	ErrUnknownBLSKey = errors.New("unknown BLS key")
+
+	// ErrBLSKeyAlreadyExists is returned if a BLS key attempted to import is
+	// already stored for the account.
+	ErrBLSKeyAlreadyExists = errors.New("BLS key already exists")
+)
+
+// encryptedBLSKeyJSON is the format of BLS key files. Besides the key derived
+// from its ECDSA key, an account may hold any number of independent BLS keys,
+// encrypted with the passphrase of the account, which allows rotating the BLS
+// key of a validator without changing its signer address.
+type encryptedBLSKeyJSON struct {
+	Address      string     `json:"address"`
+	BLSPublicKey string     `json:"blspublickey"`
+	Crypto       CryptoJSON `json:"crypto"`
+	Id           string     `json:"id"`
+	Version      int        `json:"version"`
+}
+
+// blsKeyFile is a BLS key file of the keystore.
+type blsKeyFile struct {
+	path      string
+	publicKey blscrypto.SerializedPublicKey
+	json      *encryptedBLSKeyJSON
+}
+
+// blsKeyFiles returns the BLS key files of addr, oldest first.
+func (ks *KeyStore) blsKeyFiles(addr common.Address) ([]*blsKeyFile, error) {
+	dir := ks.storage.JoinPath(blsKeyDir)
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var keys []*blsKeyFile
+	for _, fi := range files {
+		if nonKeyFile(fi) {
+			continue
+		}
+		path := filepath.Join(dir, fi.Name())
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		keyJSON := new(encryptedBLSKeyJSON)
+		if err := json.Unmarshal(content, keyJSON); err != nil {
+			log.Debug("Failed to decode BLS key file", "path", path, "err", err)
+			continue
+		}
+		if common.HexToAddress(keyJSON.Address) != addr {
+			continue
+		}
+		publicKey, err := hex.DecodeString(keyJSON.BLSPublicKey)
+		if err != nil || len(publicKey) != blscrypto.PUBLICKEYBYTES {
+			log.Debug("Invalid public key in BLS key file", "path", path, "err", err)
+			continue
+		}
+		key := &blsKeyFile{path: path, json: keyJSON}
+		copy(key.publicKey[:], publicKey)
+		keys = append(keys, key)
+	}
+	// Key files are named after their creation time
+	sort.Slice(keys, func(i, j int) bool { return keys[i].path < keys[j].path })
+	return keys, nil
+}
+
+// BLSPublicKeys returns the public keys of the independent BLS keys of the
+// account, oldest first. The key derived from the ECDSA key is not included.
+func (ks *KeyStore) BLSPublicKeys(a accounts.Account) ([]blscrypto.SerializedPublicKey, error) {
+	files, err := ks.blsKeyFiles(a.Address)
+	if err != nil {
+		return nil, err
+	}
+	publicKeys := make([]blscrypto.SerializedPublicKey, len(files))
+	for i, file := range files {
+		publicKeys[i] = file.publicKey
+	}
+	return publicKeys, nil
+}
+
+// NewBLSKey generates a new BLS key for the account, encrypting it with the
+// passphrase of the account.
+func (ks *KeyStore) NewBLSKey(a accounts.Account, passphrase string) (blscrypto.SerializedPublicKey, error) {
+	privateKey, err := bls.GeneratePrivateKey()
+	if err != nil {
+		return blscrypto.SerializedPublicKey{}, err
+	}
+	defer privateKey.Destroy()
+	privateKeyBytes, err := privateKey.Serialize()
+	if err != nil {
+		return blscrypto.SerializedPublicKey{}, err
+	}
+	defer zeroBytes(privateKeyBytes)
+	return ks.ImportBLSKey(a, passphrase, privateKeyBytes)
+}
+
+// ImportBLSKey stores the given serialized BLS private key for the account,
+// encrypting it with the passphrase of the account.
+func (ks *KeyStore) ImportBLSKey(a accounts.Account, passphrase string, privateKey []byte) (blscrypto.SerializedPublicKey, error) {
+	publicKey, err := blscrypto.PrivateToPublic(privateKey)
+	if err != nil {
+		return blscrypto.SerializedPublicKey{}, err
+	}
+	// Make sure the account exists and the passphrase is the right one
+	a, key, err := ks.getDecryptedKey(a, passphrase)
+	if err != nil {
+		return blscrypto.SerializedPublicKey{}, err
+	}
+	zeroKey(key.PrivateKey)
+
+	ks.importMu.Lock()
+	defer ks.importMu.Unlock()
+
+	files, err := ks.blsKeyFiles(a.Address)
+	if err != nil {
+		return blscrypto.SerializedPublicKey{}, err
+	}
+	for _, file := range files {
+		if file.publicKey == publicKey {
+			return publicKey, ErrBLSKeyAlreadyExists
+		}
+	}
+	path := ks.storage.JoinPath(filepath.Join(blsKeyDir, keyFileName(a.Address)))
+	if err := ks.storeBLSKey(path, a.Address, publicKey, privateKey, uuid.NewRandom(), passphrase); err != nil {
+		return blscrypto.SerializedPublicKey{}, err
+	}
+	// Make the key available right away if the account is unlocked
+	ks.mu.Lock()
+	if u, found := ks.unlocked[a.Address]; found {
+		u.bls[publicKey] = common.CopyBytes(privateKey)
+	}
+	ks.mu.Unlock()
+	return publicKey, nil
+}
+
+// deleteBLSKeys removes the BLS key files of addr.
+func (ks *KeyStore) deleteBLSKeys(addr common.Address) error {
+	ks.importMu.Lock()
+	defer ks.importMu.Unlock()
+
+	files, err := ks.blsKeyFiles(addr)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := os.Remove(file.path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storeBLSKey encrypts the BLS private key with the passphrase into a key file.
+func (ks *KeyStore) storeBLSKey(path string, addr common.Address, publicKey blscrypto.SerializedPublicKey, privateKey []byte, id uuid.UUID, passphrase string) error {
+	scryptN, scryptP := StandardScryptN, StandardScryptP
+	if store, ok := ks.storage.(*keyStorePassphrase); ok {
+		scryptN, scryptP = store.scryptN, store.scryptP
+	}
+	cryptoStruct, err := EncryptDataV3(privateKey, []byte(passphrase), scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(&encryptedBLSKeyJSON{
+		Address:      hex.EncodeToString(addr[:]),
+		BLSPublicKey: hex.EncodeToString(publicKey[:]),
+		Crypto:       cryptoStruct,
+		Id:           id.String(),
+		Version:      version,
+	})
+	if err != nil {
+		return err
+	}
+	return writeKeyFile(path, content)
+}
+
+// decryptBLSKeys decrypts the independent BLS keys of addr. Keys which can't
+// be decrypted with the passphrase are skipped.
+func (ks *KeyStore) decryptBLSKeys(addr common.Address, passphrase string) (map[blscrypto.SerializedPublicKey][]byte, error) {
+	files, err := ks.blsKeyFiles(addr)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[blscrypto.SerializedPublicKey][]byte, len(files))
+	for _, file := range files {
+		privateKey, err := DecryptDataV3(file.json.Crypto, passphrase)
+		if err != nil {
+			log.Warn("Failed to decrypt BLS key", "address", addr, "path", file.path, "err", err)
+			continue
+		}
+		if publicKey, err := blscrypto.PrivateToPublic(privateKey); err != nil || publicKey != file.publicKey {
+			log.Warn("Mismatching BLS key", "address", addr, "path", file.path, "err", err)
+			zeroBytes(privateKey)
+			continue
+		}
+		keys[file.publicKey] = privateKey
+	}
+	return keys, nil
+}
+
+// decryptBLSKey decrypts the BLS key of the account with the given public key,
+// or derives it from the ECDSA key if publicKey is empty.
+func (ks *KeyStore) decryptBLSKey(a accounts.Account, passphrase string, publicKey blscrypto.SerializedPublicKey) ([]byte, error) {
+	a, key, err := ks.getDecryptedKey(a, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroKey(key.PrivateKey)
+
+	var keys map[blscrypto.SerializedPublicKey][]byte
+	if publicKey != (blscrypto.SerializedPublicKey{}) {
+		if keys, err = ks.decryptBLSKeys(a.Address, passphrase); err != nil {
+			return nil, err
+		}
+		defer zeroBLSKeys(keys)
+	}
+	return blsPrivateKey(key.PrivateKey, keys, publicKey)
+}
+
+// updateBLSKeys re-encrypts the independent BLS keys of addr with a new passphrase.
+func (ks *KeyStore) updateBLSKeys(addr common.Address, passphrase, newPassphrase string) error {
+	files, err := ks.blsKeyFiles(addr)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		privateKey, err := DecryptDataV3(file.json.Crypto, passphrase)
+		if err != nil {
+			return err
+		}
+		err = ks.storeBLSKey(file.path, addr, file.publicKey, privateKey, uuid.Parse(file.json.Id), newPassphrase)
+		zeroBytes(privateKey)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blsPrivateKey returns the BLS private key with the given public key, among the
+// independent keys of an account and the one derived from its ECDSA key. The
+// derived key is also returned for the empty public key.
+func blsPrivateKey(key *ecdsa.PrivateKey, keys map[blscrypto.SerializedPublicKey][]byte, publicKey blscrypto.SerializedPublicKey) ([]byte, error) {
+	if privateKey, ok := keys[publicKey]; ok {
+		return common.CopyBytes(privateKey), nil
+	}
+	derived, err := blscrypto.ECDSAToBLS(key)
+	if err != nil {
+		return nil, err
+	}
+	if publicKey == (blscrypto.SerializedPublicKey{}) {
+		return derived, nil
+	}
+	if derivedPublicKey, err := blscrypto.PrivateToPublic(derived); err != nil || derivedPublicKey != publicKey {
+		zeroBytes(derived)
+		return nil, ErrUnknownBLSKey
+	}
+	return derived, nil
+}
+
+// zeroBLSKeys zeroes a set of BLS private keys in memory.
+func zeroBLSKeys(keys map[blscrypto.SerializedPublicKey][]byte) {
+	for _, key := range keys {
+		zeroBytes(key)
+	}
+}
+
+// zeroBytes zeroes a private key in memory.
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -84,6 +84,7 @@ type KeyStore struct {
 
 type unlocked struct {
 	*Key
+	bls   map[blscrypto.SerializedPublicKey][]byte // Independent BLS keys of the account
 	abort chan struct{}
 }
 
@@ -277,6 +278,11 @@ func (ks *KeyStore) Delete(a accounts.Account, passphrase string) error {
 	if err == nil {
 		ks.cache.delete(a)
 		ks.refreshWallets()
+
+		// The BLS keys go with the last key file of the address
+		if !ks.cache.hasAddress(a.Address) {
+			err = ks.deleteBLSKeys(a.Address)
+		}
 	}
 	return err
 }
@@ -296,7 +302,9 @@ func (ks *KeyStore) SignHash(a accounts.Account, hash []byte) ([]byte, error) {
 	return crypto.Sign(hash, unlockedKey.PrivateKey)
 }
 
-func (ks *KeyStore) SignBLS(a accounts.Account, msg []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
+// SignBLS signs msg with the BLS key of the account with the given public key,
+// or with the key derived from its ECDSA key if publicKey is empty.
+func (ks *KeyStore) SignBLS(a accounts.Account, publicKey blscrypto.SerializedPublicKey, msg []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
 	// Look up the key to sign with and abort if it cannot be found
 	ks.mu.RLock()
 	defer ks.mu.RUnlock()
@@ -305,12 +313,64 @@ func (ks *KeyStore) SignBLS(a accounts.Account, msg []byte, extraData []byte, us
 	if !found {
 		return blscrypto.SerializedSignature{}, ErrLocked
 	}
-
-	privateKeyBytes, err := blscrypto.ECDSAToBLS(unlockedKey.PrivateKey)
+	privateKey, err := blsPrivateKey(unlockedKey.PrivateKey, unlockedKey.bls, publicKey)
 	if err != nil {
 		return blscrypto.SerializedSignature{}, err
 	}
+	defer zeroBytes(privateKey)
+	return signBLS(privateKey, msg, extraData, useComposite, cip22)
+}
+
+func (ks *KeyStore) GenerateProofOfPossession(a accounts.Account, address common.Address) ([]byte, []byte, error) {
+	// Look up the key to sign with and abort if it cannot be found
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	unlockedKey, found := ks.unlocked[a.Address]
+	if !found {
+		return nil, nil, ErrLocked
+	}
+	return proofOfPossession(unlockedKey.PrivateKey, address)
+}
+
+func (ks *KeyStore) GenerateProofOfPossessionBLS(a accounts.Account, address common.Address) ([]byte, []byte, error) {
+	// Look up the key to sign with and abort if it cannot be found
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
 
+	unlockedKey, found := ks.unlocked[a.Address]
+	if !found {
+		return nil, nil, ErrLocked
+	}
+	privateKey, err := blscrypto.ECDSAToBLS(unlockedKey.PrivateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer zeroBytes(privateKey)
+	return proofOfPossessionBLS(privateKey, address)
+}
+
+// GenerateProofOfPossessionBLSKey returns the BLS key of the account with the
+// given public key, and its proof of possession signature over address.
+func (ks *KeyStore) GenerateProofOfPossessionBLSKey(a accounts.Account, publicKey blscrypto.SerializedPublicKey, address common.Address) ([]byte, []byte, error) {
+	// Look up the key to sign with and abort if it cannot be found
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	unlockedKey, found := ks.unlocked[a.Address]
+	if !found {
+		return nil, nil, ErrLocked
+	}
+	privateKey, err := blsPrivateKey(unlockedKey.PrivateKey, unlockedKey.bls, publicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer zeroBytes(privateKey)
+	return proofOfPossessionBLS(privateKey, address)
+}
+
+// signBLS signs msg with the given serialized BLS private key.
+func signBLS(privateKeyBytes []byte, msg []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
 	privateKey, err := bls.DeserializePrivateKey(privateKeyBytes)
 	if err != nil {
 		return blscrypto.SerializedSignature{}, err
@@ -330,12 +390,10 @@ func (ks *KeyStore) SignBLS(a accounts.Account, msg []byte, extraData []byte, us
 	return blscrypto.SerializedSignatureFromBytes(signatureBytes)
 }
 
-func (ks *KeyStore) GenerateProofOfPossession(a accounts.Account, address common.Address) ([]byte, []byte, error) {
-	publicKey, err := ks.GetPublicKey(a)
-	if err != nil {
-		return nil, nil, err
-	}
-	publicKeyBytes := crypto.FromECDSAPub(publicKey)
+// proofOfPossession returns the ECDSA public key of key and its signature over
+// the given address.
+func proofOfPossession(key *ecdsa.PrivateKey, address common.Address) ([]byte, []byte, error) {
+	publicKeyBytes := crypto.FromECDSAPub(&key.PublicKey)
 
 	hash := crypto.Keccak256(address.Bytes())
 	log.Info("msg", "msg", hexutil.Encode(hash))
@@ -343,28 +401,16 @@ func (ks *KeyStore) GenerateProofOfPossession(a accounts.Account, address common
 	hash = crypto.Keccak256([]byte(msg))
 	log.Info("hash", "hash", hexutil.Encode(hash))
 
-	signature, err := ks.SignHash(a, hash)
+	signature, err := crypto.Sign(hash, key)
 	if err != nil {
 		return nil, nil, err
 	}
 	return publicKeyBytes, signature, nil
 }
 
-func (ks *KeyStore) GenerateProofOfPossessionBLS(a accounts.Account, address common.Address) ([]byte, []byte, error) {
-	// Look up the key to sign with and abort if it cannot be found
-	ks.mu.RLock()
-	defer ks.mu.RUnlock()
-
-	unlockedKey, found := ks.unlocked[a.Address]
-	if !found {
-		return nil, nil, ErrLocked
-	}
-
-	privateKeyBytes, err := blscrypto.ECDSAToBLS(unlockedKey.PrivateKey)
-	if err != nil {
-		return nil, nil, err
-	}
-
+// proofOfPossessionBLS returns the public key of the given serialized BLS
+// private key and its proof of possession signature over address.
+func proofOfPossessionBLS(privateKeyBytes []byte, address common.Address) ([]byte, []byte, error) {
 	privateKey, err := bls.DeserializePrivateKey(privateKeyBytes)
 	if err != nil {
 		return nil, nil, err
@@ -435,6 +481,74 @@ func (ks *KeyStore) SignHashWithPassphrase(a accounts.Account, passphrase string
 	return crypto.Sign(hash, key.PrivateKey)
 }
 
+// DecryptWithPassphrase decrypts an ECIES ciphertext if the private key matching
+// the given address can be decrypted with the given passphrase.
+func (ks *KeyStore) DecryptWithPassphrase(a accounts.Account, passphrase string, c, s1, s2 []byte) ([]byte, error) {
+	_, key, err := ks.getDecryptedKey(a, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroKey(key.PrivateKey)
+	return ecies.ImportECDSA(key.PrivateKey).Decrypt(c, s1, s2)
+}
+
+// SignBLSWithPassphrase signs msg with the account's BLS key with the given
+// public key, or the one derived from its ECDSA key if publicKey is empty, if
+// the private key can be decrypted with the given passphrase.
+func (ks *KeyStore) SignBLSWithPassphrase(a accounts.Account, passphrase string, publicKey blscrypto.SerializedPublicKey, msg []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
+	privateKey, err := ks.decryptBLSKey(a, passphrase, publicKey)
+	if err != nil {
+		return blscrypto.SerializedSignature{}, err
+	}
+	defer zeroBytes(privateKey)
+	return signBLS(privateKey, msg, extraData, useComposite, cip22)
+}
+
+// GenerateProofOfPossessionWithPassphrase is the passphrase variant of
+// GenerateProofOfPossession.
+func (ks *KeyStore) GenerateProofOfPossessionWithPassphrase(a accounts.Account, passphrase string, address common.Address) ([]byte, []byte, error) {
+	_, key, err := ks.getDecryptedKey(a, passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer zeroKey(key.PrivateKey)
+	return proofOfPossession(key.PrivateKey, address)
+}
+
+// GenerateProofOfPossessionBLSWithPassphrase is the passphrase variant of
+// GenerateProofOfPossessionBLS.
+func (ks *KeyStore) GenerateProofOfPossessionBLSWithPassphrase(a accounts.Account, passphrase string, address common.Address) ([]byte, []byte, error) {
+	privateKey, err := ks.decryptBLSKey(a, passphrase, blscrypto.SerializedPublicKey{})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer zeroBytes(privateKey)
+	return proofOfPossessionBLS(privateKey, address)
+}
+
+// GenerateProofOfPossessionBLSKeyWithPassphrase is the passphrase variant of
+// GenerateProofOfPossessionBLSKey.
+func (ks *KeyStore) GenerateProofOfPossessionBLSKeyWithPassphrase(a accounts.Account, passphrase string, publicKey blscrypto.SerializedPublicKey, address common.Address) ([]byte, []byte, error) {
+	privateKey, err := ks.decryptBLSKey(a, passphrase, publicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer zeroBytes(privateKey)
+	return proofOfPossessionBLS(privateKey, address)
+}
+
+// GetPublicKeyWithPassphrase retrieves the ECDSA public key of the account if its
+// private key can be decrypted with the given passphrase.
+func (ks *KeyStore) GetPublicKeyWithPassphrase(a accounts.Account, passphrase string) (*ecdsa.PublicKey, error) {
+	_, key, err := ks.getDecryptedKey(a, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	publicKey := key.PrivateKey.PublicKey
+	zeroKey(key.PrivateKey)
+	return &publicKey, nil
+}
+
 // SignTxWithPassphrase signs the transaction if the private key matching the
 // given address can be decrypted with the given passphrase.
 func (ks *KeyStore) SignTxWithPassphrase(a accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
@@ -480,6 +594,11 @@ func (ks *KeyStore) TimedUnlock(a accounts.Account, passphrase string, timeout t
 	if err != nil {
 		return err
 	}
+	blsKeys, err := ks.decryptBLSKeys(a.Address, passphrase)
+	if err != nil {
+		zeroKey(key.PrivateKey)
+		return err
+	}
 
 	ks.mu.Lock()
 	defer ks.mu.Unlock()
@@ -489,16 +608,17 @@ func (ks *KeyStore) TimedUnlock(a accounts.Account, passphrase string, timeout t
 			// The address was unlocked indefinitely, so unlocking
 			// it with a timeout would be confusing.
 			zeroKey(key.PrivateKey)
+			zeroBLSKeys(blsKeys)
 			return nil
 		}
 		// Terminate the expire goroutine and replace it below.
 		close(u.abort)
 	}
 	if timeout > 0 {
-		u = &unlocked{Key: key, abort: make(chan struct{})}
+		u = &unlocked{Key: key, bls: blsKeys, abort: make(chan struct{})}
 		go ks.expire(a.Address, u, timeout)
 	} else {
-		u = &unlocked{Key: key}
+		u = &unlocked{Key: key, bls: blsKeys}
 	}
 	ks.unlocked[a.Address] = u
 	return nil
@@ -536,6 +656,7 @@ func (ks *KeyStore) expire(addr common.Address, u *unlocked, timeout time.Durati
 		// unlocked.
 		if ks.unlocked[addr] == u {
 			zeroKey(u.PrivateKey)
+			zeroBLSKeys(u.bls)
 			delete(ks.unlocked, addr)
 		}
 		ks.mu.Unlock()
@@ -621,6 +742,9 @@ func (ks *KeyStore) Update(a accounts.Account, passphrase, newPassphrase string)
 	if err != nil {
 		return err
 	}
+	if err := ks.updateBLSKeys(a.Address, passphrase, newPassphrase); err != nil {
+		return err
+	}
 	return ks.storage.StoreKey(a.URL.Path, key, newPassphrase)
 }
 
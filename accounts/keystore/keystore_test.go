@@ -17,10 +17,14 @@
 package keystore
 
 import (
+	"bytes"
+	crand "crypto/rand"
 	"encoding/hex"
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
+	"reflect"
 	"runtime"
 	"sort"
 	"strings"
@@ -32,7 +36,10 @@ import (
 	"github.com/aaronwinter/celo-blockchain/accounts"
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/crypto"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
+	"github.com/aaronwinter/celo-blockchain/crypto/ecies"
 	"github.com/aaronwinter/celo-blockchain/event"
+	"github.com/celo-org/celo-bls-go/bls"
 )
 
 var testSigData = make([]byte, 32)
@@ -138,6 +145,171 @@ func TestSignWithPassphrase(t *testing.T) {
 	}
 }
 
+func TestCeloOpsWithPassphrase(t *testing.T) {
+	dir, ks := tmpKeyStore(t, true)
+	defer os.RemoveAll(dir)
+
+	pass := "passwd"
+	acc, err := ks.NewAccount(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signee := common.HexToAddress("0x01")
+
+	blsSig, err := ks.SignBLSWithPassphrase(acc, pass, blscrypto.SerializedPublicKey{}, testSigData, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blsPub, blsPoP, err := ks.GenerateProofOfPossessionBLSWithPassphrase(acc, pass, signee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := ks.GetPublicKeyWithPassphrase(acc, pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, unlocked := ks.unlocked[acc.Address]; unlocked {
+		t.Fatal("expected account to be locked")
+	}
+
+	// The results must match the ones obtained with the unlocked account
+	if err := ks.Unlock(acc, pass); err != nil {
+		t.Fatal(err)
+	}
+	if sig, err := ks.SignBLS(acc, blscrypto.SerializedPublicKey{}, testSigData, nil, false, false); err != nil || sig != blsSig {
+		t.Fatalf("BLS signature mismatch: have %x, want %x (err %v)", sig, blsSig, err)
+	}
+	if pk, pop, err := ks.GenerateProofOfPossessionBLS(acc, signee); err != nil || !bytes.Equal(pk, blsPub) || !bytes.Equal(pop, blsPoP) {
+		t.Fatalf("BLS proof-of-possession mismatch (err %v)", err)
+	}
+	if unlockedPub, err := ks.GetPublicKey(acc); err != nil || !unlockedPub.Equal(pub) {
+		t.Fatalf("public key mismatch (err %v)", err)
+	}
+
+	plaintext := []byte("enode://")
+	ciphertext, err := ecies.Encrypt(crand.Reader, ecies.ImportECDSAPublic(pub), plaintext, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted, err := ks.DecryptWithPassphrase(acc, pass, ciphertext, nil, nil); err != nil || !bytes.Equal(decrypted, plaintext) {
+		t.Fatalf("decryption mismatch: have %q (err %v)", decrypted, err)
+	}
+	if _, err := ks.SignBLSWithPassphrase(acc, "invalid passwd", blscrypto.SerializedPublicKey{}, testSigData, nil, false, false); err == nil {
+		t.Fatal("expected SignBLSWithPassphrase to fail with invalid password")
+	}
+}
+
+func TestBLSKeys(t *testing.T) {
+	dir, ks := tmpKeyStore(t, true)
+	defer os.RemoveAll(dir)
+
+	pass := "passwd"
+	acc, err := ks.NewAccount(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.NewBLSKey(acc, "invalid passwd"); err != ErrDecrypt {
+		t.Fatalf("expected ErrDecrypt for invalid password, got %v", err)
+	}
+	generated, err := ks.NewBLSKey(acc, pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey, err := bls.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer privateKey.Destroy()
+	privateKeyBytes, err := privateKey.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := ks.ImportBLSKey(acc, pass, privateKeyBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.ImportBLSKey(acc, pass, privateKeyBytes); err != ErrBLSKeyAlreadyExists {
+		t.Fatalf("expected ErrBLSKeyAlreadyExists for duplicate import, got %v", err)
+	}
+	if keys, err := ks.BLSPublicKeys(acc); err != nil || !reflect.DeepEqual(keys, []blscrypto.SerializedPublicKey{generated, imported}) {
+		t.Fatalf("BLS key list mismatch: have %x, want %x (err %v)", keys, []blscrypto.SerializedPublicKey{generated, imported}, err)
+	}
+	// BLS key files must not be mistaken for accounts
+	if accs := ks.Accounts(); len(accs) != 1 {
+		t.Fatalf("expected 1 account, got %d", len(accs))
+	}
+
+	// Signatures are made with the selected key, and survive passphrase updates
+	sig, err := ks.SignBLSWithPassphrase(acc, pass, imported, testSigData, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := blscrypto.VerifySignature(imported, testSigData, nil, sig[:], false, false); err != nil {
+		t.Fatalf("signature not made with the imported key: %v", err)
+	}
+	newPass := "new passwd"
+	if err := ks.Update(acc, pass, newPass); err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.Unlock(acc, newPass); err != nil {
+		t.Fatal(err)
+	}
+	if unlockedSig, err := ks.SignBLS(acc, imported, testSigData, nil, false, false); err != nil || unlockedSig != sig {
+		t.Fatalf("BLS signature mismatch: have %x, want %x (err %v)", unlockedSig, sig, err)
+	}
+	pub, pop, err := ks.GenerateProofOfPossessionBLSKey(acc, generated, common.HexToAddress("0x01"))
+	if err != nil || !bytes.Equal(pub, generated[:]) || len(pop) == 0 {
+		t.Fatalf("BLS proof-of-possession mismatch: have %x, want %x (err %v)", pub, generated, err)
+	}
+
+	// The derived key can be selected either explicitly or by the empty key
+	derivedPub, _, err := ks.GenerateProofOfPossessionBLS(acc, common.HexToAddress("0x01"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var derived blscrypto.SerializedPublicKey
+	copy(derived[:], derivedPub)
+	if _, err := ks.SignBLS(acc, derived, testSigData, nil, false, false); err != nil {
+		t.Fatalf("failed to sign with the derived key: %v", err)
+	}
+	if _, err := ks.SignBLS(acc, blscrypto.SerializedPublicKey{0x01}, testSigData, nil, false, false); err != ErrUnknownBLSKey {
+		t.Fatalf("expected ErrUnknownBLSKey, got %v", err)
+	}
+}
+
+// Tests that deleting an account removes its BLS keys, and only its own.
+func TestDeleteBLSKeys(t *testing.T) {
+	dir, ks := tmpKeyStore(t, true)
+	defer os.RemoveAll(dir)
+
+	pass := "passwd"
+	acc, err := ks.NewAccount(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := ks.NewAccount(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range []accounts.Account{acc, acc, other} {
+		if _, err := ks.NewBLSKey(a, pass); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ks.Delete(acc, pass); err != nil {
+		t.Fatal(err)
+	}
+	if keys, err := ks.BLSPublicKeys(acc); err != nil || len(keys) != 0 {
+		t.Fatalf("BLS keys of the deleted account left behind: %x (err %v)", keys, err)
+	}
+	if keys, err := ks.BLSPublicKeys(other); err != nil || len(keys) != 1 {
+		t.Fatalf("expected 1 BLS key of the other account, got %x (err %v)", keys, err)
+	}
+	if files, err := ioutil.ReadDir(filepath.Join(dir, blsKeyDir)); err != nil || len(files) != 1 {
+		t.Fatalf("expected 1 BLS key file, got %d (err %v)", len(files), err)
+	}
+}
+
 func TestTimedUnlock(t *testing.T) {
 	dir, ks := tmpKeyStore(t, true)
 	defer os.RemoveAll(dir)
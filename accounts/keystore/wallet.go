@@ -129,14 +129,14 @@ func (w *keystoreWallet) GetPublicKey(account accounts.Account) (*ecdsa.PublicKe
 	return w.keystore.GetPublicKey(account)
 }
 
-func (w *keystoreWallet) SignBLS(account accounts.Account, msg []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
+func (w *keystoreWallet) SignBLS(account accounts.Account, publicKey blscrypto.SerializedPublicKey, msg []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
 	// Make sure the requested account is contained within
 	if !w.Contains(account) {
 		log.Debug(accounts.ErrUnknownAccount.Error(), "account", account)
 		return blscrypto.SerializedSignature{}, accounts.ErrUnknownAccount
 	}
 	// Account seems valid, request the keystore to sign
-	return w.keystore.SignBLS(account, msg, extraData, useComposite, cip22)
+	return w.keystore.SignBLS(account, publicKey, msg, extraData, useComposite, cip22)
 }
 
 func (w *keystoreWallet) GenerateProofOfPossession(account accounts.Account, address common.Address) ([]byte, []byte, error) {
@@ -161,7 +161,7 @@ func (w *keystoreWallet) GenerateProofOfPossessionBLS(account accounts.Account,
 
 // SignData signs keccak256(data). The mimetype parameter describes the type of data being signed
 func (w *keystoreWallet) SignData(account accounts.Account, mimeType string, data []byte) ([]byte, error) {
-	return w.signHash(account, crypto.Keccak256(data))
+	return w.signHash(account, dataHash(mimeType, data))
 }
 
 // SignHash implements accounts.Wallet, attempting to sign the given hash with
@@ -182,7 +182,16 @@ func (w *keystoreWallet) SignDataWithPassphrase(account accounts.Account, passph
 		return nil, accounts.ErrUnknownAccount
 	}
 	// Account seems valid, request the keystore to sign
-	return w.keystore.SignHashWithPassphrase(account, passphrase, crypto.Keccak256(data))
+	return w.keystore.SignHashWithPassphrase(account, passphrase, dataHash(mimeType, data))
+}
+
+// dataHash returns the hash to sign for data of the given content type. The
+// istanbul randomness seed is a hash already, any other data is hashed first.
+func dataHash(mimeType string, data []byte) []byte {
+	if mimeType == accounts.MimetypeIstanbulRandomSeed {
+		return data
+	}
+	return crypto.Keccak256(data)
 }
 
 func (w *keystoreWallet) SignText(account accounts.Account, text []byte) ([]byte, error) {
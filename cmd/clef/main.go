@@ -41,8 +41,10 @@ import (
 	"github.com/aaronwinter/celo-blockchain/cmd/utils"
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/common/math"
 	"github.com/aaronwinter/celo-blockchain/core/types"
 	"github.com/aaronwinter/celo-blockchain/crypto"
+	"github.com/aaronwinter/celo-blockchain/ethclient"
 	"github.com/aaronwinter/celo-blockchain/internal/ethapi"
 	"github.com/aaronwinter/celo-blockchain/internal/flags"
 	"github.com/aaronwinter/celo-blockchain/log"
@@ -52,8 +54,10 @@ import (
 	"github.com/aaronwinter/celo-blockchain/rpc"
 	shsigner "github.com/aaronwinter/celo-blockchain/shared/signer"
 	"github.com/aaronwinter/celo-blockchain/signer/core"
+	"github.com/aaronwinter/celo-blockchain/signer/fees"
 	"github.com/aaronwinter/celo-blockchain/signer/fourbyte"
 	"github.com/aaronwinter/celo-blockchain/signer/rules"
+	"github.com/aaronwinter/celo-blockchain/signer/slashing"
 	"github.com/aaronwinter/celo-blockchain/signer/storage"
 	colorable "github.com/mattn/go-colorable"
 	"github.com/mattn/go-isatty"
@@ -141,6 +145,23 @@ var (
 		Name:  "stdio-ui-test",
 		Usage: "Mechanism to test interface between Clef and UI. Requires 'stdio-ui'.",
 	}
+	slashingDBFlag = cli.StringFlag{
+		Name:  "slashingdb",
+		Usage: "File holding the consensus slashing-protection database (default: <configdir>/slashing_protection.json)",
+	}
+	feeCurrenciesFlag = cli.StringFlag{
+		Name:  "feecurrencies",
+		Usage: "Comma separated list of token addresses transactions may pay fees in, besides the native currency (default: the FeeCurrencyWhitelist of --nodeurl)",
+	}
+	maxGatewayFeeFlag = cli.StringFlag{
+		Name:  "maxgatewayfee",
+		Usage: "Highest gateway fee (in wei of the fee currency) transactions may pay",
+		Value: fees.DefaultMaxGatewayFee.String(),
+	}
+	nodeURLFlag = cli.StringFlag{
+		Name:  "nodeurl",
+		Usage: "URL of a node used to look up the core contracts in the Registry, verifying the targets of decoded calls and the whitelisted fee currencies",
+	}
 	app         = cli.NewApp()
 	initCommand = cli.Command{
 		Action:    utils.MigrateFlags(initializeSecrets),
@@ -214,6 +235,42 @@ The newaccount command creates a new keystore-backed account. It is a convenienc
 which can be used in lieu of an external UI.`,
 	}
 
+	slashingCommand = cli.Command{
+		Name:  "slashing",
+		Usage: "Manage the consensus slashing-protection database",
+		Description: `
+Clef records the highest view at which each validator key signed istanbul consensus
+messages and seals, and refuses to sign conflicting ones. The slashing commands move
+these signing histories between machines, in the interchange format described in the
+README. They must not be used while Clef is running on the same database.`,
+		Subcommands: []cli.Command{
+			{
+				Action:    utils.MigrateFlags(exportSlashingProtection),
+				Name:      "export",
+				Usage:     "Export the signing histories to a file",
+				ArgsUsage: "<file>",
+				Flags: []cli.Flag{
+					logLevelFlag,
+					configdirFlag,
+					chainIdFlag,
+					slashingDBFlag,
+				},
+			},
+			{
+				Action:    utils.MigrateFlags(importSlashingProtection),
+				Name:      "import",
+				Usage:     "Merge the signing histories of a file into the database",
+				ArgsUsage: "<file>",
+				Flags: []cli.Flag{
+					logLevelFlag,
+					configdirFlag,
+					chainIdFlag,
+					slashingDBFlag,
+				},
+			},
+		},
+	}
+
 	gendocCommand = cli.Command{
 		Action: GenDoc,
 		Name:   "gendoc",
@@ -246,6 +303,10 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			ruleFlag,
 			stdiouiFlag,
 			testFlag,
+			slashingDBFlag,
+			feeCurrenciesFlag,
+			maxGatewayFeeFlag,
+			nodeURLFlag,
 			advancedMode,
 			acceptFlag,
 		},
@@ -280,6 +341,10 @@ func init() {
 		ruleFlag,
 		stdiouiFlag,
 		testFlag,
+		slashingDBFlag,
+		feeCurrenciesFlag,
+		maxGatewayFeeFlag,
+		nodeURLFlag,
 		advancedMode,
 		acceptFlag,
 		legacyRPCPortFlag,
@@ -290,6 +355,7 @@ func init() {
 		setCredentialCommand,
 		delCredentialCommand,
 		newAccountCommand,
+		slashingCommand,
 		gendocCommand}
 	cli.CommandHelpTemplate = flags.CommandHelpTemplate
 	// Override the default app help template
@@ -499,7 +565,7 @@ func newAccount(c *cli.Context) error {
 	log.Info("Starting clef", "keystore", ksLoc, "light-kdf", lightKdf)
 	am := core.StartClefAccountManager(ksLoc, true, lightKdf)
 	// This gives is us access to the external API
-	apiImpl := core.NewSignerAPI(am, 0, true, ui, nil, false, pwStorage)
+	apiImpl := core.NewSignerAPI(am, 0, true, ui, nil, false, pwStorage, nil)
 	// This gives us access to the internal API
 	internalApi := core.NewUIServerAPI(apiImpl)
 	addr, err := internalApi.New(context.Background())
@@ -555,6 +621,33 @@ func ipcEndpoint(ipcPath, datadir string) string {
 	return ipcPath
 }
 
+// feePolicy creates the policy on the fee currencies and gateway fees transactions
+// are allowed to pay.
+func feePolicy(c *cli.Context) (*fees.Policy, error) {
+	policy := fees.NewPolicy()
+	if c.GlobalIsSet(feeCurrenciesFlag.Name) {
+		var currencies []common.Address
+		if list := c.GlobalString(feeCurrenciesFlag.Name); list != "" {
+			for _, currency := range strings.Split(list, ",") {
+				currency = strings.TrimSpace(currency)
+				if !common.IsHexAddress(currency) {
+					return nil, fmt.Errorf("invalid fee currency %q", currency)
+				}
+				currencies = append(currencies, common.HexToAddress(currency))
+			}
+		}
+		policy.SetFeeCurrencies(currencies)
+		log.Info("Configured fee currencies", "currencies", currencies)
+	}
+	maxGatewayFee, ok := math.ParseBig256(c.GlobalString(maxGatewayFeeFlag.Name))
+	if !ok {
+		return nil, fmt.Errorf("invalid maximum gateway fee %q", c.GlobalString(maxGatewayFeeFlag.Name))
+	}
+	policy.SetMaxGatewayFee(maxGatewayFee)
+	log.Info("Configured maximum gateway fee", "maxgatewayfee", maxGatewayFee)
+	return policy, nil
+}
+
 func signer(c *cli.Context) error {
 	// If we have some unrecognized command, bail out
 	if args := c.Args(); len(args) > 0 {
@@ -581,6 +674,22 @@ func signer(c *cli.Context) error {
 	}
 	embeds, locals := db.Size()
 	log.Info("Loaded 4byte database", "embeds", embeds, "locals", locals, "local", fourByteLocal)
+	policy, err := feePolicy(c)
+	if err != nil {
+		utils.Fatalf("Invalid fee policy: %v", err)
+	}
+	if url := c.GlobalString(nodeURLFlag.Name); url != "" {
+		client, err := ethclient.Dial(url)
+		if err != nil {
+			utils.Fatalf("Failed to connect to node %s: %v", url, err)
+		}
+		db.SetRegistry(client)
+		policy.SetWhitelist(client)
+		log.Info("Resolving core contracts and fee currencies through the Registry", "node", url)
+	} else if !c.GlobalIsSet(feeCurrenciesFlag.Name) {
+		log.Warn("Fee currencies are neither configured nor looked up, only native fees are allowed")
+	}
+	db.SetFeePolicy(policy)
 
 	var (
 		api       core.ExternalAPI
@@ -636,7 +745,11 @@ func signer(c *cli.Context) error {
 	log.Info("Starting signer", "chainid", chainId, "keystore", ksLoc,
 		"light-kdf", lightKdf, "advanced", advanced)
 	am := core.StartClefAccountManager(ksLoc, nousb, lightKdf)
-	apiImpl := core.NewSignerAPI(am, chainId, nousb, ui, db, advanced, pwStorage)
+	protection, err := openSlashingProtection(c)
+	if err != nil {
+		utils.Fatalf("Failed to open slashing-protection database: %v", err)
+	}
+	apiImpl := core.NewSignerAPI(am, chainId, nousb, ui, db, advanced, pwStorage, protection)
 
 	// Establish the bidirectional communication, by creating a new UI backend and registering
 	// it with the UI.
@@ -735,6 +848,53 @@ func signer(c *cli.Context) error {
 
 // splitAndTrim splits input separated by a comma
 // and trims excessive white space from the substrings.
+// openSlashingProtection opens the slashing-protection database of the chain
+// configured on the command line.
+func openSlashingProtection(c *cli.Context) (*slashing.Database, error) {
+	path := c.GlobalString(slashingDBFlag.Name)
+	if path == "" {
+		configDir := c.GlobalString(configdirFlag.Name)
+		if err := os.MkdirAll(configDir, 0700); err != nil {
+			return nil, err
+		}
+		path = filepath.Join(configDir, "slashing_protection.json")
+	}
+	log.Info("Using slashing-protection database", "path", path)
+	return slashing.Open(path, big.NewInt(c.GlobalInt64(chainIdFlag.Name)))
+}
+
+func exportSlashingProtection(c *cli.Context) error {
+	if len(c.Args()) != 1 {
+		utils.Fatalf("This command requires a file to be passed as an argument")
+	}
+	protection, err := openSlashingProtection(c)
+	if err != nil {
+		utils.Fatalf("Failed to open slashing-protection database: %v", err)
+	}
+	f, err := os.OpenFile(c.Args().First(), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		utils.Fatalf("Failed to create export file: %v", err)
+	}
+	defer f.Close()
+	return protection.Export(f)
+}
+
+func importSlashingProtection(c *cli.Context) error {
+	if len(c.Args()) != 1 {
+		utils.Fatalf("This command requires a file to be passed as an argument")
+	}
+	protection, err := openSlashingProtection(c)
+	if err != nil {
+		utils.Fatalf("Failed to open slashing-protection database: %v", err)
+	}
+	f, err := os.Open(c.Args().First())
+	if err != nil {
+		utils.Fatalf("Failed to open import file: %v", err)
+	}
+	defer f.Close()
+	return protection.Import(f)
+}
+
 func splitAndTrim(input string) []string {
 	result := strings.Split(input, ",")
 	for i, r := range result {
@@ -926,6 +1086,13 @@ func testExternalUI(api *core.SignerAPI) {
 		_, err := api.SignData(ctx, accounts.MimetypeTextPlain, *addr, hexutil.Encode([]byte("hello world")))
 		expectDeny("signdata - text", err)
 	}
+	{ // Sign BLS reject
+		api.UI.ShowInfo("Please deny the next request for signing a consensus message with a BLS key")
+		time.Sleep(delay)
+		addr, _ := common.NewMixedcaseAddressFromString("0x0011223344556677889900112233445566778899")
+		_, err := api.SignBLS(ctx, *addr, hexutil.Bytes("hello world"), nil, false, false, nil)
+		expectDeny("signbls", err)
+	}
 	{ // Sign transaction
 
 		api.UI.ShowInfo("Please reject next transaction")
@@ -1050,6 +1217,44 @@ func GenDoc(ctx *cli.Context) {
 		add("SignDataResponse - deny", "Response to SignDataRequest",
 			&core.SignDataResponse{})
 	}
+	{ // Sign BLS request
+		desc := "SignBLSRequest contains information about a pending request to sign a consensus message " +
+			"with the BLS key of a validator. The `extra_data` is hashed along with the `message` when the " +
+			"composite hasher is used, e.g. for epoch snark data."
+		add("SignBLSRequest", desc, &core.SignBLSRequest{
+			Address:      common.NewMixedcaseAddress(a),
+			Message:      hexutil.Bytes{0x01, 0x02, 0x03, 0x04},
+			ExtraData:    hexutil.Bytes{},
+			UseComposite: false,
+			Meta:         meta,
+		})
+		add("SignBLSResponse - approve", "Response to SignBLSRequest",
+			&core.SignBLSResponse{Approved: true})
+	}
+	{ // Decrypt request
+		desc := "DecryptRequest contains information about a pending request to decrypt an ECIES ciphertext, " +
+			"such as the encrypted enode URL of an istanbul announce message. The plaintext is returned to the caller."
+		add("DecryptRequest", desc, &core.DecryptRequest{
+			Address:    common.NewMixedcaseAddress(a),
+			Ciphertext: hexutil.Bytes{0x04, 0x03, 0x02, 0x01},
+			Meta:       meta,
+		})
+		add("DecryptResponse - approve", "Response to DecryptRequest",
+			&core.DecryptResponse{Approved: true})
+	}
+	{ // Proof-of-possession request
+		desc := "ProofOfPossessionRequest contains information about a pending request to sign the `signee` " +
+			"address with the ECDSA or BLS key of the account, proving possession of the key, e.g. to register " +
+			"a validator or update its BLS public key."
+		add("ProofOfPossessionRequest", desc, &core.ProofOfPossessionRequest{
+			Address: common.NewMixedcaseAddress(a),
+			Signee:  b,
+			BLS:     true,
+			Meta:    meta,
+		})
+		add("ProofOfPossessionResponse - approve", "Response to ProofOfPossessionRequest",
+			&core.ProofOfPossessionResponse{Approved: true})
+	}
 	{ // Sign transaction request
 		desc := "SignTxRequest contains information about a pending request to sign a transaction. " +
 			"Aside from the transaction itself, there is also a `call_info`-struct. That struct contains " +
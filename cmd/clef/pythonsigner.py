@@ -96,6 +96,27 @@ class StdIOHandler():
         """
         return {"approved": False, "password" : None}
 
+    @public
+    def ApproveSignBLS(self, req):
+        """ Example request
+
+        """
+        return {"approved": False}
+
+    @public
+    def ApproveDecrypt(self, req):
+        """ Example request
+
+        """
+        return {"approved": False}
+
+    @public
+    def ApproveProofOfPossession(self, req):
+        """ Example request
+
+        """
+        return {"approved": False}
+
     @public
     def ApproveExport(self, req):
         """ Example request
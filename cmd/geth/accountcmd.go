@@ -20,6 +20,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"github.com/aaronwinter/celo-blockchain/accounts"
 	"github.com/aaronwinter/celo-blockchain/accounts/keystore"
@@ -27,6 +28,7 @@ import (
 	"github.com/aaronwinter/celo-blockchain/cmd/utils"
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/crypto"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
 	"github.com/aaronwinter/celo-blockchain/log"
 	cli "gopkg.in/urfave/cli.v1"
 )
@@ -36,6 +38,10 @@ var (
 		Name:  "bls",
 		Usage: "Set to specify generation of proof-of-possession of a BLS key.",
 	}
+	blsKeyFlag = cli.StringFlag{
+		Name:  "blskey",
+		Usage: "Public key of the BLS key to generate the proof-of-possession of (defaults to the key derived from the signer key)",
+	}
 	walletCommand = cli.Command{
 		Name:      "wallet",
 		Usage:     "Manage Ethereum presale wallets",
@@ -118,6 +124,7 @@ Print a short summary of all accounts`,
 					utils.PasswordFileFlag,
 					utils.LightKDFFlag,
 					blsFlag,
+					blsKeyFlag,
 				},
 				Description: `
 Print a proof-of-possession signature for the given account.
@@ -126,6 +133,9 @@ Creates a signature over the given message, for example an account address,
 with the private key corresponding to a given signer address. The signature
 can then be used to prove possession of the signing key, for example to
 authorize a the signer to act as a validator in the Celo protocol.
+
+With --bls, the proof-of-possession is made with the BLS key derived from the
+signer key. Independent BLS keys of the signer are selected with --blskey.
 `,
 			},
 			{
@@ -233,6 +243,90 @@ this import mechanism is not needed when you transfer an account between
 nodes.
 `,
 			},
+			{
+				Name:  "bls",
+				Usage: "Manage the BLS keys of an account",
+				Description: `
+Besides the BLS key derived from its private key, an account can hold any number
+of independent BLS keys. They are stored under <KEYSTORE>/bls, encrypted with
+the password of the account, which allows rotating the BLS key of a validator
+without changing its signer address.
+
+Validators sign consensus messages with the BLS key registered in the current
+validator set, so keys must be kept until they are no longer registered.`,
+				Subcommands: []cli.Command{
+					{
+						Name:      "list",
+						Usage:     "Print the independent BLS keys of an account",
+						Action:    utils.MigrateFlags(accountBLSList),
+						ArgsUsage: "<signer address>",
+						Flags: []cli.Flag{
+							utils.DataDirFlag,
+							utils.KeyStoreDirFlag,
+						},
+						Description: `
+    geth account bls list <signer address>
+
+Print the public keys of the independent BLS keys of an account, oldest first.`,
+					},
+					{
+						Name:      "new",
+						Usage:     "Create a new BLS key for an account",
+						Action:    utils.MigrateFlags(accountBLSCreate),
+						ArgsUsage: "<signer address>",
+						Flags: []cli.Flag{
+							utils.DataDirFlag,
+							utils.KeyStoreDirFlag,
+							utils.PasswordFileFlag,
+							utils.LightKDFFlag,
+						},
+						Description: `
+    geth account bls new <signer address>
+
+Creates a new BLS key for the account and prints its public key. The key is
+encrypted with the password of the account.`,
+					},
+					{
+						Name:      "import",
+						Usage:     "Import a BLS private key into an account",
+						Action:    utils.MigrateFlags(accountBLSImport),
+						ArgsUsage: "<signer address> <keyFile>",
+						Flags: []cli.Flag{
+							utils.DataDirFlag,
+							utils.KeyStoreDirFlag,
+							utils.PasswordFileFlag,
+							utils.LightKDFFlag,
+						},
+						Description: `
+    geth account bls import <signer address> <keyfile>
+
+Imports an unencrypted BLS private key from <keyfile> into the account and
+prints its public key. The keyfile is assumed to contain the serialized private
+key in hexadecimal format.`,
+					},
+					{
+						Name:      "rotate",
+						Usage:     "Create a new BLS key and its proof-of-possession for a validator",
+						Action:    utils.MigrateFlags(accountBLSRotate),
+						ArgsUsage: "<signer address> <validator address>",
+						Flags: []cli.Flag{
+							utils.DataDirFlag,
+							utils.KeyStoreDirFlag,
+							utils.PasswordFileFlag,
+							utils.LightKDFFlag,
+						},
+						Description: `
+    geth account bls rotate <signer address> <validator address>
+
+Creates a new BLS key for the signer account and prints its public key, with a
+proof-of-possession over the validator address, as expected by
+Validators.updateBlsPublicKey.
+
+Previous keys are kept: the node keeps signing with the key registered in the
+validator set until the update takes effect.`,
+					},
+				},
+			},
 		},
 	}
 )
@@ -309,9 +403,19 @@ func accountProofOfPossession(ctx *cli.Context) error {
 	var key []byte
 	var pop []byte
 	keyType := "ECDSA"
-	if ctx.IsSet(blsFlag.Name) {
+	if ctx.IsSet(blsFlag.Name) || ctx.IsSet(blsKeyFlag.Name) {
 		keyType = "BLS"
-		key, pop, err = ks.GenerateProofOfPossessionBLS(account, message)
+		if ctx.IsSet(blsKeyFlag.Name) {
+			publicKey, decodeErr := hex.DecodeString(strings.TrimPrefix(ctx.String(blsKeyFlag.Name), "0x"))
+			if decodeErr != nil || len(publicKey) != blscrypto.PUBLICKEYBYTES {
+				utils.Fatalf("Invalid BLS public key %q", ctx.String(blsKeyFlag.Name))
+			}
+			var blsKey blscrypto.SerializedPublicKey
+			copy(blsKey[:], publicKey)
+			key, pop, err = ks.GenerateProofOfPossessionBLSKey(account, blsKey, message)
+		} else {
+			key, pop, err = ks.GenerateProofOfPossessionBLS(account, message)
+		}
 	} else {
 		key, pop, err = wallet.GenerateProofOfPossession(account, message)
 	}
@@ -522,3 +626,94 @@ func accountImport(ctx *cli.Context) error {
 	fmt.Printf("Address: {%x}\n", acct.Address)
 	return nil
 }
+
+func accountBLSList(ctx *cli.Context) error {
+	if len(ctx.Args()) != 1 {
+		utils.Fatalf("This command requires the signer address as argument.")
+	}
+	stack, _ := makeConfigNode(ctx)
+	ks := stack.AccountManager().Backends(keystore.KeyStoreType)[0].(*keystore.KeyStore)
+
+	account, err := utils.MakeAddress(ks, ctx.Args().First())
+	if err != nil {
+		utils.Fatalf("Could not list accounts: %v", err)
+	}
+	keys, err := ks.BLSPublicKeys(account)
+	if err != nil {
+		utils.Fatalf("Could not list BLS keys: %v", err)
+	}
+	for i, key := range keys {
+		fmt.Printf("BLS key #%d: %s\n", i, hex.EncodeToString(key[:]))
+	}
+	return nil
+}
+
+func accountBLSCreate(ctx *cli.Context) error {
+	if len(ctx.Args()) != 1 {
+		utils.Fatalf("This command requires the signer address as argument.")
+	}
+	stack, _ := makeConfigNode(ctx)
+	ks := stack.AccountManager().Backends(keystore.KeyStoreType)[0].(*keystore.KeyStore)
+
+	account, password := unlockAccount(ks, ctx.Args().First(), 0, utils.MakePasswordList(ctx))
+	key, err := ks.NewBLSKey(account, password)
+	if err != nil {
+		utils.Fatalf("Could not create the BLS key: %v", err)
+	}
+	fmt.Printf("Account {%x}:\n  BLS Public Key: %s\n", account.Address, hex.EncodeToString(key[:]))
+	return nil
+}
+
+func accountBLSImport(ctx *cli.Context) error {
+	if len(ctx.Args()) != 2 {
+		utils.Fatalf("This command requires the signer address and the keyfile as arguments.")
+	}
+	privateKey, err := loadBLSKey(ctx.Args()[1])
+	if err != nil {
+		utils.Fatalf("Failed to load the BLS private key: %v", err)
+	}
+	stack, _ := makeConfigNode(ctx)
+	ks := stack.AccountManager().Backends(keystore.KeyStoreType)[0].(*keystore.KeyStore)
+
+	account, password := unlockAccount(ks, ctx.Args().First(), 0, utils.MakePasswordList(ctx))
+	key, err := ks.ImportBLSKey(account, password, privateKey)
+	if err != nil {
+		utils.Fatalf("Could not import the BLS key: %v", err)
+	}
+	fmt.Printf("Account {%x}:\n  BLS Public Key: %s\n", account.Address, hex.EncodeToString(key[:]))
+	return nil
+}
+
+func accountBLSRotate(ctx *cli.Context) error {
+	if len(ctx.Args()) != 2 {
+		utils.Fatalf("This command requires the signer address and the validator address as arguments.")
+	}
+	if !common.IsHexAddress(ctx.Args()[1]) {
+		utils.Fatalf("Validator address is an invalid address")
+	}
+	validator := common.HexToAddress(ctx.Args()[1])
+
+	stack, _ := makeConfigNode(ctx)
+	ks := stack.AccountManager().Backends(keystore.KeyStoreType)[0].(*keystore.KeyStore)
+
+	account, password := unlockAccount(ks, ctx.Args().First(), 0, utils.MakePasswordList(ctx))
+	key, err := ks.NewBLSKey(account, password)
+	if err != nil {
+		utils.Fatalf("Could not create the BLS key: %v", err)
+	}
+	publicKey, pop, err := ks.GenerateProofOfPossessionBLSKey(account, key, validator)
+	if err != nil {
+		utils.Fatalf("Could not generate the proof-of-possession: %v", err)
+	}
+	printProofOfPossession(account, pop, "BLS", publicKey)
+	return nil
+}
+
+// loadBLSKey reads a serialized BLS private key in hexadecimal format from file.
+func loadBLSKey(file string) ([]byte, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(strings.TrimSpace(string(content)))
+}
@@ -152,6 +152,32 @@ Repeat password: {{.InputLine "foobar2"}}
 `)
 }
 
+func TestAccountBLSImport(t *testing.T) {
+	datadir := tmpDatadirWithKeystore(t)
+	keyfile := filepath.Join(datadir, "bls.prv")
+	if err := ioutil.WriteFile(keyfile, []byte("02efe19e078eed91c6646edafc30024035597613556470ec04d0d65cef86f002"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	geth := runGeth(t, "account", "bls", "import",
+		"--datadir", datadir, "--lightkdf",
+		"f466859ead1932d743d622cb74fc058882e8648a", keyfile)
+	geth.Expect(`
+Unlocking account f466859ead1932d743d622cb74fc058882e8648a | Attempt 1/3
+!! Unsupported terminal, password will be echoed.
+Password: {{.InputLine "foobar"}}
+Account {f466859ead1932d743d622cb74fc058882e8648a}:
+  BLS Public Key: a6dbcc80b0dc0fefde4e410bdae1fc87b8ec8f4c106e4c58062584c8dc5b874c23a51e2bcd8d65794e4cebd9d509b300c56620c4076a909b6ef0a2b0956716c6236da80a0861b7a2b9bbfb3c429f971c572de95be11733eda85899ae13f16480
+`)
+	geth.ExpectExit()
+
+	// The imported key is listed for the account
+	geth = runGeth(t, "account", "bls", "list", "--datadir", datadir, "f466859ead1932d743d622cb74fc058882e8648a")
+	defer geth.ExpectExit()
+	geth.Expect(`
+BLS key #0: a6dbcc80b0dc0fefde4e410bdae1fc87b8ec8f4c106e4c58062584c8dc5b874c23a51e2bcd8d65794e4cebd9d509b300c56620c4076a909b6ef0a2b0956716c6236da80a0861b7a2b9bbfb3c429f971c572de95be11733eda85899ae13f16480
+`)
+}
+
 func TestWalletImport(t *testing.T) {
 	geth := runGeth(t, "wallet", "import", "--lightkdf", "testdata/guswallet.json")
 	defer geth.ExpectExit()
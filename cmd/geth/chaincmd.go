@@ -28,6 +28,7 @@ import (
 
 	"github.com/aaronwinter/celo-blockchain/cmd/utils"
 	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend"
 	"github.com/aaronwinter/celo-blockchain/console/prompt"
 	"github.com/aaronwinter/celo-blockchain/core"
 	"github.com/aaronwinter/celo-blockchain/core/rawdb"
@@ -124,6 +125,28 @@ Optional second and third arguments control the first and
 last block to write. In this mode, the file will be appended
 if already existing. If the file ends with .gz, the output will
 be gzipped.`,
+	}
+	exportEpochsCommand = cli.Command{
+		Action:    utils.MigrateFlags(exportEpochs),
+		Name:      "export-epochs",
+		Usage:     "Export the epoch blocks into an epoch bundle",
+		ArgsUsage: "<filename> [<blockNumLast>]",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.AlfajoresFlag,
+			utils.BaklavaFlag,
+			utils.CacheFlag,
+			utils.SyncModeFlag,
+		},
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+Writes the last block of every epoch up to the current head (or the optional
+last block), along with its epoch validator set seal, into an epoch bundle which
+lightest sync clients can be bootstrapped from with --light.epochbundle. If the
+file ends with .gz, the output will be gzipped.
+
+The bundle is verified before being written, and the trusted epoch of its last
+block is printed as JSON, in the format of the hardcoded trusted epochs.`,
 	}
 	importPreimagesCommand = cli.Command{
 		Action:    utils.MigrateFlags(importPreimages),
@@ -401,6 +424,42 @@ func exportChain(ctx *cli.Context) error {
 	return nil
 }
 
+func exportEpochs(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		utils.Fatalf("This command requires an argument.")
+	}
+
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, _ := utils.MakeChain(ctx, stack, true)
+	start := time.Now()
+
+	last := chain.CurrentBlock().NumberU64()
+	if len(ctx.Args()) > 1 {
+		number, err := strconv.ParseUint(ctx.Args().Get(1), 10, 64)
+		if err != nil {
+			utils.Fatalf("Export error in parsing parameters: block number not an integer\n")
+		}
+		if number > last {
+			utils.Fatalf("Export error: block %d is past the head of the chain\n", number)
+		}
+		last = number
+	}
+	epoch, err := utils.ExportEpochs(chain, ctx.Args().First(), last)
+	if err != nil {
+		utils.Fatalf("Export error: %v\n", err)
+	}
+	config, err := epoch.Config()
+	if err != nil {
+		utils.Fatalf("Export error: %v\n", err)
+	}
+	out, _ := json.MarshalIndent(config, "", "  ")
+	fmt.Println(string(out))
+	fmt.Printf("Export done in %v\n", time.Since(start))
+	return nil
+}
+
 // importPreimages imports preimage data from the specified file.
 func importPreimages(ctx *cli.Context) error {
 	if len(ctx.Args()) < 1 {
@@ -595,13 +654,17 @@ func dump(ctx *cli.Context) error {
 }
 
 func inspect(ctx *cli.Context) error {
-	node, _ := makeConfigNode(ctx)
+	node, config := makeConfigNode(ctx)
 	defer node.Close()
 
 	_, chainDb := utils.MakeChain(ctx, node, true)
 	defer chainDb.Close()
 
-	return rawdb.InspectDatabase(chainDb)
+	istanbulStats, err := backend.InspectDatabases(&config.Eth.Istanbul)
+	if err != nil {
+		utils.Fatalf("Failed to inspect istanbul databases: %v", err)
+	}
+	return rawdb.InspectDatabase(chainDb, istanbulStats...)
 }
 
 // hashish returns true for strings that look like hashes.
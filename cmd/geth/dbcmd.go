@@ -0,0 +1,258 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/aaronwinter/celo-blockchain/cmd/utils"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/core"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/state"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	dbCommand = cli.Command{
+		Name:      "db",
+		Usage:     "Low level database operations",
+		ArgsUsage: "",
+		Category:  "BLOCKCHAIN COMMANDS",
+		Subcommands: []cli.Command{
+			{
+				Action:    utils.MigrateFlags(inspect),
+				Name:      "inspect",
+				Usage:     "Inspect the storage size for each type of data in the chain and istanbul databases",
+				ArgsUsage: " ",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AncientFlag,
+					utils.CacheFlag,
+					utils.AlfajoresFlag,
+					utils.BaklavaFlag,
+					utils.SyncModeFlag,
+				},
+				Description: `
+geth db inspect
+reports the size and the number of entries of each category of data stored
+in the chain database, including the accumulated epoch uptimes and the istanbul
+snapshots, as well as in the validator enode, version certificate, round state
+and replica state databases.`,
+			},
+			{
+				Action:    utils.MigrateFlags(dbUptime),
+				Name:      "uptime",
+				Usage:     "Print the accumulated uptime of the validators for an epoch",
+				ArgsUsage: "<epoch>",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AncientFlag,
+					utils.AlfajoresFlag,
+					utils.BaklavaFlag,
+				},
+			},
+			{
+				Action:    utils.MigrateFlags(dbSnapshot),
+				Name:      "snapshot",
+				Usage:     "Print the istanbul validator set snapshot stored at a block hash",
+				ArgsUsage: "<hash>",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AncientFlag,
+					utils.AlfajoresFlag,
+					utils.BaklavaFlag,
+				},
+			},
+			{
+				Action:    utils.MigrateFlags(dbRoundState),
+				Name:      "roundstate",
+				Usage:     "Print the istanbul round state stored for a view",
+				ArgsUsage: "[<sequence> <round>]",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AlfajoresFlag,
+					utils.BaklavaFlag,
+				},
+				Description: `
+geth db roundstate [<sequence> <round>]
+prints the round state stored by the validator for the given view, or for the
+last view it has seen if none is given.`,
+			},
+			{
+				Action:    utils.MigrateFlags(dbVerifyEpochs),
+				Name:      "verify-epochs",
+				Usage:     "Recompute the istanbul snapshots and accumulated uptimes of a range of epochs from the headers",
+				ArgsUsage: "<fromEpoch> [<toEpoch>]",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AncientFlag,
+					utils.AlfajoresFlag,
+					utils.BaklavaFlag,
+					epochRepairFlag,
+				},
+				Description: `
+geth db verify-epochs <fromEpoch> [<toEpoch>]
+recomputes the validator set snapshots and the accumulated uptimes of the given
+epochs, up to the current one by default, purely from the validator set diffs
+and the parent seal bitmaps of the stored headers, and reports the stored ones
+which are missing or differ. With --repair, the recomputed values are written
+to the database in their place.`,
+			},
+		},
+	}
+
+	epochRepairFlag = cli.BoolFlag{
+		Name:  "repair",
+		Usage: "Overwrite the missing or corrupted data with the recomputed one",
+	}
+)
+
+func dbUptime(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("expected an epoch number")
+	}
+	epoch, err := strconv.ParseUint(ctx.Args()[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid epoch: %v", err)
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chaindb := utils.MakeChainDatabase(ctx, stack)
+	defer chaindb.Close()
+
+	uptime := rawdb.ReadAccumulatedEpochUptime(chaindb, epoch)
+	if uptime == nil {
+		return fmt.Errorf("no uptime stored for epoch %d", epoch)
+	}
+	return printJSON(uptime)
+}
+
+func dbSnapshot(ctx *cli.Context) error {
+	if ctx.NArg() != 1 || !hashish(ctx.Args()[0]) {
+		return errors.New("expected a block hash")
+	}
+	hash := common.HexToHash(ctx.Args()[0])
+
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chaindb := utils.MakeChainDatabase(ctx, stack)
+	defer chaindb.Close()
+
+	snap, err := backend.ReadSnapshot(chaindb, hash)
+	if err != nil {
+		return fmt.Errorf("no istanbul snapshot stored at %x: %v", hash, err)
+	}
+	return printJSON(snap)
+}
+
+func dbRoundState(ctx *cli.Context) error {
+	var view *istanbul.View
+	switch ctx.NArg() {
+	case 0:
+	case 2:
+		sequence, ok := new(big.Int).SetString(ctx.Args()[0], 10)
+		if !ok {
+			return fmt.Errorf("invalid sequence: %s", ctx.Args()[0])
+		}
+		round, ok := new(big.Int).SetString(ctx.Args()[1], 10)
+		if !ok {
+			return fmt.Errorf("invalid round: %s", ctx.Args()[1])
+		}
+		view = &istanbul.View{Sequence: sequence, Round: round}
+	default:
+		return errors.New("expected either no arguments or a sequence and a round")
+	}
+	stack, config := makeConfigNode(ctx)
+	defer stack.Close()
+
+	summary, err := core.ReadRoundStateSummary(config.Eth.Istanbul.RoundStateDBPath, view)
+	if err != nil {
+		return fmt.Errorf("failed to read round state: %v", err)
+	}
+	return printJSON(summary)
+}
+
+func dbVerifyEpochs(ctx *cli.Context) error {
+	if ctx.NArg() < 1 || ctx.NArg() > 2 {
+		return errors.New("expected a range of epochs")
+	}
+	from, err := strconv.ParseUint(ctx.Args()[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid epoch: %v", err)
+	}
+	to := uint64(math.MaxUint64)
+	if ctx.NArg() == 2 {
+		if to, err = strconv.ParseUint(ctx.Args()[1], 10, 64); err != nil {
+			return fmt.Errorf("invalid epoch: %v", err)
+		}
+	}
+	stack, config := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, chaindb := utils.MakeChain(ctx, stack, true)
+	defer chaindb.Close()
+
+	if chain.Config().Istanbul == nil {
+		return errors.New("not an istanbul chain")
+	}
+	epochSize := chain.Config().Istanbul.Epoch
+	if head := istanbul.GetEpochNumber(chain.CurrentHeader().Number.Uint64(), epochSize); to > head {
+		to = head
+	}
+	istanbulConfig := config.Eth.Istanbul
+	if err := istanbul.ApplyParamsChainConfigToConfig(chain.Config(), &istanbulConfig); err != nil {
+		return err
+	}
+	repair := ctx.Bool(epochRepairFlag.Name)
+
+	mismatches, err := backend.CheckSnapshots(chain, chaindb, from, to, repair)
+	if err != nil {
+		return fmt.Errorf("failed to check snapshots: %v", err)
+	}
+	stateAt := func(header *types.Header) (*state.StateDB, error) { return chain.StateAt(header.Root) }
+	uptimeMismatches, err := backend.CheckUptimes(chain, chaindb, from, to, backend.LookbackWindowFn(chain, &istanbulConfig, stateAt), repair)
+	if err != nil {
+		return fmt.Errorf("failed to check uptimes: %v", err)
+	}
+	mismatches = append(mismatches, uptimeMismatches...)
+	for _, mismatch := range mismatches {
+		fmt.Println(mismatch)
+	}
+	fmt.Printf("Checked epochs %d to %d, %d mismatches found\n", from, to, len(mismatches))
+	return nil
+}
+
+// printJSON prints a value as indented JSON to the standard output.
+func printJSON(v interface{}) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+// Tests that verify-epochs only overwrites the stored epoch data when asked to.
+func TestVerifyEpochsRepair(t *testing.T) {
+	datadir := tmpdir(t)
+	defer os.RemoveAll(datadir)
+
+	// Nothing is stored for the genesis epoch of a fresh database
+	geth := runGeth(t, "--datadir", datadir, "db", "verify-epochs", "0")
+	geth.Expect(`
+snapshot of epoch 0 at block #0: missing
+Checked epochs 0 to 0, 1 mismatches found
+`)
+	geth.ExpectExit()
+
+	geth = runGeth(t, "--datadir", datadir, "db", "verify-epochs", "--repair", "0")
+	geth.Expect(`
+snapshot of epoch 0 at block #0: missing, repaired
+Checked epochs 0 to 0, 1 mismatches found
+`)
+	geth.ExpectExit()
+
+	geth = runGeth(t, "--datadir", datadir, "db", "verify-epochs", "0")
+	geth.Expect(`
+Checked epochs 0 to 0, 0 mismatches found
+`)
+	geth.ExpectExit()
+}
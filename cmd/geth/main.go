@@ -94,6 +94,7 @@ var (
 		utils.LightEgressFlag,
 		utils.LightMaxPeersFlag,
 		utils.LightNoPruneFlag,
+		utils.LightEpochBundleFlag,
 		utils.LightKDFFlag,
 		utils.LightGatewayFeeFlag,
 		utils.UltraLightServersFlag,
@@ -110,6 +111,7 @@ var (
 		utils.CacheTrieRejournalFlag,
 		utils.CacheGCFlag,
 		utils.CacheSnapshotFlag,
+		utils.CacheContractCallsFlag,
 		utils.CacheNoPrefetchFlag,
 		utils.ListenPortFlag,
 		utils.MaxPeersFlag,
@@ -128,6 +130,7 @@ var (
 		utils.DNSDiscoveryFlag,
 		utils.DeveloperFlag,
 		utils.DeveloperPeriodFlag,
+		utils.DeveloperBuildPathFlag,
 		utils.BaklavaFlag,
 		utils.AlfajoresFlag,
 		utils.VMEnableDebugFlag,
@@ -221,6 +224,7 @@ func init() {
 		initCommand,
 		importCommand,
 		exportCommand,
+		exportEpochsCommand,
 		importPreimagesCommand,
 		exportPreimagesCommand,
 		copydbCommand,
@@ -242,6 +246,10 @@ func init() {
 		dumpConfigCommand,
 		// See retesteth.go
 		retestethCommand,
+		// See snapshot.go
+		snapshotCommand,
+		// See dbcmd.go
+		dbCommand,
 		// See cmd/utils/flags_legacy.go
 		utils.ShowDeprecated,
 	}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aaronwinter/celo-blockchain/mycelo/analyze"
+	"github.com/aaronwinter/celo-blockchain/rpc"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var analyzeDurationFlag = cli.DurationFlag{
+	Name:  "duration",
+	Usage: "How long to follow the chain for (0 = until interrupted)",
+}
+
+var analyzeIntervalFlag = cli.DurationFlag{
+	Name:  "interval",
+	Usage: "Length of each interval of the report timeline",
+	Value: 10 * time.Second,
+}
+
+var analyzeNodeFlag = cli.IntFlag{
+	Name:  "node",
+	Usage: "Index of the validator node to follow",
+}
+
+var analyzeOutFlag = cli.StringFlag{
+	Name:  "out",
+	Usage: "Export the report to a .json or .csv file",
+}
+
+var analyzeCommand = cli.Command{
+	Name:      "analyze",
+	Usage:     "Follows the chain and reports on its throughput, gas usage, round changes and tx pool",
+	ArgsUsage: "[envdir]",
+	Action:    analyzeChain,
+	Flags: []cli.Flag{
+		analyzeDurationFlag,
+		analyzeIntervalFlag,
+		analyzeNodeFlag,
+		analyzeOutFlag,
+	},
+}
+
+func analyzeChain(ctx *cli.Context) error {
+	env, err := readEnv(ctx)
+	if err != nil {
+		return err
+	}
+
+	rpcClient, err := rpc.Dial(env.ValidatorIPC(ctx.Int(analyzeNodeFlag.Name)))
+	if err != nil {
+		return err
+	}
+	defer rpcClient.Close()
+
+	runCtx := withExitSignals(context.Background())
+	if duration := ctx.Duration(analyzeDurationFlag.Name); duration > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(runCtx, duration)
+		defer cancel()
+	}
+
+	collector := analyze.NewCollector(rpcClient)
+	if err := collector.Run(runCtx); err != nil {
+		return err
+	}
+	return writeAnalysis(ctx, collector)
+}
+
+// writeAnalysis prints the collector report, and exports it if requested
+func writeAnalysis(ctx *cli.Context, collector *analyze.Collector) error {
+	report := collector.Report(ctx.Duration(analyzeIntervalFlag.Name))
+	fmt.Println()
+	report.Write(os.Stdout)
+	if out := ctx.String(analyzeOutFlag.Name); out != "" {
+		return report.Export(out)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/aaronwinter/celo-blockchain/mycelo/cluster"
+	"github.com/aaronwinter/celo-blockchain/mycelo/fork"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var forkChaindataFlag = cli.StringFlag{
+	Name:  "chaindata",
+	Usage: "Path to the chaindata folder of the chain to fork (i.e. path/to/datadir/celo/chaindata)",
+}
+
+var forkBlockFlag = cli.Uint64Flag{
+	Name:  "block",
+	Usage: "Block on top of which local blocks will be produced. Its state must be present on the chain database",
+}
+
+var forkImpersonationFlag = cli.BoolFlag{
+	Name:  "impersonation",
+	Usage: "Allow eth_sendTransaction on behalf of any account",
+}
+
+var forkCommand = cli.Command{
+	Name:      "fork",
+	Usage:     "Setup all validators nodes on top of a copy of an existing chain",
+	ArgsUsage: "[envdir]",
+	Action:    forkChain,
+	Flags: []cli.Flag{
+		forkChaindataFlag,
+		forkBlockFlag,
+		forkImpersonationFlag,
+	},
+}
+
+func forkChain(ctx *cli.Context) error {
+	env, err := readEnv(ctx)
+	if err != nil {
+		return err
+	}
+
+	chaindata := ctx.String(forkChaindataFlag.Name)
+	if chaindata == "" {
+		return fmt.Errorf("Missing --%s flag", forkChaindataFlag.Name)
+	}
+	if !ctx.IsSet(forkBlockFlag.Name) {
+		return fmt.Errorf("Missing --%s flag", forkBlockFlag.Name)
+	}
+
+	cluster := cluster.New(env, cluster.Config{})
+	chainConfig, err := cluster.InitFork(chaindata, fork.Config{
+		Block:         ctx.Uint64(forkBlockFlag.Name),
+		Impersonation: ctx.Bool(forkImpersonationFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Validators run with the forked chain's network id
+	env.Config.ChainID = chainConfig.ChainID
+	return env.Save()
+}
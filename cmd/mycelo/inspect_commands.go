@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+
+	"github.com/aaronwinter/celo-blockchain/mycelo/genesis"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var genesisInspectCommand = cli.Command{
+	Name:      "genesis-inspect",
+	Usage:     "Decodes and validates the core contracts deployed on a genesis",
+	ArgsUsage: "[genesis.json]",
+	Action:    genesisInspect,
+	Flags: []cli.Flag{
+		cli.BoolFlag{Name: "json", Usage: "Print the report as json"},
+	},
+}
+
+var genesisDiffCommand = cli.Command{
+	Name:      "genesis-diff",
+	Usage:     "Shows the parameters that differ between two genesis or genesis-config files",
+	ArgsUsage: "<a.json> <b.json>",
+	Action:    genesisDiff,
+}
+
+func genesisInspect(ctx *cli.Context) error {
+	genesisPath := "genesis.json"
+	if ctx.NArg() > 1 {
+		return fmt.Errorf("Too many arguments")
+	} else if ctx.NArg() == 1 {
+		genesisPath = ctx.Args().First()
+	}
+
+	g, err := genesis.LoadGenesis(genesisPath)
+	if err != nil {
+		return err
+	}
+	report, err := genesis.Inspect(g)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Bool("json") {
+		out, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	} else {
+		printReport(report)
+	}
+
+	if len(report.Problems) > 0 {
+		return fmt.Errorf("Genesis has %d problems", len(report.Problems))
+	}
+	return nil
+}
+
+func printReport(report *genesis.Report) {
+	fmt.Println("Validators:")
+	for _, validator := range report.Validators {
+		fmt.Printf("  %s\n", validator.Hex())
+	}
+
+	fmt.Println("\nRegistry:")
+	printSorted(registryLines(report))
+
+	fmt.Println("\nParameters:")
+	printSorted(parameterLines(report))
+
+	fmt.Println("\nValidator groups:")
+	for _, group := range report.ValidatorGroups {
+		fmt.Printf("  %s (%s) commission: %s votes: %s\n", group.Address.Hex(), group.Name, group.Commission, group.Votes)
+		for _, member := range group.Members {
+			fmt.Printf("    %s\n", member.Hex())
+		}
+	}
+
+	if len(report.Problems) > 0 {
+		fmt.Println("\nProblems:")
+		for _, problem := range report.Problems {
+			fmt.Printf("  %s\n", problem)
+		}
+	}
+}
+
+func printSorted(lines map[string]string) {
+	keys := make([]string, 0, len(lines))
+	for key := range lines {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Printf("  %s: %s\n", key, lines[key])
+	}
+}
+
+func registryLines(report *genesis.Report) map[string]string {
+	lines := make(map[string]string)
+	for name, address := range report.Registry {
+		lines[name] = address.Hex()
+	}
+	return lines
+}
+
+func parameterLines(report *genesis.Report) map[string]string {
+	lines := make(map[string]string)
+	for name, params := range report.Parameters {
+		for method, value := range params {
+			lines[name+"."+method] = value
+		}
+	}
+	return lines
+}
+
+func genesisDiff(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return fmt.Errorf("Expected two files to compare")
+	}
+
+	a, err := flattenGenesisFile(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	b, err := flattenGenesisFile(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	for _, diff := range genesis.Diff(a, b) {
+		fmt.Println(diff)
+	}
+	return nil
+}
+
+// flattenGenesisFile reads either a genesis.json, which is inspected, or a genesis-config.json
+func flattenGenesisFile(filepath string) (map[string]string, error) {
+	raw, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("%s: %v", path.Base(filepath), err)
+	}
+
+	if _, ok := fields["alloc"]; !ok {
+		cfg, err := genesis.LoadConfig(filepath)
+		if err != nil {
+			return nil, err
+		}
+		return genesis.FlattenConfig(cfg)
+	}
+
+	g, err := genesis.LoadGenesis(filepath)
+	if err != nil {
+		return nil, err
+	}
+	report, err := genesis.Inspect(g)
+	if err != nil {
+		return nil, err
+	}
+	return report.Flatten(), nil
+}
@@ -3,10 +3,10 @@ package main
 import (
 	"context"
 	"fmt"
-	"math/big"
 	"os"
 	"path"
 	"path/filepath"
+	"time"
 
 	"github.com/aaronwinter/celo-blockchain/ethclient"
 	"github.com/aaronwinter/celo-blockchain/internal/fileutils"
@@ -15,10 +15,12 @@ import (
 
 	"github.com/aaronwinter/celo-blockchain/internal/debug"
 	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/mycelo/analyze"
 	"github.com/aaronwinter/celo-blockchain/mycelo/cluster"
 	"github.com/aaronwinter/celo-blockchain/mycelo/env"
 	"github.com/aaronwinter/celo-blockchain/mycelo/loadbot"
 	"github.com/aaronwinter/celo-blockchain/params"
+	"github.com/aaronwinter/celo-blockchain/rpc"
 	"gopkg.in/urfave/cli.v1"
 )
 
@@ -57,6 +59,10 @@ func init() {
 		createGenesisFromConfigCommand,
 		initValidatorsCommand,
 		runValidatorsCommand,
+		forkCommand,
+		analyzeCommand,
+		genesisInspectCommand,
+		genesisDiffCommand,
 		// initNodesCommand,
 		// runNodesCommand,
 		loadBotCommand,
@@ -91,6 +97,16 @@ var loadTestMaxPendingFlag = cli.UintFlag{
 	Value: 200,
 }
 
+var loadTestScenarioFlag = cli.StringFlag{
+	Name:  "scenario",
+	Usage: "Path to a scenario json file. Overrides --tps, --maxpending and --mixfeecurrency",
+}
+
+var loadTestAnalyzeFlag = cli.BoolFlag{
+	Name:  "analyze",
+	Usage: "Follows the chain during the run, and reports on the throughput it sustained",
+}
+
 var loadTestSkipGasEstimationFlag = cli.BoolFlag{
 	Name:  "skipgasestimation",
 	Usage: "Skips estimating gas if true and instead hardcodes a value for the cUSD transfer",
@@ -145,8 +161,12 @@ var loadBotCommand = cli.Command{
 	Flags: []cli.Flag{
 		loadTestTPSFlag,
 		loadTestMaxPendingFlag,
+		loadTestScenarioFlag,
 		loadTestSkipGasEstimationFlag,
-		loadTestMixFeeCurrencyFlag},
+		loadTestMixFeeCurrencyFlag,
+		loadTestAnalyzeFlag,
+		analyzeIntervalFlag,
+		analyzeOutFlag},
 }
 
 func readWorkdir(ctx *cli.Context) (string, error) {
@@ -277,7 +297,7 @@ func loadBot(ctx *cli.Context) error {
 	verbosityLevel := ctx.GlobalInt("verbosity")
 	verbose := verbosityLevel >= 4
 
-	runCtx := context.Background()
+	runCtx := withExitSignals(context.Background())
 
 	var clients []*ethclient.Client
 	for i := 0; i < env.Accounts().NumValidators; i++ {
@@ -289,15 +309,53 @@ func loadBot(ctx *cli.Context) error {
 		clients = append(clients, client)
 	}
 
-	return loadbot.Start(runCtx, &loadbot.Config{
-		ChainID:               env.Config.ChainID,
-		Accounts:              env.Accounts().DeveloperAccounts(),
-		Amount:                big.NewInt(10000000),
-		TransactionsPerSecond: ctx.Int(loadTestTPSFlag.Name),
-		Clients:               clients,
-		Verbose:               verbose,
-		MaxPending:            ctx.Uint64(loadTestMaxPendingFlag.Name),
-		SkipGasEstimation:     ctx.GlobalBool(loadTestSkipGasEstimationFlag.Name),
-		MixFeeCurrency:        ctx.GlobalBool(loadTestMixFeeCurrencyFlag.Name),
+	scenario := loadbot.DefaultScenario(ctx.Int(loadTestTPSFlag.Name), ctx.Uint64(loadTestMaxPendingFlag.Name), ctx.GlobalBool(loadTestMixFeeCurrencyFlag.Name))
+	if ctx.IsSet(loadTestScenarioFlag.Name) {
+		if scenario, err = loadbot.LoadScenario(ctx.String(loadTestScenarioFlag.Name)); err != nil {
+			return err
+		}
+	}
+
+	var collector *analyze.Collector
+	group, collectorCtx := errgroup.WithContext(runCtx)
+	collectorCtx, stopCollector := context.WithCancel(collectorCtx)
+	defer stopCollector()
+	if ctx.Bool(loadTestAnalyzeFlag.Name) || ctx.IsSet(analyzeOutFlag.Name) {
+		rpcClient, err := rpc.Dial(env.ValidatorIPC(0))
+		if err != nil {
+			return err
+		}
+		defer rpcClient.Close()
+		collector = analyze.NewCollector(rpcClient)
+		group.Go(func() error { return collector.Run(collectorCtx) })
+	}
+
+	report, err := loadbot.Start(runCtx, &loadbot.Config{
+		ChainID:           env.Config.ChainID,
+		Accounts:          env.Accounts().DeveloperAccounts(),
+		Clients:           clients,
+		Scenario:          scenario,
+		Verbose:           verbose,
+		SkipGasEstimation: ctx.GlobalBool(loadTestSkipGasEstimationFlag.Name),
+		Collector:         collector,
 	})
+	if err != nil {
+		return err
+	}
+	report.Write(os.Stdout)
+
+	if collector == nil {
+		return nil
+	}
+	// Let the collector catch up with the last blocks
+	syncCtx, cancel := context.WithTimeout(collectorCtx, 10*time.Second)
+	defer cancel()
+	if err := collector.Sync(syncCtx); err != nil {
+		log.Warn("Collector didn't process the last blocks", "err", err)
+	}
+	stopCollector()
+	if err := group.Wait(); err != nil {
+		return err
+	}
+	return writeAnalysis(ctx, collector)
 }
@@ -28,6 +28,7 @@ import (
 	"syscall"
 
 	"github.com/aaronwinter/celo-blockchain/common"
+	istanbulBackend "github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend"
 	"github.com/aaronwinter/celo-blockchain/core"
 	"github.com/aaronwinter/celo-blockchain/core/rawdb"
 	"github.com/aaronwinter/celo-blockchain/core/types"
@@ -237,6 +238,57 @@ func ExportAppendChain(blockchain *core.BlockChain, fn string, first uint64, las
 	return nil
 }
 
+// ExportEpochs exports the epoch blocks of the blockchain up to the given block into
+// an epoch bundle, verifying it from the genesis block. It returns the trusted epoch
+// of the last epoch block of the bundle.
+func ExportEpochs(blockchain *core.BlockChain, fn string, last uint64) (*istanbulBackend.TrustedEpoch, error) {
+	config := blockchain.Config()
+	if config.Istanbul == nil {
+		return nil, fmt.Errorf("chain is not using istanbul consensus")
+	}
+	epochSize := config.Istanbul.Epoch
+	log.Info("Exporting epochs", "file", fn, "last", last)
+
+	bundle := &istanbulBackend.EpochBundle{
+		Version:     istanbulBackend.EpochBundleVersion,
+		GenesisHash: blockchain.Genesis().Hash(),
+		EpochSize:   epochSize,
+	}
+	for number := epochSize; number <= last; number += epochSize {
+		block := blockchain.GetBlockByNumber(number)
+		if block == nil {
+			return nil, fmt.Errorf("export failed on #%d: not found", number)
+		}
+		bundle.Epochs = append(bundle.Epochs, &istanbulBackend.EpochEntry{Header: block.Header(), EpochSnarkData: block.EpochSnarkData()})
+	}
+	genesis, err := istanbulBackend.GenesisEpoch(blockchain.Genesis().Header())
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := istanbulBackend.VerifyEpochBundle(config, bundle, genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	// Open the file handle and potentially wrap with a gzip stream
+	fh, err := os.OpenFile(fn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+
+	var writer io.Writer = fh
+	if strings.HasSuffix(fn, ".gz") {
+		writer = gzip.NewWriter(writer)
+		defer writer.(*gzip.Writer).Close()
+	}
+	if err := bundle.Write(writer); err != nil {
+		return nil, err
+	}
+	log.Info("Exported epochs", "file", fn, "epochs", len(bundle.Epochs))
+	return epoch, nil
+}
+
 // ImportPreimages imports a batch of exported hash preimages into the database.
 func ImportPreimages(db ethdb.Database, fn string) error {
 	log.Info("Importing preimages", "file", fn)
@@ -52,6 +52,7 @@ import (
 	"github.com/aaronwinter/celo-blockchain/metrics/exp"
 	"github.com/aaronwinter/celo-blockchain/metrics/influxdb"
 	"github.com/aaronwinter/celo-blockchain/miner"
+	"github.com/aaronwinter/celo-blockchain/mycelo/genesis"
 	"github.com/aaronwinter/celo-blockchain/node"
 	"github.com/aaronwinter/celo-blockchain/p2p"
 	"github.com/aaronwinter/celo-blockchain/p2p/discv5"
@@ -139,6 +140,10 @@ var (
 		Name:  "dev.period",
 		Usage: "Block period to use in developer mode (0 = mine only if transaction pending)",
 	}
+	DeveloperBuildPathFlag = cli.StringFlag{
+		Name:  "dev.buildpath",
+		Usage: "Directory of the core contracts truffle build, used to deploy them in the developer genesis",
+	}
 	IdentityFlag = cli.StringFlag{
 		Name:  "identity",
 		Usage: "Custom node name",
@@ -263,6 +268,10 @@ var (
 		Name:  "light.nopruning",
 		Usage: "Disable ancient light chain data pruning",
 	}
+	LightEpochBundleFlag = cli.StringFlag{
+		Name:  "light.epochbundle",
+		Usage: "Epoch bundle file (exported with geth export-epochs) to bootstrap lightest sync from",
+	}
 	// Transaction pool settings
 
 	TxPoolLocalsFlag = cli.StringFlag{
@@ -356,10 +365,20 @@ var (
 		Usage: "Percentage of cache memory allowance to use for snapshot caching (default = 10% full mode, 20% archive mode)",
 		Value: 10,
 	}
+	CacheContractCallsFlag = cli.IntFlag{
+		Name:  "cache.contractcalls",
+		Usage: "Megabytes of memory allocated to caching read only core contract calls made by RPC callers on historical states (0 = disabled)",
+		Value: eth.DefaultConfig.ContractCallCache,
+	}
 	CacheNoPrefetchFlag = cli.BoolFlag{
 		Name:  "cache.noprefetch",
 		Usage: "Disable heuristic state prefetch during block import (less CPU and disk IO, more time waiting for data)",
 	}
+	BloomFilterSizeFlag = cli.Uint64Flag{
+		Name:  "bloomfilter.size",
+		Usage: "Megabytes of memory allocated to bloom-filter for pruning",
+		Value: 2048,
+	}
 
 	// Miner settings
 
@@ -1025,6 +1044,9 @@ func setLes(ctx *cli.Context, cfg *eth.Config) {
 	if ctx.GlobalIsSet(LightNoPruneFlag.Name) {
 		cfg.LightNoPrune = ctx.GlobalBool(LightNoPruneFlag.Name)
 	}
+	if ctx.GlobalIsSet(LightEpochBundleFlag.Name) {
+		cfg.EpochBundle = ctx.GlobalString(LightEpochBundleFlag.Name)
+	}
 }
 
 // makeDatabaseHandles raises out the number of allowed file handles per process
@@ -1341,6 +1363,9 @@ func setMiner(ctx *cli.Context, cfg *miner.Config) {
 	if ctx.GlobalIsSet(MinerExtraDataFlag.Name) {
 		cfg.ExtraData = []byte(ctx.GlobalString(MinerExtraDataFlag.Name))
 	}
+	if ctx.GlobalBool(DeveloperFlag.Name) {
+		cfg.InstantSeal = ctx.GlobalInt(DeveloperPeriodFlag.Name) == 0
+	}
 }
 
 func setWhitelist(ctx *cli.Context, cfg *eth.Config) {
@@ -1384,6 +1409,10 @@ func setIstanbul(ctx *cli.Context, stack *node.Node, cfg *eth.Config) {
 	cfg.Istanbul.VersionCertificateDBPath = stack.ResolvePath(cfg.Istanbul.VersionCertificateDBPath)
 	cfg.Istanbul.RoundStateDBPath = stack.ResolvePath(cfg.Istanbul.RoundStateDBPath)
 	cfg.Istanbul.Validator = ctx.GlobalIsSet(MiningEnabledFlag.Name) || ctx.GlobalIsSet(DeveloperFlag.Name)
+	if ctx.GlobalBool(DeveloperFlag.Name) {
+		// A zero period is not applied from the genesis config
+		cfg.Istanbul.BlockPeriod = uint64(ctx.GlobalInt(DeveloperPeriodFlag.Name))
+	}
 	cfg.Istanbul.Replica = ctx.GlobalIsSet(IstanbulReplicaFlag.Name)
 	if ctx.GlobalIsSet(MetricsLoadTestCSVFlag.Name) {
 		cfg.Istanbul.LoadTestCSVFile = ctx.GlobalString(MetricsLoadTestCSVFlag.Name)
@@ -1610,6 +1639,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *eth.Config) {
 	if ctx.GlobalIsSet(CacheNoPrefetchFlag.Name) {
 		cfg.NoPrefetch = ctx.GlobalBool(CacheNoPrefetchFlag.Name)
 	}
+	if ctx.GlobalIsSet(CacheContractCallsFlag.Name) {
+		cfg.ContractCallCache = ctx.GlobalInt(CacheContractCallsFlag.Name)
+	}
 	if ctx.GlobalIsSet(TxLookupLimitFlag.Name) {
 		cfg.TxLookupLimit = ctx.GlobalUint64(TxLookupLimitFlag.Name)
 	}
@@ -1702,14 +1734,39 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *eth.Config) {
 			// when we're definitely concerned with only one account.
 			passphrase = list[0]
 		}
+		// Check if we have an already initialized chain and fall back to
+		// that if so. Otherwise we need to generate a new genesis spec.
+		initialized := false
+		if ctx.GlobalIsSet(DataDirFlag.Name) {
+			chaindb := MakeChainDatabase(ctx, stack)
+			initialized = rawdb.ReadCanonicalHash(chaindb, 0) != (common.Hash{})
+			chaindb.Close()
+		}
+		// The developer key is the single validator of the genesis
+		period := uint64(ctx.GlobalInt(DeveloperPeriodFlag.Name))
+		developerKey, _ := crypto.HexToECDSA("add67e37fdf5c26743d295b1af6d9b50f2785a6b60bc83a8f05bd1dd4b385c6c")
+		if ctx.GlobalIsSet(DeveloperBuildPathFlag.Name) {
+			developerKey = genesis.DevAccounts().ValidatorAccounts()[0].PrivateKey
+			if !initialized {
+				log.Info("Generating developer genesis with core contracts", "buildpath", ctx.GlobalString(DeveloperBuildPathFlag.Name))
+				cfg.Genesis, err = genesis.DevGenesis(params.DeveloperChainConfig.ChainID, period, ctx.GlobalString(DeveloperBuildPathFlag.Name))
+				if err != nil {
+					Fatalf("Failed to generate developer genesis: %v", err)
+				}
+			}
+		} else if !initialized {
+			cfg.Genesis = core.DeveloperGenesisBlock(period)
+		}
+
 		// setValidator has been called above, configuring the miner address from command line flags.
+		// Otherwise the genesis validator seals, whatever other accounts the keystore holds.
+		genesisValidator := crypto.PubkeyToAddress(developerKey.PublicKey)
 		if cfg.Miner.Validator != (common.Address{}) {
 			developer = accounts.Account{Address: cfg.Miner.Validator}
-		} else if accs := ks.Accounts(); len(accs) > 0 {
-			developer = ks.Accounts()[0]
+		} else if ks.HasAddress(genesisValidator) {
+			developer = accounts.Account{Address: genesisValidator}
 		} else {
-			key, _ := crypto.HexToECDSA("add67e37fdf5c26743d295b1af6d9b50f2785a6b60bc83a8f05bd1dd4b385c6c")
-			developer, err = ks.ImportECDSA(key, passphrase)
+			developer, err = ks.ImportECDSA(developerKey, passphrase)
 			if err != nil {
 				Fatalf("Failed to create developer account: %v", err)
 			}
@@ -1719,16 +1776,12 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *eth.Config) {
 		}
 		log.Info("Using developer account", "address", developer.Address)
 
-		// Create a new developer genesis block or reuse existing one
-		cfg.Genesis = core.DeveloperGenesisBlock()
-		if ctx.GlobalIsSet(DataDirFlag.Name) {
-			// Check if we have an already initialized chain and fall back to
-			// that if so. Otherwise we need to generate a new genesis spec.
-			chaindb := MakeChainDatabase(ctx, stack)
-			if rawdb.ReadCanonicalHash(chaindb, 0) != (common.Hash{}) {
-				cfg.Genesis = nil // fallback to db content
-			}
-			chaindb.Close()
+		// The developer account validates and collects the fees unless set otherwise
+		if cfg.Miner.Validator == (common.Address{}) {
+			cfg.Miner.Validator = developer.Address
+		}
+		if cfg.TxFeeRecipient == (common.Address{}) {
+			cfg.TxFeeRecipient = developer.Address
 		}
 	default:
 		if cfg.NetworkId == params.MainnetNetworkId {
@@ -1902,6 +1955,7 @@ func MakeChain(ctx *cli.Context, stack *node.Node, readOnly bool) (chain *core.B
 		TrieDirtyDisabled:   ctx.GlobalString(GCModeFlag.Name) == "archive",
 		TrieTimeLimit:       eth.DefaultConfig.TrieTimeout,
 		SnapshotLimit:       eth.DefaultConfig.SnapshotCache,
+		ContractCallLimit:   ctx.GlobalInt(CacheContractCallsFlag.Name),
 	}
 	if !ctx.GlobalBool(SnapshotFlag.Name) {
 		cache.SnapshotLimit = 0 // Disabled
@@ -33,6 +33,7 @@ import (
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/proxy"
 	"github.com/aaronwinter/celo-blockchain/crypto/ecies"
 	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/metrics"
 	"github.com/aaronwinter/celo-blockchain/p2p"
 	"github.com/aaronwinter/celo-blockchain/p2p/enode"
 )
@@ -219,6 +220,10 @@ func (sb *Backend) announceThread() {
 		case <-checkIfShouldAnnounceTicker.C:
 			logger.Trace("Checking if this node should announce it's enode")
 
+			if metrics.Enabled {
+				sb.updateAnnounceTableMetrics()
+			}
+
 			var err error
 			shouldQuery, err = sb.announceManager.shouldParticipateInAnnounce()
 			if err != nil {
@@ -990,6 +995,17 @@ func (sb *Backend) UpdateAnnounceVersion() {
 	}
 }
 
+// updateAnnounceTableMetrics reports the sizes of the validator enode and version
+// certificate tables
+func (sb *Backend) updateAnnounceTableMetrics() {
+	if valEnodes, err := sb.valEnodeTable.GetValEnodes(nil); err == nil {
+		sb.valEnodeTableSizeGauge.Update(int64(len(valEnodes)))
+	}
+	if versionCertificates, err := sb.announceManager.versionCertificateTable.GetAll(); err == nil {
+		sb.versionCertificateTableSizeGauge.Update(int64(len(versionCertificates)))
+	}
+}
+
 // GetAnnounceVersion will retrieve the current announce version.
 func (sb *Backend) GetAnnounceVersion() uint {
 	sb.announceVersionMu.RLock()
@@ -91,12 +91,12 @@ type BlsInfo struct {
 	sign    istanbul.BLSSignerFn // Signer function to authorize BLS messages
 }
 
-// Sign signs with the bls account
-func (bi *BlsInfo) Sign(data []byte, extra []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
+// Sign signs with the bls key of the account with the given public key
+func (bi *BlsInfo) Sign(publicKey blscrypto.SerializedPublicKey, data []byte, extra []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
 	if bi.sign == nil {
 		return blscrypto.SerializedSignature{}, errInvalidSigningFn
 	}
-	return bi.sign(accounts.Account{Address: bi.Address}, data, extra, useComposite, cip22)
+	return bi.sign(accounts.Account{Address: bi.Address}, publicKey, data, extra, useComposite, cip22)
 }
 
 type Wallets struct {
@@ -125,6 +125,7 @@ func New(config *istanbul.Config, db ethdb.Database) consensus.Istanbul {
 		announceRunning:                    false,
 		gossipCache:                        NewLRUGossipCache(inmemoryPeers, inmemoryMessages),
 		announceThreadWg:                   new(sync.WaitGroup),
+		epochCheckQuit:                     make(chan struct{}),
 		generateAndGossipQueryEnodeCh:      make(chan struct{}, 1),
 		updateAnnounceVersionCh:            make(chan struct{}, 1),
 		updatingCachedValidatorConnSetCond: sync.NewCond(&sync.Mutex{}),
@@ -143,6 +144,11 @@ func New(config *istanbul.Config, db ethdb.Database) consensus.Istanbul {
 		blocksFinalizedTransactionsGauge:   metrics.NewRegisteredGauge("consensus/istanbul/blocks/transactions", nil),
 		blocksFinalizedGasUsedGauge:        metrics.NewRegisteredGauge("consensus/istanbul/blocks/gasused", nil),
 		sleepGauge:                         metrics.NewRegisteredGauge("consensus/istanbul/backend/sleep", nil),
+		blocksSealInclusionGauge:           metrics.NewRegisteredGaugeFloat64("consensus/istanbul/blocks/sealinclusion", nil),
+		uptimeProjectedGauge:               metrics.NewRegisteredGaugeFloat64("consensus/istanbul/uptime/projected", nil),
+		uptimeBestGauge:                    metrics.NewRegisteredGaugeFloat64("consensus/istanbul/uptime/best", nil),
+		valEnodeTableSizeGauge:             metrics.NewRegisteredGauge("consensus/istanbul/announce/valenodetable", nil),
+		versionCertificateTableSizeGauge:   metrics.NewRegisteredGauge("consensus/istanbul/announce/versioncertificates", nil),
 	}
 	backend.aWallets.Store(&Wallets{})
 	if config.LoadTestCSVFile != "" {
@@ -260,6 +266,10 @@ type Backend struct {
 
 	updateAnnounceVersionCh chan struct{}
 
+	epochCheckWg   sync.WaitGroup
+	epochCheckQuit chan struct{}
+	closeOnce      sync.Once // Guards closing epochCheckQuit, Close may be called more than once
+
 	delegateSignFeed  event.Feed
 	delegateSignScope event.SubscriptionScope
 
@@ -299,6 +309,18 @@ type Backend struct {
 
 	// Gauge reporting how many nanoseconds were spent sleeping
 	sleepGauge metrics.Gauge
+
+	// Gauge for the ratio of blocks in whose parent seal we were included, over the blocks we were elected for
+	blocksSealInclusionGauge metrics.GaugeFloat64
+
+	// Gauges projecting our uptime score at the end of the epoch, if we keep the same uptime ratio
+	// and if we are up for all of the remaining blocks.
+	uptimeProjectedGauge metrics.GaugeFloat64
+	uptimeBestGauge      metrics.GaugeFloat64
+
+	// Gauges for the number of entries in the validator enode and version certificate tables
+	valEnodeTableSizeGauge           metrics.Gauge
+	versionCertificateTableSizeGauge metrics.Gauge
 	// Start of the previous block cycle.
 	cycleStart time.Time
 
@@ -340,6 +362,23 @@ func (sb *Backend) isCoreStarted() bool {
 	return sb.coreStarted.Load().(bool)
 }
 
+// CurrentView returns the current view of the istanbul core, and the number of round
+// changes it went through. It returns istanbul.ErrStoppedEngine if the core is not
+// running.
+func (sb *Backend) CurrentView() (*istanbul.View, uint64, error) {
+	sb.coreMu.RLock()
+	defer sb.coreMu.RUnlock()
+
+	if !sb.isCoreStarted() {
+		return nil, 0, istanbul.ErrStoppedEngine
+	}
+	view := sb.core.CurrentView()
+	if view == nil {
+		return nil, 0, istanbul.ErrStoppedEngine
+	}
+	return view, sb.core.RoundChanges(), nil
+}
+
 // IsProxy returns true if instance has proxy flag
 func (sb *Backend) IsProxy() bool {
 	return sb.config.Proxy
@@ -435,6 +474,8 @@ func (sb *Backend) SelfNode() *enode.Node {
 
 // Close the backend
 func (sb *Backend) Close() error {
+	sb.closeOnce.Do(func() { close(sb.epochCheckQuit) })
+	sb.epochCheckWg.Wait()
 	sb.delegateSignScope.Close()
 	var errs []error
 	if err := sb.valEnodeTable.Close(); err != nil {
@@ -697,7 +738,26 @@ func (sb *Backend) Sign(data []byte) ([]byte, error) {
 // Sign implements istanbul.Backend.SignBLS
 func (sb *Backend) SignBLS(data []byte, extra []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
 	w := sb.wallets()
-	return w.Bls.Sign(data, extra, useComposite, cip22)
+	return w.Bls.Sign(sb.registeredBLSPublicKey(w.Ecdsa.Address), data, extra, useComposite, cip22)
+}
+
+// registeredBLSPublicKey returns the BLS public key the validator is registered
+// with in the current validator set, so that seals are signed with the key other
+// validators verify them against, even when the BLS key has been rotated. The
+// empty key, selecting the key derived from the ECDSA key, is returned if the
+// validator isn't elected.
+func (sb *Backend) registeredBLSPublicKey(validator common.Address) blscrypto.SerializedPublicKey {
+	if sb.currentBlock == nil {
+		return blscrypto.SerializedPublicKey{}
+	}
+	head := sb.currentBlock()
+	if head == nil {
+		return blscrypto.SerializedPublicKey{}
+	}
+	if _, v := sb.getValidators(head.NumberU64(), head.Hash()).GetByAddress(validator); v != nil {
+		return v.BLSPublicKey()
+	}
+	return blscrypto.SerializedPublicKey{}
 }
 
 // CheckSignature implements istanbul.Backend.CheckSignature
@@ -46,6 +46,17 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestCloseTwice(t *testing.T) {
+	b := newBackend()
+	b.Close()
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("second close panicked: %v", r)
+		}
+	}()
+	b.Close()
+}
+
 func TestCheckSignature(t *testing.T) {
 	key, _ := generatePrivateKey()
 	data := []byte("Here is a string....")
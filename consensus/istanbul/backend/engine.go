@@ -28,7 +28,6 @@ import (
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
 	istanbulCore "github.com/aaronwinter/celo-blockchain/consensus/istanbul/core"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/uptime"
-	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/validator"
 	"github.com/aaronwinter/celo-blockchain/contracts/blockchain_parameters"
 	gpm "github.com/aaronwinter/celo-blockchain/contracts/gasprice_minimum"
 	"github.com/aaronwinter/celo-blockchain/core"
@@ -286,8 +285,10 @@ func (sb *Backend) verifyAggregatedSeals(chain consensus.ChainHeaderReader, head
 	// The first block is also skipped, since its parent
 	// is the genesis block which contains no parent signatures.
 	// The parent commit messages are only used for the uptime calculation,
-	// so ultralight clients don't need to verify them
-	if number > 1 && chain.Config().FullHeaderChainAvailable {
+	// so ultralight clients don't need to verify them.
+	// On a local fork, the first local block carries the parent seal of the original
+	// validators, who are not part of the local validator set.
+	if number > 1 && chain.Config().FullHeaderChainAvailable && !chain.Config().IsLocalForkBlock(header.Number) {
 		sb.logger.Trace("verifyAggregatedSeals: verifying parent seals for block", "num", number)
 		var parentValidators istanbul.ValidatorSet
 		// The first block in an epoch will have a different validator set than the block
@@ -316,7 +317,12 @@ func (sb *Backend) verifyAggregatedSeals(chain consensus.ChainHeaderReader, head
 }
 
 func (sb *Backend) verifyAggregatedSeal(headerHash common.Hash, validators istanbul.ValidatorSet, aggregatedSeal types.IstanbulAggregatedSeal) error {
-	logger := sb.logger.New("func", "Backend.verifyAggregatedSeal()")
+	return verifyAggregatedSeal(sb.logger.New("func", "Backend.verifyAggregatedSeal()"), headerHash, validators, aggregatedSeal)
+}
+
+// verifyAggregatedSeal checks that the aggregated seal of a header is signed by a quorum
+// of the given validators
+func verifyAggregatedSeal(logger log.Logger, headerHash common.Hash, validators istanbul.ValidatorSet, aggregatedSeal types.IstanbulAggregatedSeal) error {
 	if len(aggregatedSeal.Signature) != types.IstanbulExtraBlsSignature {
 		return errInvalidAggregatedSeal
 	}
@@ -411,7 +417,8 @@ func (sb *Backend) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 func (sb *Backend) UpdateValSetDiff(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) error {
 	// If this is the last block of the epoch, then get the validator set diff, to save into the header
 	log.Trace("Called UpdateValSetDiff", "number", header.Number.Uint64(), "epoch", sb.config.Epoch)
-	if istanbul.IsLastBlockOfEpoch(header.Number.Uint64(), sb.config.Epoch) {
+	// A local fork keeps its validators, the elected ones belong to the original network.
+	if istanbul.IsLastBlockOfEpoch(header.Number.Uint64(), sb.config.Epoch) && !chain.Config().IsLocalFork(header.Number) {
 		newValSet, err := sb.getNewValidatorSet(header, state)
 		if err == nil {
 			// Get the last epoch's validator set
@@ -440,18 +447,29 @@ func (sb *Backend) EpochSize() uint64 {
 
 // LookbackWindow returns the size of the lookback window for calculating uptime (in blocks)
 // Value is constant during an epoch
-func (sb *Backend) LookbackWindow(header *types.Header, state *state.StateDB) uint64 {
+func (sb *Backend) LookbackWindow(header *types.Header, statedb *state.StateDB) uint64 {
+	return lookbackWindow(sb.chain, sb.config, header, func() (*state.StateDB, error) { return statedb, nil })
+}
+
+// lookbackWindow computes the size of the lookback window at the given header. The
+// state is only retrieved if the window is read from the BlockchainParameters contract.
+func lookbackWindow(chain consensus.ChainContext, config *istanbul.Config, header *types.Header, getState func() (*state.StateDB, error)) uint64 {
 	// Check if donut was already active at the beginning of the epoch
 	// as we want to activate the change at epoch change
-	firstBlockOfEpoch := istanbul.MustGetEpochFirstBlockGivenBlockNumber(header.Number.Uint64(), sb.config.Epoch)
-	cip21Activated := sb.chain.Config().IsDonut(new(big.Int).SetUint64(firstBlockOfEpoch))
+	firstBlockOfEpoch := istanbul.MustGetEpochFirstBlockGivenBlockNumber(header.Number.Uint64(), config.Epoch)
+	cip21Activated := chain.Config().IsDonut(new(big.Int).SetUint64(firstBlockOfEpoch))
 
-	vmRunner := sb.chain.NewEVMRunner(header, state)
 	return uptime.ComputeLookbackWindow(
-		sb.config.Epoch,
-		sb.config.DefaultLookbackWindow,
+		config.Epoch,
+		config.DefaultLookbackWindow,
 		cip21Activated,
-		func() (uint64, error) { return blockchain_parameters.GetLookbackWindow(vmRunner) },
+		func() (uint64, error) {
+			state, err := getState()
+			if err != nil {
+				return 0, err
+			}
+			return blockchain_parameters.GetLookbackWindow(chain.NewEVMRunner(header, state))
+		},
 	)
 }
 
@@ -875,31 +893,10 @@ func (sb *Backend) snapshot(chain consensus.ChainHeaderReader, number uint64, ha
 			panic(fmt.Sprintf("There is a bug in the code.  NumberIter should be 0.  NumberIter: %v", numberIter))
 		}
 
-		genesis := chain.GetHeaderByNumber(0)
-		if genesis == nil {
-			log.Error("Cannot load genesis")
-			return nil, errors.New("Cannot load genesis")
-		}
-
-		istanbulExtra, err := types.ExtractIstanbulExtra(genesis)
-		if err != nil {
-			log.Error("Unable to extract istanbul extra", "err", err)
+		var err error
+		if snap, err = genesisSnapshot(chain, sb.config.Epoch); err != nil {
 			return nil, err
 		}
-
-		// The genesis block should have an empty RemovedValidators set.  If not, throw an error
-		if istanbulExtra.RemovedValidators.BitLen() != 0 {
-			log.Error("Genesis block has a non empty RemovedValidators set")
-			return nil, errInvalidValidatorSetDiff
-		}
-
-		validators, err := istanbul.CombineIstanbulExtraToValidatorData(istanbulExtra.AddedValidators, istanbulExtra.AddedValidatorsPublicKeys)
-		if err != nil {
-			log.Error("Cannot construct validators data from istanbul extra")
-			return nil, errInvalidValidatorSetDiff
-		}
-		snap = newSnapshot(sb.config.Epoch, 0, genesis.Hash(), validator.NewSet(validators))
-
 		if err := snap.store(sb.db); err != nil {
 			log.Error("Unable to store snapshot", "err", err)
 			return nil, err
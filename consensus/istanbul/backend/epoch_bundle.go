@@ -0,0 +1,247 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	istanbulCore "github.com/aaronwinter/celo-blockchain/consensus/istanbul/core"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/validator"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
+	"github.com/aaronwinter/celo-blockchain/ethdb"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/params"
+	"github.com/aaronwinter/celo-blockchain/rlp"
+)
+
+// EpochBundleVersion is the version of the epoch bundle format
+const EpochBundleVersion = 1
+
+var (
+	// errInvalidEpochBundle is returned when an epoch bundle doesn't belong to the chain,
+	// or doesn't continue from the trusted epoch it is verified from
+	errInvalidEpochBundle = errors.New("invalid epoch bundle")
+	// errInvalidEpochValidatorSetSeal is returned when the epoch validator set seal of an
+	// epoch block isn't signed by a quorum of the validators of the epoch
+	errInvalidEpochValidatorSetSeal = errors.New("invalid epoch validator set seal")
+)
+
+// EpochBundle holds the last block header of consecutive epochs, along with the epoch
+// validator set seal of each one of them. It allows lightest sync clients to verify the
+// validator set transitions of a chain offline, and to start syncing from the last epoch
+// of the bundle.
+//
+// A bundle is the RLP encoding of
+//
+//	[version, genesisHash, epochSize, [[header, [bitmap, signature]], ...]]
+//
+// where each header is the last block of the epoch following the previous one (the first
+// one follows the epoch the bundle is verified from, usually the genesis block), and
+// [bitmap, signature] is the aggregated IstanbulEpochValidatorSetSeal stored in the body
+// of that block. The bitmap is over the validators of the epoch, which signed the
+// validator set elected in the block.
+type EpochBundle struct {
+	Version     uint64
+	GenesisHash common.Hash
+	EpochSize   uint64
+	Epochs      []*EpochEntry
+}
+
+// EpochEntry is the last block of an epoch in an epoch bundle
+type EpochEntry struct {
+	Header         *types.Header
+	EpochSnarkData *types.EpochSnarkData
+}
+
+// ReadEpochBundle decodes an epoch bundle
+func ReadEpochBundle(r io.Reader) (*EpochBundle, error) {
+	bundle := new(EpochBundle)
+	if err := rlp.Decode(r, bundle); err != nil {
+		return nil, err
+	}
+	if bundle.Version != EpochBundleVersion {
+		return nil, fmt.Errorf("unsupported epoch bundle version %d", bundle.Version)
+	}
+	return bundle, nil
+}
+
+// Write encodes the epoch bundle
+func (b *EpochBundle) Write(w io.Writer) error {
+	return rlp.Encode(w, b)
+}
+
+// Headers returns the epoch headers of the bundle
+func (b *EpochBundle) Headers() []*types.Header {
+	headers := make([]*types.Header, len(b.Epochs))
+	for i, entry := range b.Epochs {
+		headers[i] = entry.Header
+	}
+	return headers
+}
+
+// TrustedEpoch is the last block of an epoch, along with the validator set elected in it
+type TrustedEpoch struct {
+	Header     *types.Header
+	Validators []istanbul.ValidatorData
+}
+
+// GenesisEpoch returns the validator set of the genesis block as a trusted epoch
+func GenesisEpoch(genesis *types.Header) (*TrustedEpoch, error) {
+	extra, err := types.ExtractIstanbulExtra(genesis)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := istanbul.CombineIstanbulExtraToValidatorData(extra.AddedValidators, extra.AddedValidatorsPublicKeys)
+	if err != nil {
+		return nil, errInvalidValidatorSetDiff
+	}
+	return &TrustedEpoch{Header: genesis, Validators: validators}, nil
+}
+
+// NewTrustedEpoch decodes a trusted epoch from its chain configuration
+func NewTrustedEpoch(config *params.TrustedEpoch, epochSize uint64) (*TrustedEpoch, error) {
+	header := new(types.Header)
+	if err := rlp.DecodeBytes(config.Header, header); err != nil {
+		return nil, err
+	}
+	if header.Number.Uint64() != config.Number || header.Hash() != config.Hash {
+		return nil, fmt.Errorf("trusted epoch header mismatch: have #%d [%x], want #%d [%x]", header.Number, header.Hash(), config.Number, config.Hash)
+	}
+	if !istanbul.IsLastBlockOfEpoch(config.Number, epochSize) {
+		return nil, fmt.Errorf("trusted epoch block %d is not the last block of an epoch", config.Number)
+	}
+	validators := make([]istanbul.ValidatorData, len(config.Validators))
+	for i, v := range config.Validators {
+		if len(v.BLSPublicKey) != blscrypto.PUBLICKEYBYTES {
+			return nil, fmt.Errorf("invalid BLS public key for validator %s", v.Address.Hex())
+		}
+		validators[i].Address = v.Address
+		copy(validators[i].BLSPublicKey[:], v.BLSPublicKey)
+	}
+	return &TrustedEpoch{Header: header, Validators: validators}, nil
+}
+
+// Config returns the chain configuration of the trusted epoch
+func (e *TrustedEpoch) Config() (*params.TrustedEpoch, error) {
+	header, err := rlp.EncodeToBytes(e.Header)
+	if err != nil {
+		return nil, err
+	}
+	validators := make([]params.TrustedValidator, len(e.Validators))
+	for i := range e.Validators {
+		validators[i] = params.TrustedValidator{Address: e.Validators[i].Address, BLSPublicKey: e.Validators[i].BLSPublicKey[:]}
+	}
+	return &params.TrustedEpoch{
+		Number:     e.Header.Number.Uint64(),
+		Hash:       e.Header.Hash(),
+		Header:     header,
+		Validators: validators,
+	}, nil
+}
+
+// VerifyEpochBundle verifies the validator set transitions of the bundle, starting from
+// the validator set of the trusted epoch preceding its first header. Each header has to be
+// sealed by a quorum of the validators of its epoch, which also need to have signed its
+// epoch validator set seal. It returns the trusted epoch of the last header of the bundle.
+func VerifyEpochBundle(config *params.ChainConfig, bundle *EpochBundle, from *TrustedEpoch) (*TrustedEpoch, error) {
+	if config.Istanbul == nil || bundle.EpochSize != config.Istanbul.Epoch {
+		return nil, fmt.Errorf("%w: epoch size %d doesn't match the chain", errInvalidEpochBundle, bundle.EpochSize)
+	}
+	if len(bundle.Epochs) == 0 {
+		return from, nil
+	}
+	epochSize := bundle.EpochSize
+	if first := bundle.Epochs[0].Header.Number.Uint64(); first != from.Header.Number.Uint64()+epochSize {
+		return nil, fmt.Errorf("%w: bundle starts at block %d, expected %d", errInvalidEpochBundle, first, from.Header.Number.Uint64()+epochSize)
+	}
+
+	var (
+		logger = log.New("func", "VerifyEpochBundle")
+		// The snapshots of the intermediate epochs are not kept
+		memDB = rawdb.NewMemoryDatabase()
+		snap  = newSnapshot(epochSize, from.Header.Number.Uint64(), from.Header.Hash(), validator.NewSet(from.Validators))
+	)
+	for _, entry := range bundle.Epochs {
+		header := entry.Header
+		number := header.Number.Uint64()
+		extra, err := types.ExtractIstanbulExtra(header)
+		if err != nil {
+			return nil, fmt.Errorf("epoch block %d: %w", number, err)
+		}
+		// The block has to be sealed by the validators of its epoch
+		if err := verifyAggregatedSeal(logger, header.Hash(), snap.ValSet, extra.AggregatedSeal); err != nil {
+			return nil, fmt.Errorf("epoch block %d: %w", number, err)
+		}
+		next, err := snap.apply([]*types.Header{header}, memDB)
+		if err != nil {
+			return nil, fmt.Errorf("epoch block %d: %w", number, err)
+		}
+		// And they have to have signed the validator set elected in it
+		if err := verifyEpochValidatorSetSeal(config, epochSize, header, snap.Hash, snap.ValSet, next.ValSet, entry.EpochSnarkData); err != nil {
+			return nil, fmt.Errorf("epoch block %d: %w", number, err)
+		}
+		snap = next
+	}
+	last := bundle.Epochs[len(bundle.Epochs)-1].Header
+	return &TrustedEpoch{Header: last, Validators: snap.validators()}, nil
+}
+
+// verifyEpochValidatorSetSeal checks that the epoch validator set seal of an epoch block
+// is signed by a quorum of the validators of the epoch
+func verifyEpochValidatorSetSeal(config *params.ChainConfig, epochSize uint64, header *types.Header, parentEpochBlockHash common.Hash, validators, newValidators istanbul.ValidatorSet, seal *types.EpochSnarkData) error {
+	if seal == nil || seal.IsEmpty() || seal.Bitmap == nil {
+		return errInvalidEpochValidatorSetSeal
+	}
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return err
+	}
+	var round uint8
+	if extra.AggregatedSeal.Round != nil {
+		round = uint8(extra.AggregatedSeal.Round.Uint64())
+	}
+	message, extraData, cip22, err := istanbulCore.EpochValidatorSetData(config.IsDonut(header.Number), epochSize, header.Number.Uint64(), round, header.Hash(), parentEpochBlockHash, newValidators)
+	if err != nil {
+		return err
+	}
+	publicKeys := []blscrypto.SerializedPublicKey{}
+	for i := 0; i < validators.Size(); i++ {
+		if seal.Bitmap.Bit(i) == 1 {
+			publicKeys = append(publicKeys, validators.GetByIndex(uint64(i)).BLSPublicKey())
+		}
+	}
+	if len(publicKeys) < validators.MinQuorumSize() {
+		return errInsufficientSeals
+	}
+	if err := blscrypto.VerifyAggregatedSignature(publicKeys, message, extraData, seal.Signature, true, cip22); err != nil {
+		return errInvalidEpochValidatorSetSeal
+	}
+	return nil
+}
+
+// WriteTrustedEpoch stores the validator set of a trusted epoch, so that headers of the
+// following epochs are verified from it instead of from the genesis validator set
+func WriteTrustedEpoch(db ethdb.Database, epochSize uint64, epoch *TrustedEpoch) error {
+	snap := newSnapshot(epochSize, epoch.Header.Number.Uint64(), epoch.Header.Hash(), validator.NewSet(epoch.Validators))
+	return snap.store(db)
+}
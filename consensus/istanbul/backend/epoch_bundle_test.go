@@ -0,0 +1,266 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/aaronwinter/celo-blockchain/accounts"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	istanbulCore "github.com/aaronwinter/celo-blockchain/consensus/istanbul/core"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/validator"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/crypto"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
+	"github.com/aaronwinter/celo-blockchain/params"
+	"github.com/aaronwinter/celo-blockchain/rlp"
+)
+
+const testEpochSize = 10
+
+// epochChain builds the epoch blocks of a chain sealed by a set of test validators
+type epochChain struct {
+	t      *testing.T
+	config *params.ChainConfig
+	keys   map[common.Address]*ecdsa.PrivateKey
+	snap   *Snapshot
+	head   *types.Header
+}
+
+func newEpochChain(t *testing.T, n int) (*epochChain, []*ecdsa.PrivateKey) {
+	config := *params.IstanbulTestChainConfig
+	config.Istanbul = &params.IstanbulConfig{Epoch: testEpochSize}
+	c := &epochChain{t: t, config: &config, keys: make(map[common.Address]*ecdsa.PrivateKey)}
+
+	keys := make([]*ecdsa.PrivateKey, n)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	genesis := &types.Header{Number: big.NewInt(0), Extra: c.extra(c.validatorData(keys), big.NewInt(0))}
+	c.head = genesis
+	c.snap = newSnapshot(testEpochSize, 0, genesis.Hash(), validator.NewSet(c.validatorData(keys)))
+	return c, keys
+}
+
+func (c *epochChain) validatorData(keys []*ecdsa.PrivateKey) []istanbul.ValidatorData {
+	validators := make([]istanbul.ValidatorData, len(keys))
+	for i, key := range keys {
+		blsPrivateKey, _ := blscrypto.ECDSAToBLS(key)
+		blsPublicKey, _ := blscrypto.PrivateToPublic(blsPrivateKey)
+		validators[i] = istanbul.ValidatorData{Address: crypto.PubkeyToAddress(key.PublicKey), BLSPublicKey: blsPublicKey}
+		c.keys[validators[i].Address] = key
+	}
+	return validators
+}
+
+func (c *epochChain) extra(added []istanbul.ValidatorData, removed *big.Int) []byte {
+	addresses, publicKeys := istanbul.SeparateValidatorDataIntoIstanbulExtra(added)
+	payload, err := rlp.EncodeToBytes(&types.IstanbulExtra{
+		AddedValidators:           addresses,
+		AddedValidatorsPublicKeys: publicKeys,
+		RemovedValidators:         removed,
+		Seal:                      []byte{},
+	})
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return append(make([]byte, types.IstanbulExtraVanity), payload...)
+}
+
+// aggregate signs the message with every validator of the current epoch
+func (c *epochChain) aggregate(message, extraData []byte, composite, cip22 bool) (*big.Int, []byte) {
+	var (
+		bitmap     = new(big.Int)
+		signatures [][]byte
+	)
+	for i, v := range c.snap.ValSet.List() {
+		signature, err := SignBLSFn(c.keys[v.Address()])(accounts.Account{}, blscrypto.SerializedPublicKey{}, message, extraData, composite, cip22)
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		signatures = append(signatures, signature[:])
+		bitmap.SetBit(bitmap, i, 1)
+	}
+	aggregated, err := blscrypto.AggregateSignatures(signatures)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return bitmap, aggregated
+}
+
+// next seals the last block of the next epoch, electing the validators of the current
+// epoch minus the removed ones plus the added ones
+func (c *epochChain) next(added []*ecdsa.PrivateKey, removed *big.Int) *EpochEntry {
+	number := c.head.Number.Uint64() + testEpochSize
+	header := &types.Header{
+		ParentHash: common.BigToHash(big.NewInt(int64(number - 1))),
+		Number:     new(big.Int).SetUint64(number),
+		Time:       number,
+		Extra:      c.extra(c.validatorData(added), removed),
+	}
+	proposer := c.keys[c.snap.ValSet.GetByIndex(0).Address()]
+	seal, err := SignFn(proposer)(accounts.Account{}, accounts.MimetypeIstanbul, sigHash(header).Bytes())
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err := writeSeal(header, seal); err != nil {
+		c.t.Fatal(err)
+	}
+	round := big.NewInt(1)
+	bitmap, signature := c.aggregate(istanbulCore.PrepareCommittedSeal(header.Hash(), round), []byte{}, false, false)
+	if err := writeAggregatedSeal(header, types.IstanbulAggregatedSeal{Bitmap: bitmap, Signature: signature, Round: round}, false); err != nil {
+		c.t.Fatal(err)
+	}
+
+	next, err := c.snap.apply([]*types.Header{header}, rawdb.NewMemoryDatabase())
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	message, extraData, cip22, err := istanbulCore.EpochValidatorSetData(c.config.IsDonut(header.Number), testEpochSize, number, uint8(round.Uint64()), header.Hash(), c.head.Hash(), next.ValSet)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	bitmap, signature = c.aggregate(message, extraData, true, cip22)
+
+	c.snap, c.head = next, header
+	return &EpochEntry{Header: header, EpochSnarkData: &types.EpochSnarkData{Bitmap: bitmap, Signature: signature}}
+}
+
+func TestVerifyEpochBundle(t *testing.T) {
+	chain, keys := newEpochChain(t, 4)
+	genesis, err := GenesisEpoch(chain.head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, _ := crypto.GenerateKey()
+	bundle := &EpochBundle{
+		Version:     EpochBundleVersion,
+		GenesisHash: chain.head.Hash(),
+		EpochSize:   testEpochSize,
+		Epochs: []*EpochEntry{
+			chain.next(nil, big.NewInt(0)),
+			// Replace the first validator
+			chain.next([]*ecdsa.PrivateKey{newKey}, big.NewInt(1)),
+			chain.next(nil, big.NewInt(0)),
+		},
+	}
+
+	trusted, err := VerifyEpochBundle(chain.config, bundle, genesis)
+	if err != nil {
+		t.Fatalf("failed to verify bundle: %v", err)
+	}
+	if trusted.Header.Number.Uint64() != 3*testEpochSize {
+		t.Errorf("trusted epoch at block %d, want %d", trusted.Header.Number, 3*testEpochSize)
+	}
+	want := chain.validatorData(append(keys[1:], newKey))
+	if len(trusted.Validators) != len(want) {
+		t.Fatalf("trusted epoch has %d validators, want %d", len(trusted.Validators), len(want))
+	}
+	for _, v := range want {
+		if _, val := chain.snap.ValSet.GetByAddress(v.Address); val == nil {
+			t.Errorf("validator %s missing from the trusted epoch", v.Address.Hex())
+		}
+	}
+
+	// The bundle survives an encoding round trip
+	var buf bytes.Buffer
+	if err := bundle.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := ReadEpochBundle(&buf)
+	if err != nil {
+		t.Fatalf("failed to decode bundle: %v", err)
+	}
+	if _, err := VerifyEpochBundle(chain.config, decoded, genesis); err != nil {
+		t.Errorf("failed to verify decoded bundle: %v", err)
+	}
+
+	// The tail of the bundle can be verified from an intermediate trusted epoch
+	intermediate, err := VerifyEpochBundle(chain.config, &EpochBundle{Version: EpochBundleVersion, EpochSize: testEpochSize, Epochs: bundle.Epochs[:1]}, genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := intermediate.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediate, err = NewTrustedEpoch(config, testEpochSize)
+	if err != nil {
+		t.Fatalf("failed to decode trusted epoch: %v", err)
+	}
+	tail := &EpochBundle{Version: EpochBundleVersion, EpochSize: testEpochSize, Epochs: bundle.Epochs[1:]}
+	if trusted, err = VerifyEpochBundle(chain.config, tail, intermediate); err != nil {
+		t.Fatalf("failed to verify bundle from intermediate epoch: %v", err)
+	}
+	if trusted.Header.Hash() != chain.head.Hash() {
+		t.Errorf("trusted epoch mismatch: have %x, want %x", trusted.Header.Hash(), chain.head.Hash())
+	}
+	// But it doesn't continue from genesis
+	if _, err := VerifyEpochBundle(chain.config, tail, genesis); !errors.Is(err, errInvalidEpochBundle) {
+		t.Errorf("verifying from the wrong epoch: have %v, want %v", err, errInvalidEpochBundle)
+	}
+}
+
+func TestVerifyEpochBundleInvalidSeal(t *testing.T) {
+	chain, _ := newEpochChain(t, 4)
+	genesis, err := GenesisEpoch(chain.head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, second := chain.next(nil, big.NewInt(0)), chain.next(nil, big.NewInt(0))
+	bundle := &EpochBundle{Version: EpochBundleVersion, EpochSize: testEpochSize, Epochs: []*EpochEntry{first, second}}
+
+	// An epoch validator set seal of another epoch
+	second.EpochSnarkData = first.EpochSnarkData
+	if _, err := VerifyEpochBundle(chain.config, bundle, genesis); !errors.Is(err, errInvalidEpochValidatorSetSeal) {
+		t.Errorf("have %v, want %v", err, errInvalidEpochValidatorSetSeal)
+	}
+	// Not enough signers
+	second.EpochSnarkData = &types.EpochSnarkData{Bitmap: big.NewInt(1), Signature: first.EpochSnarkData.Signature}
+	if _, err := VerifyEpochBundle(chain.config, bundle, genesis); !errors.Is(err, errInsufficientSeals) {
+		t.Errorf("have %v, want %v", err, errInsufficientSeals)
+	}
+	// Missing seal
+	second.EpochSnarkData = nil
+	if _, err := VerifyEpochBundle(chain.config, bundle, genesis); !errors.Is(err, errInvalidEpochValidatorSetSeal) {
+		t.Errorf("have %v, want %v", err, errInvalidEpochValidatorSetSeal)
+	}
+}
+
+func TestWriteTrustedEpoch(t *testing.T) {
+	chain, _ := newEpochChain(t, 3)
+	chain.next(nil, big.NewInt(0))
+	epoch := &TrustedEpoch{Header: chain.head, Validators: chain.snap.validators()}
+
+	db := rawdb.NewMemoryDatabase()
+	if err := WriteTrustedEpoch(db, testEpochSize, epoch); err != nil {
+		t.Fatal(err)
+	}
+	snap, err := loadSnapshot(testEpochSize, db, chain.head.Hash())
+	if err != nil {
+		t.Fatalf("failed to load the trusted epoch snapshot: %v", err)
+	}
+	if snap.Number != testEpochSize || snap.ValSet.Size() != 3 {
+		t.Errorf("unexpected snapshot at block %d with %d validators", snap.Number, snap.ValSet.Size())
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/aaronwinter/celo-blockchain/consensus"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/uptime"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/state"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/ethdb"
+	"github.com/aaronwinter/celo-blockchain/log"
+)
+
+var errEpochCheckAborted = errors.New("epoch data check aborted")
+
+// EpochDataMismatch is a stored validator set snapshot or accumulated uptime which
+// differs from the one recomputed from the headers.
+type EpochDataMismatch struct {
+	Kind     string `json:"kind"`     // Kind of data, either snapshot or uptime
+	Epoch    uint64 `json:"epoch"`    // Epoch the data belongs to
+	Number   uint64 `json:"number"`   // Block up to which the data was recomputed
+	Missing  bool   `json:"missing"`  // Whether nothing was stored at all
+	Repaired bool   `json:"repaired"` // Whether the recomputed data was stored
+}
+
+func (m *EpochDataMismatch) String() string {
+	status := "corrupted"
+	if m.Missing {
+		status = "missing"
+	}
+	if m.Repaired {
+		status += ", repaired"
+	}
+	return fmt.Sprintf("%s of epoch %d at block #%d: %s", m.Kind, m.Epoch, m.Number, status)
+}
+
+// CheckSnapshots recomputes the validator set snapshots at the last blocks of the
+// epochs in [from, to] by applying the validator set diffs of the stored epoch
+// headers to the genesis validators, and compares them with the stored ones.
+// Missing or mismatching snapshots are overwritten if repair is set. Epochs which
+// are not completed yet are skipped, as are the ones of a local fork, whose
+// validators are not recorded in the headers.
+func CheckSnapshots(chain consensus.ChainHeaderReader, db ethdb.Database, from, to uint64, repair bool) ([]*EpochDataMismatch, error) {
+	epochSize := chain.Config().Istanbul.Epoch
+	if fork := chain.Config().LocalFork; fork != nil {
+		// The fork snapshot is the one of the last epoch completed by the original chain
+		base := fork.Block.Uint64() - 1
+		forkEpoch := istanbul.GetEpochNumber(base, epochSize)
+		if !istanbul.IsLastBlockOfEpoch(base, epochSize) {
+			forkEpoch--
+		}
+		if forkEpoch == 0 {
+			return nil, nil
+		}
+		if to >= forkEpoch {
+			to = forkEpoch - 1
+		}
+	}
+	if head := chain.CurrentHeader().Number.Uint64(); head < istanbul.GetEpochLastBlockNumber(to, epochSize) {
+		to = istanbul.GetEpochNumber(head, epochSize)
+		if !istanbul.IsLastBlockOfEpoch(head, epochSize) && to > 0 {
+			to--
+		}
+	}
+	snap, err := genesisSnapshot(chain, epochSize)
+	if err != nil {
+		return nil, err
+	}
+	var mismatches []*EpochDataMismatch
+	for epoch := uint64(0); epoch <= to; epoch++ {
+		if epoch > 0 {
+			number := istanbul.GetEpochLastBlockNumber(epoch, epochSize)
+			header := chain.GetHeaderByNumber(number)
+			if header == nil {
+				return mismatches, fmt.Errorf("missing header #%d", number)
+			}
+			if err := applyValSetDiff(snap.ValSet, header); err != nil {
+				return mismatches, err
+			}
+			snap.Number, snap.Hash = number, header.Hash()
+		}
+		if epoch < from {
+			continue
+		}
+		stored, err := ReadSnapshot(db, snap.Hash)
+		if err == nil && reflect.DeepEqual(stored.validators(), snap.validators()) {
+			continue
+		}
+		mismatch := &EpochDataMismatch{Kind: "snapshot", Epoch: epoch, Number: snap.Number, Missing: err != nil}
+		if repair {
+			if err := snap.store(db); err != nil {
+				return mismatches, err
+			}
+			mismatch.Repaired = true
+		}
+		log.Warn("Recomputed istanbul snapshot differs from the stored one", "epoch", epoch, "number", snap.Number, "missing", mismatch.Missing, "repaired", mismatch.Repaired)
+		mismatches = append(mismatches, mismatch)
+	}
+	return mismatches, nil
+}
+
+// CheckUptimes recomputes the accumulated uptimes of the epochs in [from, to] from
+// the parent seal bitmaps of the stored headers, up to the current header for the
+// epoch in progress, and compares them with the stored ones. Missing or mismatching
+// uptimes are overwritten if repair is set.
+func CheckUptimes(chain consensus.ChainHeaderReader, db ethdb.Database, from, to uint64, lookbackWindow uptime.LookbackWindowFn, repair bool) ([]*EpochDataMismatch, error) {
+	var (
+		epochSize  = chain.Config().Istanbul.Epoch
+		head       = chain.CurrentHeader().Number.Uint64()
+		mismatches []*EpochDataMismatch
+	)
+	if from == 0 {
+		from = 1 // Nothing is monitored in the genesis epoch
+	}
+	for epoch := from; epoch <= to; epoch++ {
+		if first, _ := istanbul.GetEpochFirstBlockNumber(epoch, epochSize); first > head {
+			break
+		}
+		computed, err := uptime.Recompute(epoch, epochSize, head, chain.GetHeaderByNumber, lookbackWindow)
+		if err != nil {
+			return mismatches, err
+		}
+		stored := rawdb.ReadAccumulatedEpochUptime(db, epoch)
+		if computed.Equal(stored) {
+			continue
+		}
+		if stored != nil && stored.LatestBlock > head {
+			// Blocks were imported while checking, the recomputed uptime is outdated
+			continue
+		}
+		number := istanbul.GetEpochLastBlockNumber(epoch, epochSize)
+		if number > head {
+			number = head
+		}
+		mismatch := &EpochDataMismatch{Kind: "uptime", Epoch: epoch, Number: number, Missing: stored == nil}
+		if repair && computed != nil {
+			rawdb.WriteAccumulatedEpochUptime(db, epoch, computed)
+			mismatch.Repaired = true
+		}
+		log.Warn("Recomputed accumulated uptime differs from the stored one", "epoch", epoch, "number", number, "missing", mismatch.Missing, "repaired", mismatch.Repaired)
+		mismatches = append(mismatches, mismatch)
+	}
+	return mismatches, nil
+}
+
+// LookbackWindowFn returns a function computing the lookback window in effect at a
+// header, reading it from the state of the header, or from the state of the current
+// header if the former is not available anymore.
+func LookbackWindowFn(chain consensus.ChainContext, config *istanbul.Config, stateAt func(header *types.Header) (*state.StateDB, error)) uptime.LookbackWindowFn {
+	return func(header *types.Header) (uint64, error) {
+		getState := func() (*state.StateDB, error) {
+			statedb, err := stateAt(header)
+			if err != nil {
+				return stateAt(chain.CurrentHeader())
+			}
+			return statedb, nil
+		}
+		return lookbackWindow(chain, config, header, getState), nil
+	}
+}
+
+// CheckEpochData recomputes the validator set snapshot of the last completed epoch
+// and the accumulated uptime of the epoch in progress from the stored headers, and
+// reports the ones which are missing or corrupted. Nothing is written, repairs are
+// left to `geth db verify-epochs --repair`. The check runs in the background, as
+// replaying an epoch of headers may take a while, and is aborted on Close.
+func (sb *Backend) CheckEpochData() {
+	head := sb.chain.CurrentHeader().Number.Uint64()
+	if head == 0 {
+		return
+	}
+	epoch := istanbul.GetEpochNumber(head, sb.config.Epoch)
+
+	stateAt := func(header *types.Header) (*state.StateDB, error) { return sb.stateAt(header.Hash()) }
+	lookbackWindow := LookbackWindowFn(sb.chain, sb.config, stateAt)
+	abortableLookbackWindow := func(header *types.Header) (uint64, error) {
+		select {
+		case <-sb.epochCheckQuit:
+			return 0, errEpochCheckAborted
+		default:
+			return lookbackWindow(header)
+		}
+	}
+
+	sb.epochCheckWg.Add(1)
+	go func() {
+		defer sb.epochCheckWg.Done()
+
+		snapshots, err := CheckSnapshots(sb.chain, sb.db, epoch-1, epoch, false)
+		if err != nil {
+			sb.logger.Error("Failed to check istanbul snapshots", "err", err)
+		}
+		uptimes, err := CheckUptimes(sb.chain, sb.db, epoch, epoch, abortableLookbackWindow, false)
+		if err != nil && err != errEpochCheckAborted {
+			sb.logger.Error("Failed to check accumulated uptimes", "err", err)
+		}
+		if len(snapshots) > 0 || len(uptimes) > 0 {
+			sb.logger.Error("Stored epoch data is missing or corrupted, run `geth db verify-epochs --repair` to fix it", "epoch", epoch)
+		}
+	}()
+}
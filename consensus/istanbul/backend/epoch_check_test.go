@@ -0,0 +1,211 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/uptime"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/uptime/store"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/crypto"
+	"github.com/aaronwinter/celo-blockchain/params"
+	"github.com/aaronwinter/celo-blockchain/rlp"
+)
+
+// headerChain is a chain of headers indexed by number
+type headerChain struct {
+	config  *params.ChainConfig
+	headers []*types.Header
+}
+
+func (c *headerChain) Config() *params.ChainConfig  { return c.config }
+func (c *headerChain) CurrentHeader() *types.Header { return c.headers[len(c.headers)-1] }
+func (c *headerChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number >= uint64(len(c.headers)) {
+		return nil
+	}
+	return c.headers[number]
+}
+func (c *headerChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if header := c.GetHeaderByNumber(number); header != nil && header.Hash() == hash {
+		return header
+	}
+	return nil
+}
+func (c *headerChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	for _, header := range c.headers {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+	return nil
+}
+
+// newHeaderChain creates a chain of n+1 headers, starting with 3 validators and
+// replacing the first one at every epoch, with the parent seals signed by all the
+// validators but one, in turns.
+func newHeaderChain(t *testing.T, n int) *headerChain {
+	config := *params.IstanbulTestChainConfig
+	config.Istanbul = &params.IstanbulConfig{Epoch: testEpochSize}
+	chain := &headerChain{config: &config}
+
+	c := &epochChain{t: t, keys: make(map[common.Address]*ecdsa.PrivateKey)}
+	newKeys := func(n int) []*ecdsa.PrivateKey {
+		keys := make([]*ecdsa.PrivateKey, n)
+		for i := range keys {
+			keys[i], _ = crypto.GenerateKey()
+		}
+		return keys
+	}
+	for number := 0; number <= n; number++ {
+		extra := c.extra(nil, big.NewInt(0))
+		if number%testEpochSize == 0 {
+			if number == 0 {
+				extra = c.extra(c.validatorData(newKeys(3)), big.NewInt(0))
+			} else {
+				extra = c.extra(c.validatorData(newKeys(1)), big.NewInt(1))
+			}
+		}
+		if number > 1 {
+			var istExtra types.IstanbulExtra
+			if err := rlp.DecodeBytes(extra[types.IstanbulExtraVanity:], &istExtra); err != nil {
+				t.Fatal(err)
+			}
+			istExtra.ParentAggregatedSeal.Bitmap = big.NewInt(int64(7 &^ (1 << uint(number%3))))
+			payload, _ := rlp.EncodeToBytes(&istExtra)
+			extra = append(extra[:types.IstanbulExtraVanity], payload...)
+		}
+		header := &types.Header{Number: big.NewInt(int64(number)), Extra: extra}
+		if number > 0 {
+			header.ParentHash = chain.headers[number-1].Hash()
+		}
+		chain.headers = append(chain.headers, header)
+	}
+	return chain
+}
+
+func TestCheckSnapshots(t *testing.T) {
+	var (
+		chain = newHeaderChain(t, 25)
+		db    = rawdb.NewMemoryDatabase()
+	)
+	// Snapshots of the completed epochs 0 to 2 are all missing
+	mismatches, err := CheckSnapshots(chain, db, 0, 5, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 3 || !mismatches[0].Missing || mismatches[2].Epoch != 2 || mismatches[2].Repaired {
+		t.Fatalf("mismatches: %v", mismatches)
+	}
+	if _, err := CheckSnapshots(chain, db, 0, 2, true); err != nil {
+		t.Fatal(err)
+	}
+	if mismatches, err := CheckSnapshots(chain, db, 0, 2, false); err != nil || len(mismatches) != 0 {
+		t.Fatalf("mismatches after repair: %v, %v", mismatches, err)
+	}
+	snap, err := ReadSnapshot(db, chain.headers[20].Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snap.Number != 20 || snap.ValSet.Size() != 3 {
+		t.Errorf("snapshot mismatch: number %d, validators %d", snap.Number, snap.ValSet.Size())
+	}
+
+	// Corrupt the snapshot of epoch 1
+	snap.Hash = chain.headers[10].Hash()
+	if err := snap.store(db); err != nil {
+		t.Fatal(err)
+	}
+	mismatches, err = CheckSnapshots(chain, db, 1, 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 1 || mismatches[0].Epoch != 1 || mismatches[0].Missing || !mismatches[0].Repaired {
+		t.Fatalf("mismatches: %v", mismatches)
+	}
+}
+
+func TestCheckSnapshotsLocalFork(t *testing.T) {
+	var (
+		chain = newHeaderChain(t, 25)
+		db    = rawdb.NewMemoryDatabase()
+	)
+	// Fork the chain off block 15, the local validators take over after block 10
+	chain.config.LocalFork = &params.LocalForkConfig{Block: big.NewInt(16)}
+	rawdb.WriteCanonicalHash(db, chain.headers[10].Hash(), 10)
+	key, _ := crypto.GenerateKey()
+	c := &epochChain{t: t, keys: make(map[common.Address]*ecdsa.PrivateKey)}
+	if err := WriteLocalForkSnapshot(db, testEpochSize, 15, c.validatorData([]*ecdsa.PrivateKey{key})); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the genesis snapshot comes from the original chain
+	mismatches, err := CheckSnapshots(chain, db, 0, 5, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 1 || mismatches[0].Epoch != 0 {
+		t.Fatalf("mismatches: %v", mismatches)
+	}
+	snap, err := ReadSnapshot(db, chain.headers[10].Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snap.ValSet.Size() != 1 {
+		t.Errorf("fork snapshot overwritten: validators %d", snap.ValSet.Size())
+	}
+}
+
+func TestCheckUptimes(t *testing.T) {
+	var (
+		chain          = newHeaderChain(t, 25)
+		db             = rawdb.NewMemoryDatabase()
+		lookbackWindow = func(*types.Header) (uint64, error) { return 2, nil }
+	)
+	// Accumulate the uptimes the way the chain does while importing
+	for _, header := range chain.headers[1:] {
+		monitor := uptime.NewMonitor(store.New(db), testEpochSize, 2)
+		if err := monitor.ProcessBlock(types.NewBlockWithHeader(header)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if mismatches, err := CheckUptimes(chain, db, 0, 5, lookbackWindow, false); err != nil || len(mismatches) != 0 {
+		t.Fatalf("mismatches: %v, %v", mismatches, err)
+	}
+
+	// Corrupt the uptime of epoch 2 and drop the one of the epoch in progress
+	corrupted := rawdb.ReadAccumulatedEpochUptime(db, 2)
+	corrupted.Entries[1].UpBlocks++
+	rawdb.WriteAccumulatedEpochUptime(db, 2, corrupted)
+	rawdb.DeleteAccumulatedEpochUptime(db, 3)
+
+	mismatches, err := CheckUptimes(chain, db, 1, 3, lookbackWindow, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 2 || mismatches[0].Epoch != 2 || mismatches[0].Missing || mismatches[1].Number != 25 || !mismatches[1].Missing {
+		t.Fatalf("mismatches: %v", mismatches)
+	}
+	if mismatches, err := CheckUptimes(chain, db, 1, 3, lookbackWindow, false); err != nil || len(mismatches) != 0 {
+		t.Fatalf("mismatches after repair: %v, %v", mismatches, err)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2017 The celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/validator"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/ethdb"
+)
+
+// WriteLocalForkSnapshot replaces the validator set that signs off on the blocks after
+// `number` with `validators`. It is used to continue a copy of an existing chain with
+// local validators, and must not be used on a node that follows a live network.
+//
+// The snapshot is written for the last epoch block at or before `number`, which is the one
+// the engine looks up when verifying the blocks on top of `number`.
+func WriteLocalForkSnapshot(db ethdb.Database, epoch uint64, number uint64, validators []istanbul.ValidatorData) error {
+	snapNumber := number
+	if !istanbul.IsLastBlockOfEpoch(snapNumber, epoch) {
+		epochNum := istanbul.GetEpochNumber(snapNumber, epoch)
+		snapNumber = istanbul.GetEpochLastBlockNumber(epochNum-1, epoch)
+	}
+
+	hash := rawdb.ReadCanonicalHash(db, snapNumber)
+	if hash == (common.Hash{}) {
+		return errUnknownBlock
+	}
+
+	snap := newSnapshot(epoch, snapNumber, hash, validator.NewSet(validators))
+	return snap.store(db)
+}
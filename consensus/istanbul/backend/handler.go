@@ -23,6 +23,8 @@ import (
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/consensus"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/uptime"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/uptime/store"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/validator"
 	"github.com/aaronwinter/celo-blockchain/core/types"
 	"github.com/aaronwinter/celo-blockchain/event"
@@ -312,6 +314,23 @@ func (sb *Backend) UpdateMetricsForParentOfBlock(child *types.Block) {
 	// However, the value used for updating the validator scores is the one set at the last epoch block.
 	lookbackWindow := sb.LookbackWindow(parentHeader, parentState)
 
+	if elected := sb.blocksElectedMeter.Count(); elected > 0 {
+		sb.blocksSealInclusionGauge.Update(float64(sb.blocksElectedAndSignedMeter.Count()) / float64(elected))
+	}
+
+	// Project the uptime score from the signatures accounted so far in the epoch. The first block of
+	// an epoch carries the seal of the previous epoch, which isn't accounted.
+	if !istanbul.IsFirstBlockOfEpoch(number, sb.config.Epoch) {
+		monitor := uptime.NewMonitor(store.New(sb.db), sb.config.Epoch, lookbackWindow)
+		projected, best, err := monitor.ProjectedUptime(istanbul.GetEpochNumber(number, sb.config.Epoch), gpValSetIndex)
+		if err != nil {
+			sb.logger.Debug("Failed to project uptime score", "number", number, "err", err)
+		} else {
+			sb.uptimeProjectedGauge.Update(projected)
+			sb.uptimeBestGauge.Update(best)
+		}
+	}
+
 	// Report downtime events
 	if sb.blocksElectedButNotSignedGauge.Value() >= int64(lookbackWindow) {
 		sb.blocksDowntimeEventMeter.Mark(1)
@@ -0,0 +1,58 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"os"
+
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend/internal/enodes"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend/internal/replica"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/core"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/log"
+)
+
+// InspectDatabases opens the istanbul databases living outside of the chain
+// database in read-only mode, and returns the size of each kind of data stored
+// in them. Databases which were never created on disk are skipped.
+func InspectDatabases(config *istanbul.Config) ([]rawdb.DatabaseStat, error) {
+	var stats []rawdb.DatabaseStat
+	for _, db := range []struct {
+		path    string
+		inspect func(string) ([]rawdb.DatabaseStat, error)
+	}{
+		{config.ValidatorEnodeDBPath, enodes.InspectValidatorEnodeDB},
+		{config.VersionCertificateDBPath, enodes.InspectVersionCertificateDB},
+		{config.RoundStateDBPath, core.InspectRoundStateDB},
+		{config.ReplicaStateDBPath, replica.InspectReplicaStateDB},
+	} {
+		if db.path == "" {
+			continue
+		}
+		if _, err := os.Stat(db.path); os.IsNotExist(err) {
+			log.Debug("Skipping missing istanbul database", "path", db.path)
+			continue
+		}
+		dbStats, err := db.inspect(db.path)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, dbStats...)
+	}
+	return stats, nil
+}
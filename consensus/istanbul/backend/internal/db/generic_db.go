@@ -27,6 +27,8 @@ import (
 	"github.com/syndtr/goleveldb/leveldb/storage"
 	"github.com/syndtr/goleveldb/leveldb/util"
 
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
 	"github.com/aaronwinter/celo-blockchain/log"
 )
 
@@ -42,6 +44,12 @@ type GenericDB struct {
 
 type GenericEntry interface{}
 
+// Category is a kind of data stored in a db, identified by its key prefix.
+type Category struct {
+	Name   string
+	Prefix []byte
+}
+
 // New will open a new db at the given file path with the given version.
 // If the path is empty, the db will be created in memory.
 // If there is a version mismatch in the existing db, the contents are flushed.
@@ -125,6 +133,51 @@ func (gdb *GenericDB) Iterate(keyPrefix []byte, onEntry func([]byte, []byte) err
 	return iter.Error()
 }
 
+// Inspect opens the existing db at the given path in read-only mode and accounts
+// each entry to the first category whose prefix matches its key. The version
+// entry is accounted as metadata, anything else as unaccounted data.
+func Inspect(path string, database string, categories []Category) ([]rawdb.DatabaseStat, error) {
+	db, err := leveldb.OpenFile(path, &opt.Options{ReadOnly: true, ErrorIfMissing: true, OpenFilesCacheCapacity: 5})
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	stats := make([]rawdb.DatabaseStat, len(categories)+2)
+	for i, category := range categories {
+		stats[i] = rawdb.DatabaseStat{Database: database, Category: category.Name}
+	}
+	metadata := &stats[len(categories)]
+	*metadata = rawdb.DatabaseStat{Database: database, Category: "Metadata"}
+	unaccounted := &stats[len(categories)+1]
+	*unaccounted = rawdb.DatabaseStat{Database: database, Category: "Unaccounted"}
+
+	iter := db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		key := iter.Key()
+		size := common.StorageSize(len(key) + len(iter.Value()))
+
+		stat := unaccounted
+		if bytes.Equal(key, []byte(dbVersionKey)) {
+			stat = metadata
+		} else {
+			for i, category := range categories {
+				if bytes.HasPrefix(key, category.Prefix) {
+					stat = &stats[i]
+					break
+				}
+			}
+		}
+		stat.Add(size)
+	}
+	if unaccounted.Count == 0 {
+		stats = stats[:len(categories)+1]
+	}
+	return stats, iter.Error()
+}
+
 // newDB creates/opens a leveldb persistent database at the given path.
 // If no path is given, an in-memory, temporary database is constructed.
 func NewDB(dbVersion int64, path string, logger log.Logger) (*leveldb.DB, error) {
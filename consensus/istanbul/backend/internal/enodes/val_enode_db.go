@@ -29,6 +29,7 @@ import (
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend/internal/db"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
 	"github.com/aaronwinter/celo-blockchain/crypto"
 	"github.com/aaronwinter/celo-blockchain/log"
 	"github.com/aaronwinter/celo-blockchain/p2p/enode"
@@ -95,6 +96,15 @@ func (vet *ValidatorEnodeDB) Close() error {
 	return vet.gdb.Close()
 }
 
+// InspectValidatorEnodeDB opens the validator enode database at the given path in
+// read-only mode, and returns the size of each kind of data stored in it.
+func InspectValidatorEnodeDB(path string) ([]rawdb.DatabaseStat, error) {
+	return db.Inspect(path, "Validator enodes", []db.Category{
+		{Name: "Address entries", Prefix: []byte(dbAddressPrefix)},
+		{Name: "Node ID entries", Prefix: []byte(dbNodeIDPrefix)},
+	})
+}
+
 func (vet *ValidatorEnodeDB) String() string {
 	vet.lock.RLock()
 	defer vet.lock.RUnlock()
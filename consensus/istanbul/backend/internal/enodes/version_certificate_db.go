@@ -26,6 +26,7 @@ import (
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend/internal/db"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
 	"github.com/aaronwinter/celo-blockchain/crypto"
 	"github.com/aaronwinter/celo-blockchain/log"
 	"github.com/aaronwinter/celo-blockchain/rlp"
@@ -71,6 +72,14 @@ func (svdb *VersionCertificateDB) Close() error {
 	return svdb.gdb.Close()
 }
 
+// InspectVersionCertificateDB opens the version certificate database at the given
+// path in read-only mode, and returns the size of the data stored in it.
+func InspectVersionCertificateDB(path string) ([]rawdb.DatabaseStat, error) {
+	return db.Inspect(path, "Version certificates", []db.Category{
+		{Name: "Certificates", Prefix: []byte(dbAddressPrefix)},
+	})
+}
+
 // String gives a string representation of the entire db
 func (svdb *VersionCertificateDB) String() string {
 	var b strings.Builder
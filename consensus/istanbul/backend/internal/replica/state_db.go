@@ -23,6 +23,7 @@ import (
 	"github.com/syndtr/goleveldb/leveldb/opt"
 
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend/internal/db"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
 	"github.com/aaronwinter/celo-blockchain/log"
 	"github.com/aaronwinter/celo-blockchain/rlp"
 )
@@ -66,6 +67,14 @@ func (rsdb *ReplicaStateDB) Close() error {
 	return rsdb.gdb.Close()
 }
 
+// InspectReplicaStateDB opens the replica state database at the given path in
+// read-only mode, and returns the size of the data stored in it.
+func InspectReplicaStateDB(path string) ([]rawdb.DatabaseStat, error) {
+	return db.Inspect(path, "Replica state", []db.Category{
+		{Name: "Replica state", Prefix: []byte(replicaStateKey)},
+	})
+}
+
 func (rsdb *ReplicaStateDB) GetReplicaState() (*replicaStateImpl, error) {
 	rsdb.lock.Lock()
 	defer rsdb.lock.Unlock()
@@ -37,14 +37,40 @@ import (
 	"github.com/aaronwinter/celo-blockchain/core/types"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/metrics"
 	"github.com/aaronwinter/celo-blockchain/params"
 )
 
+// Gauges for the rewards paid in the last epoch, in CELO. Validator rewards are paid in cUSD and
+// reported converted to CELO.
+var (
+	validatorEpochRewardsGauge        = newEpochRewardsGauge("validators")
+	voterEpochRewardsGauge            = newEpochRewardsGauge("voters")
+	communityEpochRewardsGauge        = newEpochRewardsGauge("community")
+	carbonOffsettingEpochRewardsGauge = newEpochRewardsGauge("carbonoffsetting")
+)
+
+func newEpochRewardsGauge(recipient string) metrics.GaugeFloat64 {
+	return metrics.NewRegisteredGaugeFloat64(metrics.LabeledName("consensus/istanbul/epochrewards", "recipient", recipient), nil)
+}
+
+// weiToCelo converts an amount in wei to CELO, for reporting purposes only
+func weiToCelo(amount *big.Int) float64 {
+	celo, _ := new(big.Float).Quo(new(big.Float).SetInt(amount), big.NewFloat(params.Ether)).Float64()
+	return celo
+}
+
 func (sb *Backend) distributeEpochRewards(header *types.Header, state *state.StateDB) error {
 	start := time.Now()
 	defer sb.rewardDistributionTimer.UpdateSince(start)
 	logger := sb.logger.New("func", "Backend.distributeEpochPaymentsAndRewards", "blocknum", header.Number.Uint64())
 
+	// The local validators of a forked chain are not registered in the Validators contract.
+	if sb.chain.Config().IsLocalFork(header.Number) {
+		logger.Debug("Local fork, skipping epoch rewards distribution")
+		return nil
+	}
+
 	vmRunner := sb.chain.NewEVMRunner(header, state)
 	// Check if reward distribution has been frozen and return early without error if it is.
 	if frozen, err := freezer.IsFrozen(vmRunner, params.EpochRewardsRegistryId); err != nil {
@@ -127,7 +153,8 @@ func (sb *Backend) distributeEpochRewards(header *types.Header, state *state.Sta
 		return err
 	}
 
-	if err := sb.distributeVoterRewards(vmRunner, valSet, totalVoterRewards, uptimes); err != nil {
+	voterRewards, err := sb.distributeVoterRewards(vmRunner, valSet, totalVoterRewards, uptimes)
+	if err != nil {
 		return err
 	}
 
@@ -137,6 +164,10 @@ func (sb *Backend) distributeEpochRewards(header *types.Header, state *state.Sta
 		}
 	}
 
+	validatorEpochRewardsGauge.Update(weiToCelo(totalValidatorRewardsConvertedToCelo))
+	voterEpochRewardsGauge.Update(weiToCelo(voterRewards))
+	communityEpochRewardsGauge.Update(weiToCelo(communityReward))
+	carbonOffsettingEpochRewardsGauge.Update(weiToCelo(carbonOffsettingPartnerReward))
 	return nil
 }
 
@@ -207,13 +238,13 @@ func (sb *Backend) distributeCommunityRewards(vmRunner vm.EVMRunner, communityRe
 	return nil
 }
 
-func (sb *Backend) distributeVoterRewards(vmRunner vm.EVMRunner, valSet []istanbul.Validator, maxTotalRewards *big.Int, uptimes []*big.Int) error {
+func (sb *Backend) distributeVoterRewards(vmRunner vm.EVMRunner, valSet []istanbul.Validator, maxTotalRewards *big.Int, uptimes []*big.Int) (*big.Int, error) {
 
 	lockedGoldAddress, err := contracts.GetRegisteredAddress(vmRunner, params.LockedGoldRegistryId)
 	if err != nil {
-		return err
+		return nil, err
 	} else if lockedGoldAddress == common.ZeroAddress {
-		return errors.New("Unable to fetch locked gold address for epoch rewards distribution")
+		return nil, errors.New("Unable to fetch locked gold address for epoch rewards distribution")
 	}
 
 	// Select groups that elected at least one validator aggregate their uptimes.
@@ -223,7 +254,7 @@ func (sb *Backend) distributeVoterRewards(vmRunner vm.EVMRunner, valSet []istanb
 	for i, val := range valSet {
 		group, err := validators.GetMembershipInLastEpoch(vmRunner, val.Address())
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if _, ok := groupElectedValidator[group]; !ok {
 			groups = append(groups, group)
@@ -235,10 +266,10 @@ func (sb *Backend) distributeVoterRewards(vmRunner vm.EVMRunner, valSet []istanb
 
 	electionRewards, err := election.DistributeEpochRewards(vmRunner, groups, maxTotalRewards, groupUptimes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return gold_token.Mint(vmRunner, lockedGoldAddress, electionRewards)
+	return electionRewards, gold_token.Mint(vmRunner, lockedGoldAddress, electionRewards)
 }
 
 func (sb *Backend) setInitialGoldTokenTotalSupplyIfUnset(vmRunner vm.EVMRunner) error {
@@ -18,13 +18,11 @@ package backend
 
 import (
 	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
 	"github.com/aaronwinter/celo-blockchain/contracts/random"
 	"github.com/aaronwinter/celo-blockchain/crypto"
 )
 
-// String for creating the random seed
-var randomSeedString = []byte("Randomness seed string")
-
 // GenerateRandomness will generate the random beacon randomness
 func (sb *Backend) GenerateRandomness(parentHash common.Hash) (common.Hash, common.Hash, error) {
 	logger := sb.logger.New("func", "GenerateRandomness")
@@ -43,7 +41,7 @@ func (sb *Backend) GenerateRandomness(parentHash common.Hash) (common.Hash, comm
 	if sb.randomSeed == nil {
 		var err error
 		w := sb.wallets()
-		sb.randomSeed, err = w.Ecdsa.SignHash(common.BytesToHash(randomSeedString))
+		sb.randomSeed, err = w.Ecdsa.SignHash(istanbul.RandomSeedHash)
 		if err != nil {
 			logger.Error("Failed to create randomSeed", "err", err)
 			sb.randomSeedMu.Unlock()
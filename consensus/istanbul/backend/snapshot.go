@@ -18,8 +18,10 @@ package backend
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/validator"
 	"github.com/aaronwinter/celo-blockchain/core/types"
@@ -53,6 +55,34 @@ func newSnapshot(epoch uint64, number uint64, hash common.Hash, valSet istanbul.
 	return snap
 }
 
+// genesisSnapshot creates the snapshot of the validators elected in the genesis block.
+func genesisSnapshot(chain consensus.ChainHeaderReader, epoch uint64) (*Snapshot, error) {
+	genesis := chain.GetHeaderByNumber(0)
+	if genesis == nil {
+		log.Error("Cannot load genesis")
+		return nil, errors.New("Cannot load genesis")
+	}
+
+	istanbulExtra, err := types.ExtractIstanbulExtra(genesis)
+	if err != nil {
+		log.Error("Unable to extract istanbul extra", "err", err)
+		return nil, err
+	}
+
+	// The genesis block should have an empty RemovedValidators set.  If not, throw an error
+	if istanbulExtra.RemovedValidators.BitLen() != 0 {
+		log.Error("Genesis block has a non empty RemovedValidators set")
+		return nil, errInvalidValidatorSetDiff
+	}
+
+	validators, err := istanbul.CombineIstanbulExtraToValidatorData(istanbulExtra.AddedValidators, istanbulExtra.AddedValidatorsPublicKeys)
+	if err != nil {
+		log.Error("Cannot construct validators data from istanbul extra")
+		return nil, errInvalidValidatorSetDiff
+	}
+	return newSnapshot(epoch, 0, genesis.Hash(), validator.NewSet(validators)), nil
+}
+
 // loadSnapshot loads an existing snapshot from the database.
 func loadSnapshot(epoch uint64, db ethdb.Database, hash common.Hash) (*Snapshot, error) {
 	blob, err := db.Get(append([]byte(dbKeySnapshotPrefix), hash[:]...))
@@ -76,6 +106,20 @@ func loadSnapshot(epoch uint64, db ethdb.Database, hash common.Hash) (*Snapshot,
 	return snap, nil
 }
 
+// ReadSnapshot retrieves the snapshot stored for the given block hash as is,
+// without upgrading it if outdated.
+func ReadSnapshot(db ethdb.KeyValueReader, hash common.Hash) (*Snapshot, error) {
+	blob, err := db.Get(append([]byte(dbKeySnapshotPrefix), hash[:]...))
+	if err != nil {
+		return nil, err
+	}
+	snap := new(Snapshot)
+	if err := json.Unmarshal(blob, snap); err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
 // store inserts the snapshot into the database.
 func (s *Snapshot) store(db ethdb.Database) error {
 	s.ValSet.CacheUncompressedBLSKey()
@@ -129,28 +173,10 @@ func (s *Snapshot) apply(headers []*types.Header, db ethdb.Database) (*Snapshot,
 			return nil, errUnauthorized
 		}
 
-		// Ensure that the extra data format is satisfied
-		istExtra, err := types.ExtractIstanbulExtra(header)
-		if err != nil {
-			log.Error("Unable to extract the istanbul extra field from the header", "header", header)
+		if err := applyValSetDiff(snap.ValSet, header); err != nil {
 			return nil, err
 		}
 
-		validators, err := istanbul.CombineIstanbulExtraToValidatorData(istExtra.AddedValidators, istExtra.AddedValidatorsPublicKeys)
-		if err != nil {
-			log.Error("Error in combining addresses and public keys")
-			return nil, errInvalidValidatorSetDiff
-		}
-
-		if !snap.ValSet.RemoveValidators(istExtra.RemovedValidators) {
-			log.Error("Error in removing the header's RemovedValidators")
-			return nil, errInvalidValidatorSetDiff
-		}
-		if !snap.ValSet.AddValidators(validators) {
-			log.Error("Error in adding the header's AddedValidators")
-			return nil, errInvalidValidatorSetDiff
-		}
-
 		snap.Epoch = s.Epoch
 		snap.Number += s.Epoch
 		snap.Hash = header.Hash()
@@ -161,6 +187,33 @@ func (s *Snapshot) apply(headers []*types.Header, db ethdb.Database) (*Snapshot,
 	return snap, nil
 }
 
+// applyValSetDiff applies the validator set diff carried by the last header of an
+// epoch to the validator set.
+func applyValSetDiff(valSet istanbul.ValidatorSet, header *types.Header) error {
+	// Ensure that the extra data format is satisfied
+	istExtra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		log.Error("Unable to extract the istanbul extra field from the header", "header", header)
+		return err
+	}
+
+	validators, err := istanbul.CombineIstanbulExtraToValidatorData(istExtra.AddedValidators, istExtra.AddedValidatorsPublicKeys)
+	if err != nil {
+		log.Error("Error in combining addresses and public keys")
+		return errInvalidValidatorSetDiff
+	}
+
+	if !valSet.RemoveValidators(istExtra.RemovedValidators) {
+		log.Error("Error in removing the header's RemovedValidators")
+		return errInvalidValidatorSetDiff
+	}
+	if !valSet.AddValidators(validators) {
+		log.Error("Error in adding the header's AddedValidators")
+		return errInvalidValidatorSetDiff
+	}
+	return nil
+}
+
 func (s *Snapshot) validators() []istanbul.ValidatorData {
 	return validator.MapValidatorsToData(s.ValSet.List())
 }
@@ -312,7 +312,7 @@ func SignBLSFn(key *ecdsa.PrivateKey) istanbul.BLSSignerFn {
 		key, _ = generatePrivateKey()
 	}
 
-	return func(_ accounts.Account, data []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
+	return func(_ accounts.Account, _ blscrypto.SerializedPublicKey, data []byte, extraData []byte, useComposite, cip22 bool) (blscrypto.SerializedSignature, error) {
 		privateKeyBytes, err := blscrypto.ECDSAToBLS(key)
 		if err != nil {
 			return blscrypto.SerializedSignature{}, err
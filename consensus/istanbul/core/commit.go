@@ -55,6 +55,25 @@ func (c *core) generateEpochValidatorSetData(blockNumber uint64, round uint8, bl
 		return nil, nil, false, errNotLastBlockInEpoch
 	}
 
+	// Before the Donut fork, the parent epoch block hash is not part of the encoding.
+	isDonut := c.backend.ChainConfig().IsDonut(big.NewInt(int64(blockNumber)))
+	var parentEpochBlockHash common.Hash
+	if isDonut {
+		// Retrieve the block hash for the last block of the previous epoch.
+		parentEpochBlockHash = c.backend.HashForBlock(blockNumber - c.config.Epoch)
+		if blockNumber > 0 && parentEpochBlockHash == (common.Hash{}) {
+			return nil, nil, false, errors.New("unknown block")
+		}
+	}
+	return EpochValidatorSetData(isDonut, c.config.Epoch, blockNumber, round, blockHash, parentEpochBlockHash, newValSet)
+}
+
+// EpochValidatorSetData serializes the epoch data signed by the validators in the
+// epoch validator set seal of the last block of an epoch. newValSet is the validator
+// set elected for the next epoch, and parentEpochBlockHash the hash of the last block
+// of the previous epoch, which is only used after the Donut fork.
+// The returned flag signifies whether the encoding is the CIP22 one.
+func EpochValidatorSetData(isDonut bool, epoch, blockNumber uint64, round uint8, blockHash, parentEpochBlockHash common.Hash, newValSet istanbul.ValidatorSet) ([]byte, []byte, bool, error) {
 	// Serialize the public keys for the validators in the validator set.
 	blsPubKeys := []blscrypto.SerializedPublicKey{}
 	for _, v := range newValSet.List() {
@@ -64,25 +83,19 @@ func (c *core) generateEpochValidatorSetData(blockNumber uint64, round uint8, bl
 	maxNonSigners := uint32(newValSet.Size() - newValSet.MinQuorumSize())
 
 	// Before the Donut fork, use the snark data encoding with epoch entropy.
-	if !c.backend.ChainConfig().IsDonut(big.NewInt(int64(blockNumber))) {
+	if !isDonut {
 		message, extraData, err := blscrypto.EncodeEpochSnarkData(
 			blsPubKeys, maxNonSigners,
-			uint16(istanbul.GetEpochNumber(blockNumber, c.config.Epoch)),
+			uint16(istanbul.GetEpochNumber(blockNumber, epoch)),
 		)
 		// This is before the Donut hardfork, so signify this doesn't use CIP22.
 		return message, extraData, false, err
 	}
 
-	// Retrieve the block hash for the last block of the previous epoch.
-	parentEpochBlockHash := c.backend.HashForBlock(blockNumber - c.config.Epoch)
-	if blockNumber > 0 && parentEpochBlockHash == (common.Hash{}) {
-		return nil, nil, false, errors.New("unknown block")
-	}
-
 	maxNonSigners = maxValidators - uint32(newValSet.MinQuorumSize())
 	message, extraData, err := blscrypto.EncodeEpochSnarkDataCIP22(
 		blsPubKeys, maxNonSigners, maxValidators,
-		uint16(istanbul.GetEpochNumber(blockNumber, c.config.Epoch)),
+		uint16(istanbul.GetEpochNumber(blockNumber, epoch)),
 		round,
 		blscrypto.EpochEntropyFromHash(blockHash),
 		blscrypto.EpochEntropyFromHash(parentEpochBlockHash),
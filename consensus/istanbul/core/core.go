@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/aaronwinter/celo-blockchain/common"
@@ -136,6 +138,13 @@ type core struct {
 
 	consensusTimestamp time.Time
 
+	// Number of times the node moved to a higher desired round
+	roundChanges uint64 // (atomic)
+
+	// State of the round and when it was entered, to account for the time spent in each phase
+	phaseState State
+	phaseStart time.Time
+
 	// Time from accepting a pre-prepare (after block verifcation) to preparing or committing
 	consensusPrepareTimeGauge metrics.Gauge
 	consensusCommitTimeGauge  metrics.Gauge
@@ -147,6 +156,13 @@ type core struct {
 	handleCommitTimer     metrics.Timer
 }
 
+// Reasons for moving to a higher desired round, reported as labels of the round change meters
+const (
+	roundChangeReasonTimeout     = "timeout"     // The round timer expired
+	roundChangeReasonRoundChange = "roundchange" // F+1 validators moved to a higher round
+	roundChangeReasonCommit      = "commit"      // The proposal couldn't be committed
+)
+
 // New creates an Istanbul consensus core
 func New(backend CoreBackend, config *istanbul.Config) Engine {
 	rsdb, err := newRoundStateDB(config.RoundStateDBPath, nil)
@@ -199,6 +215,26 @@ func (c *core) CurrentView() *istanbul.View {
 
 func (c *core) CurrentRoundState() RoundState { return c.current }
 
+func (c *core) RoundChanges() uint64 { return atomic.LoadUint64(&c.roundChanges) }
+
+// updatePhaseMetrics accounts the time spent in the previous state of the round
+// once the round moved to another state.
+func (c *core) updatePhaseMetrics() {
+	if c.current == nil {
+		return
+	}
+	state, now := c.current.State(), time.Now()
+	if state == c.phaseState && !c.phaseStart.IsZero() {
+		return
+	}
+	if !c.phaseStart.IsZero() {
+		phase := strings.ToLower(strings.Replace(c.phaseState.String(), " ", "_", -1))
+		counter := metrics.GetOrRegisterCounter(metrics.LabeledName("consensus/istanbul/core/phasetime", "phase", phase), nil)
+		counter.Inc(int64(now.Sub(c.phaseStart) / time.Millisecond))
+	}
+	c.phaseState, c.phaseStart = state, now
+}
+
 func (c *core) ParentCommits() MessageSet {
 	if c.current == nil {
 		return nil
@@ -368,14 +404,14 @@ func (c *core) commit() error {
 		if err != nil {
 			nextRound := new(big.Int).Add(c.current.Round(), common.Big1)
 			logger.Warn("Error on commit, waiting for desired round", "reason", "getAggregatedSeal", "err", err, "desired_round", nextRound)
-			c.waitForDesiredRound(nextRound)
+			c.waitForDesiredRound(nextRound, roundChangeReasonCommit)
 			return nil
 		}
 		aggregatedEpochValidatorSetSeal, err := GetAggregatedEpochValidatorSetSeal(proposal.Number().Uint64(), c.config.Epoch, c.current.Commits())
 		if err != nil {
 			nextRound := new(big.Int).Add(c.current.Round(), common.Big1)
 			c.logger.Warn("Error on commit, waiting for desired round", "reason", "GetAggregatedEpochValidatorSetSeal", "err", err, "desired_round", nextRound)
-			c.waitForDesiredRound(nextRound)
+			c.waitForDesiredRound(nextRound, roundChangeReasonCommit)
 			return nil
 		}
 
@@ -384,7 +420,7 @@ func (c *core) commit() error {
 		if err := c.backend.Commit(proposal, aggregatedSeal, aggregatedEpochValidatorSetSeal, result); err != nil {
 			nextRound := new(big.Int).Add(c.current.Round(), common.Big1)
 			logger.Warn("Error on commit, waiting for desired round", "reason", "backend.Commit", "err", err, "desired_round", nextRound)
-			c.waitForDesiredRound(nextRound)
+			c.waitForDesiredRound(nextRound, roundChangeReasonCommit)
 			return nil
 		}
 	}
@@ -566,7 +602,7 @@ func (c *core) startNewSequence() error {
 }
 
 // All actions that occur when transitioning to waiting for round change state.
-func (c *core) waitForDesiredRound(r *big.Int) error {
+func (c *core) waitForDesiredRound(r *big.Int, reason string) error {
 	logger := c.newLogger("func", "waitForDesiredRound", "new_desired_round", r)
 
 	// Don't wait for an older round
@@ -584,6 +620,8 @@ func (c *core) waitForDesiredRound(r *big.Int) error {
 	if err != nil {
 		return err
 	}
+	atomic.AddUint64(&c.roundChanges, 1)
+	metrics.GetOrRegisterMeter(metrics.LabeledName("consensus/istanbul/core/roundchanges", "reason", reason), nil).Mark(1)
 
 	c.resetRoundChangeTimer()
 
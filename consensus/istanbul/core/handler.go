@@ -152,6 +152,7 @@ func (c *core) handleEvents() {
 				}
 			}
 		}
+		c.updatePhaseMetrics()
 	}
 }
 
@@ -220,9 +221,19 @@ func (c *core) handleTimeoutAndMoveToNextRound(timedOutView *istanbul.View) erro
 		return nil
 	}
 
+	// In instant seal mode (zero block period) the miner holds the next proposal back until
+	// there is something to seal. Once the committed proposal is the head of the chain, the
+	// sequence is only waiting for the next FinalCommittedEvent.
+	if c.config.BlockPeriod == 0 && c.current.State() == StateCommitted {
+		if headBlock, _ := c.backend.GetCurrentHeadBlockAndAuthor(); headBlock != nil && headBlock.Number().Cmp(timedOutView.Sequence) >= 0 {
+			logger.Trace("Timed out on a committed sequence")
+			return nil
+		}
+	}
+
 	logger.Debug("Timed out, trying to wait for next round")
 	nextRound := new(big.Int).Add(timedOutView.Round, common.Big1)
-	return c.waitForDesiredRound(nextRound)
+	return c.waitForDesiredRound(nextRound, roundChangeReasonTimeout)
 }
 
 func (c *core) handleResendRoundChangeEvent(desiredView *istanbul.View) error {
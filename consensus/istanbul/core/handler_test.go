@@ -115,6 +115,32 @@ func TestMalformedMessageDecoding(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// Tests that a timeout on a sequence whose proposal is already committed only
+// waits for the next sequence in instant seal mode, and changes round otherwise.
+func TestHandleTimeoutOnCommittedSequence(t *testing.T) {
+	for _, period := range []uint64{5, 0} {
+		sys := NewMutedTestSystemWithBackend(1, 0)
+		backend := sys.backends[0]
+		c := backend.engine.(*core)
+		c.config.BlockPeriod = period
+
+		view := &istanbul.View{Round: big.NewInt(0), Sequence: big.NewInt(1)}
+		c.current = newTestRoundState(view, backend.peers)
+		c.current.(*roundStateImpl).state = StateCommitted
+		backend.committedMsgs = append(backend.committedMsgs, testCommittedMsgs{commitProposal: makeBlock(1)})
+
+		err := c.handleTimeoutAndMoveToNextRound(view)
+		c.stopAllTimers()
+		require.NoError(t, err)
+
+		want := common.Big1
+		if period == 0 {
+			want = common.Big0
+		}
+		assert.Equal(t, want, c.current.DesiredRound(), "desired round with block period %d", period)
+	}
+}
+
 func BenchmarkHandleMsg(b *testing.B) {
 	N := uint64(2)
 	F := uint64(1) // F does not affect tests
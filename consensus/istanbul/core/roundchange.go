@@ -193,7 +193,7 @@ func (c *core) handleRoundChange(msg *istanbul.Message) error {
 		return c.startNewRound(quorumRound)
 	} else if ffRound != nil {
 		logger.Debug("Got f+1 round change messages, sending own round change message and waiting for next round.")
-		c.waitForDesiredRound(ffRound)
+		c.waitForDesiredRound(ffRound, roundChangeReasonRoundChange)
 	}
 
 	return nil
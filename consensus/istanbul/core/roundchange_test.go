@@ -571,7 +571,7 @@ func TestPeriodicRoundChanges(t *testing.T) {
 	go sys.distributeIstMsgs(t, sys, istMsgDistribution)
 
 	for _, b := range sys.backends {
-		b.engine.(*core).waitForDesiredRound(big.NewInt(5))
+		b.engine.(*core).waitForDesiredRound(big.NewInt(5), roundChangeReasonTimeout)
 	}
 
 	// Expect at least one repeat RC before move to next round.
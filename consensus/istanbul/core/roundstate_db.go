@@ -26,6 +26,7 @@ import (
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/task"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
 	"github.com/aaronwinter/celo-blockchain/log"
 	"github.com/aaronwinter/celo-blockchain/rlp"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -275,6 +276,62 @@ func (rsdb *roundStateDBImpl) deleteEntriesOlderThan(lastView *istanbul.View) (i
 	return counter, nil
 }
 
+// InspectRoundStateDB opens the round state database at the given path in
+// read-only mode, and returns the size of each kind of data stored in it.
+func InspectRoundStateDB(path string) ([]rawdb.DatabaseStat, error) {
+	db, err := openReadOnlyDB(path)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var (
+		roundStates = rawdb.DatabaseStat{Database: "Round states", Category: "Round states"}
+		metadata    = rawdb.DatabaseStat{Database: "Round states", Category: "Metadata"}
+	)
+	iter := db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		size := common.StorageSize(len(iter.Key()) + len(iter.Value()))
+		if bytes.HasPrefix(iter.Key(), []byte(rsKey)) {
+			roundStates.Add(size)
+		} else {
+			metadata.Add(size)
+		}
+	}
+	return []rawdb.DatabaseStat{roundStates, metadata}, iter.Error()
+}
+
+// ReadRoundStateSummary opens the round state database at the given path in
+// read-only mode, and returns the round state stored for the given view, or for
+// the last stored view if nil.
+func ReadRoundStateSummary(path string, view *istanbul.View) (*RoundStateSummary, error) {
+	db, err := openReadOnlyDB(path)
+	if err != nil {
+		return nil, err
+	}
+	rsdb := &roundStateDBImpl{db: db, opts: coerceOptions(nil), logger: log.New("rsdb_path", path)}
+	defer rsdb.db.Close()
+
+	if view == nil {
+		if view, err = rsdb.GetLastView(); err != nil {
+			return nil, err
+		}
+	}
+	rs, err := rsdb.GetRoundStateFor(view)
+	if err != nil {
+		return nil, err
+	}
+	return rs.Summary(), nil
+}
+
+// openReadOnlyDB opens the existing leveldb database at the given path without
+// flushing its contents on version mismatch.
+func openReadOnlyDB(path string) (*leveldb.DB, error) {
+	return leveldb.OpenFile(path, &opt.Options{ReadOnly: true, ErrorIfMissing: true, OpenFilesCacheCapacity: 5})
+}
+
 // view2Key will encode a view in binary format
 // so that the binary format maintains the sort order for the view
 func view2Key(view *istanbul.View) []byte {
@@ -3,7 +3,9 @@ package core
 import (
 	"bytes"
 	"encoding/hex"
+	"io/ioutil"
 	"math/rand"
+	"os"
 	"testing"
 
 	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
@@ -169,3 +171,40 @@ func TestRSDBGetOldestValidView(t *testing.T) {
 	runTestCase("When StoredSequence > sequencesToSave", newView(sequencesToSave+1, 90), newView(1, 0))
 	runTestCase("When StoredSequence >> sequencesToSave", newView(sequencesToSave+1000, 90), newView(1000, 0))
 }
+
+func TestRSDBInspectAndReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roundstates")
+	finishOnError(t, err)
+	defer os.RemoveAll(dir)
+
+	valSet := validator.NewSet([]istanbul.ValidatorData{
+		{Address: common.BytesToAddress([]byte{0x02}), BLSPublicKey: blscrypto.SerializedPublicKey{1, 2, 3}},
+	})
+	rsdb, err := newRoundStateDB(dir, &RoundStateDBOptions{withGarbageCollector: false})
+	finishOnError(t, err)
+	for _, view := range []*istanbul.View{newView(2, 0), newView(2, 1), newView(3, 0)} {
+		finishOnError(t, rsdb.UpdateLastRoundState(newRoundState(view, valSet, valSet.GetByIndex(0))))
+	}
+	finishOnError(t, rsdb.Close())
+
+	stats, err := InspectRoundStateDB(dir)
+	finishOnError(t, err)
+	// Round states, then the version and last view entries
+	if len(stats) != 2 || stats[0].Count != 3 || stats[1].Count != 2 {
+		t.Fatalf("stats mismatch: %+v", stats)
+	}
+
+	summary, err := ReadRoundStateSummary(dir, nil)
+	finishOnError(t, err)
+	if summary.Sequence.Uint64() != 3 || summary.Round.Uint64() != 0 {
+		t.Errorf("last round state mismatch: have %v/%v, want 3/0", summary.Sequence, summary.Round)
+	}
+	summary, err = ReadRoundStateSummary(dir, newView(2, 1))
+	finishOnError(t, err)
+	if summary.Sequence.Uint64() != 2 || summary.Round.Uint64() != 1 {
+		t.Errorf("round state mismatch: have %v/%v, want 2/1", summary.Sequence, summary.Round)
+	}
+	if _, err := ReadRoundStateSummary(dir, newView(4, 0)); err == nil {
+		t.Error("expected error reading missing view")
+	}
+}
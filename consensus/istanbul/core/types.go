@@ -29,6 +29,8 @@ type Engine interface {
 	CurrentView() *istanbul.View
 	// CurrentRoundState returns the current roundState or nil if none
 	CurrentRoundState() RoundState
+	// RoundChanges returns the number of round changes since the core was created
+	RoundChanges() uint64
 	SetAddress(common.Address)
 	// Validator -> CommittedSeal from Parent Block
 	ParentCommits() MessageSet
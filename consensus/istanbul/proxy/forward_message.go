@@ -20,6 +20,16 @@ import (
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/consensus"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/metrics"
+)
+
+var (
+	// Gauge for the forward messages waiting to be handed over to the proxied validator thread
+	fwdMsgQueuedGauge = metrics.NewRegisteredGauge("consensus/istanbul/proxy/forward/queued", nil)
+	// Meter for the forward messages sent to the proxies, once per proxy
+	fwdMsgSentMeter = metrics.NewRegisteredMeter("consensus/istanbul/proxy/forward/sent", nil)
+	// Meter for the forward messages received from the proxied validators, and relayed by a proxy
+	fwdMsgRelayedMeter = metrics.NewRegisteredMeter("consensus/istanbul/proxy/forward/relayed", nil)
 )
 
 func (pv *proxiedValidatorEngine) sendForwardMsg(ps *proxySet, destAddresses []common.Address, ethMsgCode uint64, payload []byte) error {
@@ -50,6 +60,7 @@ func (pv *proxiedValidatorEngine) sendForwardMsg(ps *proxySet, destAddresses []c
 			}
 
 			pv.backend.Unicast(proxy.peer, fwdMsgPayload, istanbul.FwdMsg)
+			fwdMsgSentMeter.Mark(1)
 		}
 	}
 
@@ -88,6 +99,7 @@ func (p *proxyEngine) handleForwardMsg(peer consensus.Peer, payload []byte) (boo
 		logger.Error("Error in multicasting a forwarded message", "error", err)
 		return true, err
 	}
+	fwdMsgRelayedMeter.Mark(1)
 
 	return true, nil
 }
@@ -346,6 +346,9 @@ func (pv *proxiedValidatorEngine) SendForwardMsgToAllProxies(finalDestAddresses
 		return istanbul.ErrStoppedProxiedValidatorEngine
 	}
 
+	fwdMsgQueuedGauge.Inc(1)
+	defer fwdMsgQueuedGauge.Dec(1)
+
 	select {
 	case pv.sendFwdMsgsCh <- &fwdMsgInfo{destAddresses: finalDestAddresses, ethMsgCode: ethMsgCode, payload: payload}:
 
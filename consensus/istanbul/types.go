@@ -41,8 +41,9 @@ type DecryptFn func(accounts.Account, []byte, []byte, []byte) ([]byte, error)
 type SignerFn func(accounts.Account, string, []byte) ([]byte, error)
 
 // BLSSignerFn is a signer callback function to request a message and extra data to be signed by a
-// backing account using BLS with a direct or composite hasher
-type BLSSignerFn func(accounts.Account, []byte, []byte, bool, bool) (blscrypto.SerializedSignature, error)
+// backing account using BLS with a direct or composite hasher. The BLS key is selected by its
+// public key, the empty one selecting the key derived from the account's ECDSA key.
+type BLSSignerFn func(accounts.Account, blscrypto.SerializedPublicKey, []byte, []byte, bool, bool) (blscrypto.SerializedSignature, error)
 
 // HashSignerFn is a signer callback function to request a hash to be signed by a
 // backing account.
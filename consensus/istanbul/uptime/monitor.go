@@ -105,6 +105,40 @@ func (um *Monitor) ComputeValidatorsUptime(epoch uint64, valSetSize int) ([]*big
 	return uptimes, nil
 }
 
+// ProjectedUptime estimates the uptime score of a validator at the end of the given
+// epoch from the blocks accounted so far. projected assumes the validator keeps the
+// same ratio of up blocks for the rest of the epoch, while best assumes it is up for
+// all the blocks left. Both are in the [0, 1] range.
+func (um *Monitor) ProjectedUptime(epoch uint64, valIdx int) (projected float64, best float64, err error) {
+	accumulated := um.store.ReadAccumulatedEpochUptime(epoch)
+	if accumulated == nil {
+		return 0, 0, errors.New("accumulated uptimes not found")
+	}
+	if valIdx < 0 || valIdx >= len(accumulated.Entries) {
+		return 0, 0, fmt.Errorf("no accumulated uptime for validator %d", valIdx)
+	}
+	// The latest processed block accounts for the signatures of its parent
+	window := um.MonitoringWindow(epoch)
+	var monitored uint64
+	if accumulated.LatestBlock > window.Start {
+		last := accumulated.LatestBlock - 1
+		if last > window.End {
+			last = window.End
+		}
+		monitored = last - window.Start + 1
+	}
+	upBlocks := accumulated.Entries[valIdx].UpBlocks
+	if upBlocks > monitored {
+		upBlocks = monitored
+	}
+	total := window.Size()
+	best = float64(upBlocks+total-monitored) / float64(total)
+	if monitored == 0 {
+		return best, best, nil
+	}
+	return float64(upBlocks) / float64(monitored), best, nil
+}
+
 // ProcessBlock uses the block's signature bitmap (which encodes who signed the parent block) to update the epoch's Uptime data
 func (um *Monitor) ProcessBlock(block *types.Block) error {
 	// The epoch's first block's aggregated parent signatures is for the previous epoch's valset.
@@ -102,3 +102,33 @@ func TestUptimeSingle(t *testing.T) {
 		t.Fatalf("uptimes were not updated correctly, got %v, expected %v", uptimes, expected)
 	}
 }
+
+func TestProjectedUptime(t *testing.T) {
+	store := make(memStore)
+	monitor := NewMonitor(store, 10, 2) // Monitoring window of epoch 1 is [2,8]
+
+	if _, _, err := monitor.ProjectedUptime(1, 0); err == nil {
+		t.Fatal("expected error for missing accumulated uptimes")
+	}
+	// Blocks [2,5] accounted, validator 0 up for 3 of them
+	store[1] = &Uptime{LatestBlock: 6, Entries: []UptimeEntry{{UpBlocks: 3}, {UpBlocks: 4}}}
+
+	projected, best, err := monitor.ProjectedUptime(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if projected != 0.75 || best != 6.0/7.0 {
+		t.Errorf("validator 0 mismatch: have %v/%v, want %v/%v", projected, best, 0.75, 6.0/7.0)
+	}
+	if projected, best, _ = monitor.ProjectedUptime(1, 1); projected != 1 || best != 1 {
+		t.Errorf("validator 1 mismatch: have %v/%v, want 1/1", projected, best)
+	}
+	if _, _, err := monitor.ProjectedUptime(1, 2); err == nil {
+		t.Error("expected error for unknown validator")
+	}
+	// Nothing accounted yet within the window
+	store[1] = &Uptime{LatestBlock: 2, Entries: []UptimeEntry{{}}}
+	if projected, best, _ = monitor.ProjectedUptime(1, 0); projected != 1 || best != 1 {
+		t.Errorf("empty window mismatch: have %v/%v, want 1/1", projected, best)
+	}
+}
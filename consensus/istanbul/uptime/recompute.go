@@ -0,0 +1,72 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package uptime
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+)
+
+// LookbackWindowFn returns the lookback window in effect when the given block was
+// processed.
+type LookbackWindowFn func(header *types.Header) (uint64, error)
+
+// memStore is an in-memory uptime store.
+type memStore map[uint64]*Uptime
+
+func (s memStore) ReadAccumulatedEpochUptime(epoch uint64) *Uptime { return s[epoch] }
+
+func (s memStore) WriteAccumulatedEpochUptime(epoch uint64, uptime *Uptime) { s[epoch] = uptime }
+
+// Recompute accumulates the uptime of an epoch from the parent seal bitmaps of its
+// headers up to the given block, the same way ProcessBlock does while the chain is
+// imported. It returns nil if no block of the epoch is accounted up to there.
+func Recompute(epoch, epochSize, last uint64, getHeader func(number uint64) *types.Header, lookbackWindow LookbackWindowFn) (*Uptime, error) {
+	first, err := istanbul.GetEpochFirstBlockNumber(epoch, epochSize)
+	if err != nil {
+		return nil, err
+	}
+	if end := istanbul.GetEpochLastBlockNumber(epoch, epochSize); last > end {
+		last = end
+	}
+	store := make(memStore)
+	for number := first; number <= last; number++ {
+		header := getHeader(number)
+		if header == nil {
+			return nil, fmt.Errorf("missing header #%d", number)
+		}
+		window, err := lookbackWindow(header)
+		if err != nil {
+			return nil, err
+		}
+		if err := NewMonitor(store, epochSize, window).ProcessBlock(types.NewBlockWithHeader(header)); err != nil {
+			return nil, err
+		}
+	}
+	return store.ReadAccumulatedEpochUptime(epoch), nil
+}
+
+// Equal returns whether two accumulated uptimes are identical.
+func (u *Uptime) Equal(other *Uptime) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	return u.LatestBlock == other.LatestBlock && reflect.DeepEqual(u.Entries, other.Entries)
+}
@@ -32,6 +32,10 @@ import (
 	"golang.org/x/crypto/sha3"
 )
 
+// RandomSeedHash is signed with the ECDSA key of a validator to derive the seed of
+// the randomness it commits to when proposing blocks.
+var RandomSeedHash = common.BytesToHash([]byte("Randomness seed string"))
+
 func RLPHash(v interface{}) (h common.Hash) {
 	hw := sha3.NewLegacyKeccak256()
 	rlp.Encode(hw, v)
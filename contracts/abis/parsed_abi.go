@@ -22,6 +22,11 @@ var (
 	GoldToken            *abi.ABI = mustParseAbi("GoldToken", GoldTokenStr)
 	Random               *abi.ABI = mustParseAbi("Random", RandomStr)
 	Validators           *abi.ABI = mustParseAbi("Validators", ValidatorsStr)
+	StableToken          *abi.ABI = mustParseAbi("StableToken", StableTokenStr)
+	Exchange             *abi.ABI = mustParseAbi("Exchange", ExchangeStr)
+	LockedGold           *abi.ABI = mustParseAbi("LockedGold", LockedGoldStr)
+	Accounts             *abi.ABI = mustParseAbi("Accounts", AccountsStr)
+	Governance           *abi.ABI = mustParseAbi("Governance", GovernanceStr)
 )
 
 func mustParseAbi(name, abiStr string) *abi.ABI {
@@ -43,6 +48,13 @@ var byRegistryId = map[common.Hash]*abi.ABI{
 	params.GoldTokenRegistryId:            GoldToken,
 	params.RandomRegistryId:               Random,
 	params.ValidatorsRegistryId:           Validators,
+	params.StableTokenRegistryId:          StableToken,
+	params.StableTokenEURRegistryId:       StableToken,
+	params.ExchangeRegistryId:             Exchange,
+	params.ExchangeEURRegistryId:          Exchange,
+	params.LockedGoldRegistryId:           LockedGold,
+	params.AccountsRegistryId:             Accounts,
+	params.GovernanceRegistryId:           Governance,
 }
 
 func AbiFor(registryId common.Hash) *abi.ABI {
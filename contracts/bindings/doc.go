@@ -0,0 +1,28 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package bindings contains the typed Go bindings of the Celo core contracts,
+// generated from their full ABIs.
+//
+// The bindings work with any bind_v2.ContractBackend: an ethclient.Client to
+// interact with a remote node, or contracts.NewEVMBackend to run the calls of
+// the node itself against its state. The events of many contracts can be watched
+// over a single subscription by binding them to a bind_v2.SubscriptionManager.
+//
+// To regenerate them, run
+//
+//	go run ./contracts/internal/scripts/bindings -buildpath $CELO_MONOREPO/packages/protocol/build/contracts
+package bindings
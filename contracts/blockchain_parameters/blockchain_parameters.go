@@ -17,36 +17,40 @@
 package blockchain_parameters
 
 import (
-	"math/big"
 	"time"
 
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
 	"github.com/aaronwinter/celo-blockchain/contracts"
-	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/log"
 	"github.com/aaronwinter/celo-blockchain/params"
 )
 
-var (
-	getMinimumClientVersionMethod               = contracts.NewRegisteredContractMethod(params.BlockchainParametersRegistryId, abis.BlockchainParameters, "getMinimumClientVersion", params.MaxGasForReadBlockchainParameter)
-	intrinsicGasForAlternativeFeeCurrencyMethod = contracts.NewRegisteredContractMethod(params.BlockchainParametersRegistryId, abis.BlockchainParameters, "intrinsicGasForAlternativeFeeCurrency", params.MaxGasForReadBlockchainParameter)
-	blockGasLimitMethod                         = contracts.NewRegisteredContractMethod(params.BlockchainParametersRegistryId, abis.BlockchainParameters, "blockGasLimit", params.MaxGasForReadBlockchainParameter)
-	getUptimeLookbackWindowMethod               = contracts.NewRegisteredContractMethod(params.BlockchainParametersRegistryId, abis.BlockchainParameters, "getUptimeLookbackWindow", params.MaxGasForReadBlockchainParameter)
-)
+// bindBlockchainParameters binds the BlockchainParameters contract to call method on.
+func bindBlockchainParameters(vmRunner vm.EVMRunner, method string) (*bindings.BlockchainParameters, error) {
+	address, err := contracts.ResolveAddressForCall(vmRunner, params.BlockchainParametersRegistryId, method)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewBlockchainParameters(address, contracts.NewEVMBackend(vmRunner))
+}
 
 // getMinimumVersion retrieves the client required minimum version
 // If a node is running a version smaller than this, it should exit/stop
 func getMinimumVersion(vmRunner vm.EVMRunner) (*params.VersionInfo, error) {
-	version := [3]*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(0)}
-	err := getMinimumClientVersionMethod.Query(vmRunner, &version)
+	blockchainParameters, err := bindBlockchainParameters(vmRunner, "getMinimumClientVersion")
+	if err != nil {
+		return nil, err
+	}
+	version, err := blockchainParameters.GetMinimumClientVersion(contracts.QueryOpts(params.MaxGasForReadBlockchainParameter))
 	if err != nil {
 		return nil, err
 	}
 	return &params.VersionInfo{
-		Major: version[0].Uint64(),
-		Minor: version[1].Uint64(),
-		Patch: version[2].Uint64(),
+		Major: version.Major.Uint64(),
+		Minor: version.Minor.Uint64(),
+		Patch: version.Patch.Uint64(),
 	}, nil
 }
 
@@ -66,9 +70,11 @@ func GetIntrinsicGasForAlternativeFeeCurrencyOrDefault(vmRunner vm.EVMRunner) ui
 // getIntrinsicGasForAlternativeFeeCurrency retrieves the intrisic gas for transactions that pay gas in
 // with an alternative currency (not CELO)
 func getIntrinsicGasForAlternativeFeeCurrency(vmRunner vm.EVMRunner) (uint64, error) {
-	var gas *big.Int
-	err := intrinsicGasForAlternativeFeeCurrencyMethod.Query(vmRunner, &gas)
-
+	blockchainParameters, err := bindBlockchainParameters(vmRunner, "intrinsicGasForAlternativeFeeCurrency")
+	if err != nil {
+		return 0, err
+	}
+	gas, err := blockchainParameters.IntrinsicGasForAlternativeFeeCurrency(contracts.QueryOpts(params.MaxGasForReadBlockchainParameter))
 	if err != nil {
 		return 0, err
 	}
@@ -89,8 +95,11 @@ func GetBlockGasLimitOrDefault(vmRunner vm.EVMRunner) uint64 {
 
 // getBlockGasLimit retrieves the block max gas limit
 func getBlockGasLimit(vmRunner vm.EVMRunner) (uint64, error) {
-	var gasLimit *big.Int
-	err := blockGasLimitMethod.Query(vmRunner, &gasLimit)
+	blockchainParameters, err := bindBlockchainParameters(vmRunner, "blockGasLimit")
+	if err != nil {
+		return 0, err
+	}
+	gasLimit, err := blockchainParameters.BlockGasLimit(contracts.QueryOpts(params.MaxGasForReadBlockchainParameter))
 	if err != nil {
 		return 0, err
 	}
@@ -100,9 +109,12 @@ func getBlockGasLimit(vmRunner vm.EVMRunner) (uint64, error) {
 // GetLookbackWindow retrieves the lookback window parameter to be used
 // for uptime score computations
 func GetLookbackWindow(vmRunner vm.EVMRunner) (uint64, error) {
-	var lookbackWindow *big.Int
-	err := getUptimeLookbackWindowMethod.Query(vmRunner, &lookbackWindow)
-
+	blockchainParameters, err := bindBlockchainParameters(vmRunner, "getUptimeLookbackWindow")
+	if err != nil {
+		logError("getUptimeLookbackWindow", err)
+		return 0, err
+	}
+	lookbackWindow, err := blockchainParameters.GetUptimeLookbackWindow(contracts.QueryOpts(params.MaxGasForReadBlockchainParameter))
 	if err != nil {
 		logError("getUptimeLookbackWindow", err)
 		return 0, err
@@ -21,17 +21,29 @@ import (
 
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/contracts"
-	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/log"
 	"github.com/aaronwinter/celo-blockchain/params"
 )
 
-var (
-	medianRateMethod   = contracts.NewRegisteredContractMethod(params.SortedOraclesRegistryId, abis.SortedOracles, "medianRate", params.MaxGasForMedianRate)
-	getWhitelistMethod = contracts.NewRegisteredContractMethod(params.FeeCurrencyWhitelistRegistryId, abis.FeeCurrency, "getWhitelist", params.MaxGasForGetWhiteList)
-	getBalanceMethod   = contracts.NewMethod(abis.ERC20, "balanceOf", params.MaxGasToReadErc20Balance)
-)
+// bindSortedOracles binds the SortedOracles contract to call method on.
+func bindSortedOracles(vmRunner vm.EVMRunner, method string) (*bindings.SortedOracles, error) {
+	address, err := contracts.ResolveAddressForCall(vmRunner, params.SortedOraclesRegistryId, method)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewSortedOracles(address, contracts.NewEVMBackend(vmRunner))
+}
+
+// bindFeeCurrencyWhitelist binds the FeeCurrencyWhitelist contract to call method on.
+func bindFeeCurrencyWhitelist(vmRunner vm.EVMRunner, method string) (*bindings.FeeCurrencyWhitelist, error) {
+	address, err := contracts.ResolveAddressForCall(vmRunner, params.FeeCurrencyWhitelistRegistryId, method)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewFeeCurrencyWhitelist(address, contracts.NewEVMBackend(vmRunner))
+}
 
 // NoopExchangeRate represents an exchange rate of 1 to 1
 var NoopExchangeRate = ExchangeRate{common.Big1, common.Big1}
@@ -200,7 +212,10 @@ func GetExchangeRate(vmRunner vm.EVMRunner, currencyAddress *common.Address) (*E
 
 	var returnArray [2]*big.Int
 
-	err := medianRateMethod.Query(vmRunner, &returnArray, currencyAddress)
+	sortedOracles, err := bindSortedOracles(vmRunner, "medianRate")
+	if err == nil {
+		returnArray[0], returnArray[1], err = sortedOracles.MedianRate(contracts.QueryOpts(params.MaxGasForMedianRate), *currencyAddress)
+	}
 
 	if err == contracts.ErrSmartContractNotDeployed {
 		log.Warn("Registry address lookup failed", "err", err)
@@ -218,7 +233,11 @@ func GetExchangeRate(vmRunner vm.EVMRunner, currencyAddress *common.Address) (*E
 func GetBalanceOf(vmRunner vm.EVMRunner, accountOwner common.Address, contractAddress common.Address) (result *big.Int, err error) {
 	log.Trace("GetBalanceOf() Called", "accountOwner", accountOwner.Hex(), "contractAddress", contractAddress)
 
-	err = getBalanceMethod.Bind(contractAddress).Query(vmRunner, &result, accountOwner)
+	// Fee currencies are ERC20 tokens, the StableToken binding is used for their common methods
+	token, err := bindings.NewStableToken(contractAddress, contracts.NewEVMBackend(vmRunner))
+	if err == nil {
+		result, err = token.BalanceOf(contracts.QueryOpts(params.MaxGasToReadErc20Balance), accountOwner)
+	}
 
 	if err != nil {
 		log.Error("GetBalanceOf evm invocation error", "err", err)
@@ -233,7 +252,10 @@ func GetBalanceOf(vmRunner vm.EVMRunner, accountOwner common.Address, contractAd
 func CurrencyWhitelist(vmRunner vm.EVMRunner) ([]common.Address, error) {
 	returnList := []common.Address{}
 
-	err := getWhitelistMethod.Query(vmRunner, &returnList)
+	whitelist, err := bindFeeCurrencyWhitelist(vmRunner, "getWhitelist")
+	if err == nil {
+		returnList, err = whitelist.GetWhitelist(contracts.QueryOpts(params.MaxGasForGetWhiteList))
+	}
 
 	if err == contracts.ErrSmartContractNotDeployed {
 		log.Warn("Registry address lookup failed", "err", err)
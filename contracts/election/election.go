@@ -21,25 +21,28 @@ import (
 
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/contracts"
-	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/log"
 	"github.com/aaronwinter/celo-blockchain/params"
 )
 
-var (
-	electValidatorSignersMethod                   = contracts.NewRegisteredContractMethod(params.ElectionRegistryId, abis.Elections, "electValidatorSigners", params.MaxGasForElectValidators)
-	getElectableValidatorsMethod                  = contracts.NewRegisteredContractMethod(params.ElectionRegistryId, abis.Elections, "getElectableValidators", params.MaxGasForGetElectableValidators)
-	electNValidatorSignersMethod                  = contracts.NewRegisteredContractMethod(params.ElectionRegistryId, abis.Elections, "electNValidatorSigners", params.MaxGasForElectNValidatorSigners)
-	getTotalVotesForEligibleValidatorGroupsMethod = contracts.NewRegisteredContractMethod(params.ElectionRegistryId, abis.Elections, "getTotalVotesForEligibleValidatorGroups", params.MaxGasForGetEligibleValidatorGroupsVoteTotals)
-	getGroupEpochRewardsMethod                    = contracts.NewRegisteredContractMethod(params.ElectionRegistryId, abis.Elections, "getGroupEpochRewards", params.MaxGasForGetGroupEpochRewards)
-	distributeEpochRewardsMethod                  = contracts.NewRegisteredContractMethod(params.ElectionRegistryId, abis.Elections, "distributeEpochRewards", params.MaxGasForDistributeEpochRewards)
-)
+// bindElection binds the Election contract to call method on.
+func bindElection(vmRunner vm.EVMRunner, method string) (*bindings.Election, error) {
+	address, err := contracts.ResolveAddressForCall(vmRunner, params.ElectionRegistryId, method)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewElection(address, contracts.NewEVMBackend(vmRunner))
+}
 
 func GetElectedValidators(vmRunner vm.EVMRunner) ([]common.Address, error) {
+	election, err := bindElection(vmRunner, "electValidatorSigners")
+	if err != nil {
+		return nil, err
+	}
 	// Get the new epoch's validator set
-	var newValSet []common.Address
-	err := electValidatorSignersMethod.Query(vmRunner, &newValSet)
+	newValSet, err := election.ElectValidatorSigners(contracts.QueryOpts(params.MaxGasForElectValidators))
 
 	if err != nil {
 		return nil, err
@@ -48,17 +51,18 @@ func GetElectedValidators(vmRunner vm.EVMRunner) ([]common.Address, error) {
 }
 
 func ElectNValidatorSigners(vmRunner vm.EVMRunner, additionalAboveMaxElectable int64) ([]common.Address, error) {
+	election, err := bindElection(vmRunner, "getElectableValidators")
+	if err != nil {
+		return nil, err
+	}
 	// Get the electable min and max
-	var minElectableValidators *big.Int
-	var maxElectableValidators *big.Int
-	err := getElectableValidatorsMethod.Query(vmRunner, &[]interface{}{&minElectableValidators, &maxElectableValidators})
+	minElectableValidators, maxElectableValidators, err := election.GetElectableValidators(contracts.QueryOpts(params.MaxGasForGetElectableValidators))
 	if err != nil {
 		return nil, err
 	}
 
 	// Run the validator election for up to maxElectable + getTotalVotesForEligibleValidatorGroup
-	var electedValidators []common.Address
-	err = electNValidatorSignersMethod.Query(vmRunner, &electedValidators, minElectableValidators, maxElectableValidators.Add(maxElectableValidators, big.NewInt(additionalAboveMaxElectable)))
+	electedValidators, err := election.ElectNValidatorSigners(contracts.QueryOpts(params.MaxGasForElectNValidatorSigners), minElectableValidators, maxElectableValidators.Add(maxElectableValidators, big.NewInt(additionalAboveMaxElectable)))
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +77,15 @@ type voteTotal struct {
 }
 
 func getTotalVotesForEligibleValidatorGroups(vmRunner vm.EVMRunner) ([]voteTotal, error) {
-	var groups []common.Address
-	var values []*big.Int
-	err := getTotalVotesForEligibleValidatorGroupsMethod.Query(vmRunner, &[]interface{}{&groups, &values})
+	election, err := bindElection(vmRunner, "getTotalVotesForEligibleValidatorGroups")
 	if err != nil {
 		return nil, err
 	}
+	totals, err := election.GetTotalVotesForEligibleValidatorGroups(contracts.QueryOpts(params.MaxGasForGetEligibleValidatorGroupsVoteTotals))
+	if err != nil {
+		return nil, err
+	}
+	groups, values := totals.Groups, totals.Values
 
 	voteTotals := make([]voteTotal, len(groups))
 	for i, group := range groups {
@@ -90,8 +97,11 @@ func getTotalVotesForEligibleValidatorGroups(vmRunner vm.EVMRunner) ([]voteTotal
 }
 
 func getGroupEpochRewards(vmRunner vm.EVMRunner, group common.Address, maxRewards *big.Int, uptimes []*big.Int) (*big.Int, error) {
-	var groupEpochRewards *big.Int
-	err := getGroupEpochRewardsMethod.Query(vmRunner, &groupEpochRewards, group, maxRewards, uptimes)
+	election, err := bindElection(vmRunner, "getGroupEpochRewards")
+	if err != nil {
+		return nil, err
+	}
+	groupEpochRewards, err := election.GetGroupEpochRewards(contracts.QueryOpts(params.MaxGasForGetGroupEpochRewards), group, maxRewards, uptimes)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +153,11 @@ func DistributeEpochRewards(vmRunner vm.EVMRunner, groups []common.Address, maxT
 				break
 			}
 		}
-		err := distributeEpochRewardsMethod.Execute(vmRunner, nil, common.Big0, group, reward, lesser, greater)
+		election, err := bindElection(vmRunner, "distributeEpochRewards")
+		if err != nil {
+			return totalRewards, err
+		}
+		err = election.DistributeEpochRewards(contracts.ExecuteOpts(params.MaxGasForDistributeEpochRewards), group, reward, lesser, greater).Execute(nil)
 		if err != nil {
 			return totalRewards, err
 		}
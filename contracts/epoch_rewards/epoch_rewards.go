@@ -20,31 +20,36 @@ import (
 
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/contracts"
-	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/params"
 )
 
-var (
-	calculateTargetEpochRewardsMethod = contracts.NewRegisteredContractMethod(params.EpochRewardsRegistryId, abis.EpochRewards, "calculateTargetEpochRewards", params.MaxGasForCalculateTargetEpochPaymentAndRewards)
-	isReserveLowMethod                = contracts.NewRegisteredContractMethod(params.EpochRewardsRegistryId, abis.EpochRewards, "isReserveLow", params.MaxGasForIsReserveLow)
-	carbonOffsettingPartnerMethod     = contracts.NewRegisteredContractMethod(params.EpochRewardsRegistryId, abis.EpochRewards, "carbonOffsettingPartner", params.MaxGasForGetCarbonOffsettingPartner)
-	updateTargetVotingYieldMethod     = contracts.NewRegisteredContractMethod(params.EpochRewardsRegistryId, abis.EpochRewards, "updateTargetVotingYield", params.MaxGasForUpdateTargetVotingYield)
-)
+// bindEpochRewards binds the EpochRewards contract to call method on.
+func bindEpochRewards(vmRunner vm.EVMRunner, method string) (*bindings.EpochRewards, error) {
+	address, err := contracts.ResolveAddressForCall(vmRunner, params.EpochRewardsRegistryId, method)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewEpochRewards(address, contracts.NewEVMBackend(vmRunner))
+}
 
 func UpdateTargetVotingYield(vmRunner vm.EVMRunner) error {
-	err := updateTargetVotingYieldMethod.Execute(vmRunner, nil, common.Big0)
-	return err
+	epochRewards, err := bindEpochRewards(vmRunner, "updateTargetVotingYield")
+	if err != nil {
+		return err
+	}
+	return epochRewards.UpdateTargetVotingYield(contracts.ExecuteOpts(params.MaxGasForUpdateTargetVotingYield)).Execute(nil)
 }
 
 // Returns the per validator epoch reward, the total voter reward, the total community reward, and
 // the total carbon offsetting partner award, for the epoch.
 func CalculateTargetEpochRewards(vmRunner vm.EVMRunner) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
-	var validatorEpochReward *big.Int
-	var totalVoterRewards *big.Int
-	var totalCommunityReward *big.Int
-	var totalCarbonOffsettingPartnerReward *big.Int
-	err := calculateTargetEpochRewardsMethod.Query(vmRunner, &[]interface{}{&validatorEpochReward, &totalVoterRewards, &totalCommunityReward, &totalCarbonOffsettingPartnerReward})
+	epochRewards, err := bindEpochRewards(vmRunner, "calculateTargetEpochRewards")
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	validatorEpochReward, totalVoterRewards, totalCommunityReward, totalCarbonOffsettingPartnerReward, err := epochRewards.CalculateTargetEpochRewards(contracts.QueryOpts(params.MaxGasForCalculateTargetEpochPaymentAndRewards))
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -53,8 +58,11 @@ func CalculateTargetEpochRewards(vmRunner vm.EVMRunner) (*big.Int, *big.Int, *bi
 
 // Determines if the reserve is below it's critical threshold
 func IsReserveLow(vmRunner vm.EVMRunner) (bool, error) {
-	var isLow bool
-	err := isReserveLowMethod.Query(vmRunner, &isLow)
+	epochRewards, err := bindEpochRewards(vmRunner, "isReserveLow")
+	if err != nil {
+		return false, err
+	}
+	isLow, err := epochRewards.IsReserveLow(contracts.QueryOpts(params.MaxGasForIsReserveLow))
 	if err != nil {
 		return false, err
 	}
@@ -63,8 +71,11 @@ func IsReserveLow(vmRunner vm.EVMRunner) (bool, error) {
 
 // Returns the address of the carbon offsetting partner
 func GetCarbonOffsettingPartnerAddress(vmRunner vm.EVMRunner) (common.Address, error) {
-	var carbonOffsettingPartner common.Address
-	err := carbonOffsettingPartnerMethod.Query(vmRunner, &carbonOffsettingPartner)
+	epochRewards, err := bindEpochRewards(vmRunner, "carbonOffsettingPartner")
+	if err != nil {
+		return common.ZeroAddress, err
+	}
+	carbonOffsettingPartner, err := epochRewards.CarbonOffsettingPartner(contracts.QueryOpts(params.MaxGasForGetCarbonOffsettingPartner))
 	if err != nil {
 		return common.ZeroAddress, err
 	}
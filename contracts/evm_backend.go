@@ -0,0 +1,218 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package contracts
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+
+	ethereum "github.com/aaronwinter/celo-blockchain"
+	"github.com/aaronwinter/celo-blockchain/accounts/abi"
+	bind "github.com/aaronwinter/celo-blockchain/accounts/abi/bind_v2"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/core/vm"
+	"github.com/aaronwinter/celo-blockchain/log"
+)
+
+var (
+	// ErrMissingGasLimit is returned for calls through an EVMBackend without gas limit.
+	ErrMissingGasLimit = errors.New("missing gas limit for EVM call")
+
+	errBlockNumberUnsupported = errors.New("EVM backend can't call at a given block")
+	errNotSupported           = errors.New("not supported by EVM backend")
+)
+
+// Check we actually implement the backend interfaces of the bindings
+var (
+	_ bind.ContractBackend  = &EVMBackend{}
+	_ bind.ContractExecutor = &EVMBackend{}
+)
+
+// EVMBackend is a contract backend running calls through an EVMRunner, so the
+// node can use the typed bindings of the core contracts against its own state.
+// Calls require a gas limit, and execute the methods of bound transactions
+// instead of sending them.
+type EVMBackend struct {
+	vmRunner vm.EVMRunner
+}
+
+// NewEVMBackend creates a contract backend running calls through vmRunner.
+func NewEVMBackend(vmRunner vm.EVMRunner) *EVMBackend {
+	return &EVMBackend{vmRunner: vmRunner}
+}
+
+// QueryOpts returns the options of read-only calls through an EVMBackend.
+func QueryOpts(gas uint64) *bind.CallOpts {
+	return &bind.CallOpts{GasLimit: gas}
+}
+
+// ExecuteOpts returns the options of executing methods through an EVMBackend.
+func ExecuteOpts(gas uint64) *bind.TransactOpts {
+	return &bind.TransactOpts{GasLimit: gas, Value: common.Big0}
+}
+
+// CodeAt implements bind.ContractCaller. The runner doesn't expose the code of
+// contracts, so calls with an empty output fail with bind.ErrNoCode.
+func (b *EVMBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+// CallContract implements bind.ContractCaller, querying the state of the runner.
+func (b *EVMBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if blockNumber != nil {
+		return nil, errBlockNumberUnsupported
+	}
+	return b.run(call, true)
+}
+
+// ExecuteContract implements bind.ContractExecutor, executing the call on the
+// state of the runner. The sender defaults to the zero address.
+func (b *EVMBackend) ExecuteContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	return b.run(call, false)
+}
+
+func (b *EVMBackend) run(call ethereum.CallMsg, readOnly bool) ([]byte, error) {
+	if call.To == nil {
+		return nil, errNotSupported
+	}
+	method := methodName(call.Data)
+	defer meterExecutionTime(method)()
+
+	logger := log.New("to", *call.To, "method", method)
+	if call.Gas == 0 {
+		return nil, ErrMissingGasLimit
+	}
+	value := call.Value
+	if value == nil {
+		value = common.Big0
+	}
+	var (
+		output []byte
+		err    error
+	)
+	switch {
+	case readOnly:
+		output, err = b.vmRunner.Query(*call.To, call.Data, call.Gas)
+	case call.From != common.ZeroAddress:
+		output, err = b.vmRunner.ExecuteFrom(call.From, *call.To, call.Data, call.Gas, value)
+	default:
+		output, err = b.vmRunner.Execute(*call.To, call.Data, call.Gas, value)
+	}
+	if err != nil {
+		message, _ := unpackError(output)
+		logger.Error("Error invoking evm function: EVM call failure", "input", hexutil.Encode(call.Data), "maxgas", call.Gas, "err", err, "message", message)
+		return nil, err
+	}
+	logger.Trace("EVM call successful", "input", hexutil.Encode(call.Data), "output", hexutil.Encode(output))
+	return output, nil
+}
+
+// PendingCodeAt implements bind.ContractTransactor, unsupported by the runner.
+func (b *EVMBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return nil, errNotSupported
+}
+
+// PendingNonceAt implements bind.ContractTransactor, unsupported by the runner.
+func (b *EVMBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return 0, errNotSupported
+}
+
+// SuggestGasPrice implements bind.ContractTransactor, unsupported by the runner.
+func (b *EVMBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return nil, errNotSupported
+}
+
+// EstimateGas implements bind.ContractTransactor, unsupported by the runner.
+func (b *EVMBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return 0, errNotSupported
+}
+
+// SendTransaction implements bind.ContractTransactor, unsupported by the runner.
+func (b *EVMBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return errNotSupported
+}
+
+// TransactionReceipt implements bind.ContractTransactor, unsupported by the runner.
+func (b *EVMBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return nil, errNotSupported
+}
+
+// FilterLogs implements bind.ContractFilterer, unsupported by the runner.
+func (b *EVMBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, errNotSupported
+}
+
+// SubscribeFilterLogs implements bind.ContractFilterer, unsupported by the runner.
+func (b *EVMBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errNotSupported
+}
+
+var (
+	methodNames     map[string]string
+	methodNamesOnce sync.Once
+)
+
+// methodName returns the name of the core contract method called with input,
+// for logs and metrics.
+func methodName(input []byte) string {
+	if len(input) < 4 {
+		return "<none>"
+	}
+	methodNamesOnce.Do(func() {
+		methodNames = make(map[string]string)
+		for _, parse := range []func() (*abi.ABI, error){
+			bindings.ParseAccountsABI,
+			bindings.ParseBlockchainParametersABI,
+			bindings.ParseDoubleSigningSlasherABI,
+			bindings.ParseDowntimeSlasherABI,
+			bindings.ParseElectionABI,
+			bindings.ParseEpochRewardsABI,
+			bindings.ParseExchangeABI,
+			bindings.ParseFeeCurrencyWhitelistABI,
+			bindings.ParseFreezerABI,
+			bindings.ParseGasPriceMinimumABI,
+			bindings.ParseGoldTokenABI,
+			bindings.ParseGovernanceABI,
+			bindings.ParseGovernanceSlasherABI,
+			bindings.ParseLockedGoldABI,
+			bindings.ParseRandomABI,
+			bindings.ParseRegistryABI,
+			bindings.ParseReserveABI,
+			bindings.ParseSortedOraclesABI,
+			bindings.ParseStableTokenABI,
+			bindings.ParseValidatorsABI,
+		} {
+			parsed, err := parse()
+			if err != nil {
+				log.Error("Failed to parse core contract ABI", "err", err)
+				continue
+			}
+			for _, method := range parsed.Methods {
+				methodNames[string(method.ID)] = method.RawName
+			}
+		}
+	})
+	if name, ok := methodNames[string(input[:4])]; ok {
+		return name
+	}
+	return hexutil.Encode(input[:4])
+}
@@ -0,0 +1,78 @@
+package contracts_test
+
+import (
+	"math/big"
+	"testing"
+
+	bind "github.com/aaronwinter/celo-blockchain/accounts/abi/bind_v2"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/contracts"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
+	"github.com/aaronwinter/celo-blockchain/contracts/testutil"
+	"github.com/aaronwinter/celo-blockchain/params"
+	. "github.com/onsi/gomega"
+)
+
+// recordingContract records the input of its last call.
+type recordingContract struct {
+	input []byte
+}
+
+func (rc *recordingContract) Call(input []byte) ([]byte, error) {
+	rc.input = input
+	return nil, nil
+}
+
+func TestEVMBackendQuery(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	runner := testutil.NewMockEVMRunner()
+	registry := testutil.NewRegistryMock()
+	runner.RegisterContract(params.RegistrySmartContractAddress, registry)
+	registry.AddContract(params.ElectionRegistryId, common.HexToAddress("0x01"))
+
+	contract, err := bindings.NewRegistry(params.RegistrySmartContractAddress, contracts.NewEVMBackend(runner))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	address, err := contract.GetAddressFor(contracts.QueryOpts(params.MaxGasForGetAddressFor), params.ElectionRegistryId)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(address).To(Equal(common.HexToAddress("0x01")))
+
+	_, err = contract.GetAddressFor(contracts.QueryOpts(0), params.ElectionRegistryId)
+	g.Expect(err).To(MatchError(contracts.ErrMissingGasLimit))
+
+	_, err = contract.GetAddressFor(&bind.CallOpts{GasLimit: params.MaxGasForGetAddressFor, BlockNumber: common.Big1}, params.ElectionRegistryId)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestEVMBackendNoCode(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	contract, err := bindings.NewRegistry(params.RegistrySmartContractAddress, contracts.NewEVMBackend(testutil.NewMockEVMRunner()))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = contract.GetAddressFor(contracts.QueryOpts(params.MaxGasForGetAddressFor), params.ElectionRegistryId)
+	g.Expect(err).To(MatchError(bind.ErrNoCode))
+}
+
+func TestEVMBackendExecute(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	runner := testutil.NewMockEVMRunner()
+	goldTokenAddress := common.HexToAddress("0x02")
+	recorder := &recordingContract{}
+	runner.RegisterContract(goldTokenAddress, recorder)
+
+	contract, err := bindings.NewGoldToken(goldTokenAddress, contracts.NewEVMBackend(runner))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	beneficiary, value := common.HexToAddress("0x03"), big.NewInt(100)
+	err = contract.Mint(contracts.ExecuteOpts(params.MaxGasForMintGas), beneficiary, value).Execute(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	parsed, err := bindings.ParseGoldTokenABI()
+	g.Expect(err).NotTo(HaveOccurred())
+	input, err := parsed.Pack("mint", beneficiary, value)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(recorder.input).To(Equal(input))
+}
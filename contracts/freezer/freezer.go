@@ -19,25 +19,25 @@ package freezer
 import (
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/contracts"
-	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/params"
 )
 
-var (
-	isFrozenMethod = contracts.NewRegisteredContractMethod(params.FreezerRegistryId, abis.Freezer, "isFrozen", params.MaxGasForIsFrozen)
-)
-
 func IsFrozen(vmRunner vm.EVMRunner, registryId common.Hash) (bool, error) {
 	address, err := contracts.GetRegisteredAddress(vmRunner, registryId)
 	if err != nil {
 		return false, err
 	}
 
-	var isFrozen bool
-	if err := isFrozenMethod.Query(vmRunner, &isFrozen, address); err != nil {
+	freezerAddress, err := contracts.ResolveAddressForCall(vmRunner, params.FreezerRegistryId, "isFrozen")
+	if err != nil {
+		return false, err
+	}
+	freezer, err := bindings.NewFreezer(freezerAddress, contracts.NewEVMBackend(vmRunner))
+	if err != nil {
 		return false, err
 	}
 
-	return isFrozen, nil
+	return freezer.IsFrozen(contracts.QueryOpts(params.MaxGasForIsFrozen), address)
 }
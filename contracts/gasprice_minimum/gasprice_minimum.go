@@ -21,7 +21,7 @@ import (
 
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/contracts"
-	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/contracts/blockchain_parameters"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/params"
@@ -32,10 +32,14 @@ var (
 	suggestionMultiplier    *big.Int = big.NewInt(5) // The multiplier that we apply to the minimum when suggesting gas price
 )
 
-var (
-	getGasPriceMinimumMethod    = contracts.NewRegisteredContractMethod(params.GasPriceMinimumRegistryId, abis.GasPriceMinimum, "getGasPriceMinimum", params.MaxGasForGetGasPriceMinimum)
-	updateGasPriceMinimumMethod = contracts.NewRegisteredContractMethod(params.GasPriceMinimumRegistryId, abis.GasPriceMinimum, "updateGasPriceMinimum", params.MaxGasForUpdateGasPriceMinimum)
-)
+// bindGasPriceMinimum binds the GasPriceMinimum contract to call method on.
+func bindGasPriceMinimum(vmRunner vm.EVMRunner, method string) (*bindings.GasPriceMinimum, error) {
+	address, err := contracts.ResolveAddressForCall(vmRunner, params.GasPriceMinimumRegistryId, method)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewGasPriceMinimum(address, contracts.NewEVMBackend(vmRunner))
+}
 
 func GetGasPriceSuggestion(vmRunner vm.EVMRunner, currency *common.Address) (*big.Int, error) {
 	gasPriceMinimum, err := GetGasPriceMinimum(vmRunner, currency)
@@ -60,7 +64,10 @@ func GetGasPriceMinimum(vmRunner vm.EVMRunner, currency *common.Address) (*big.I
 	}
 
 	var gasPriceMinimum *big.Int
-	err = getGasPriceMinimumMethod.Query(vmRunner, &gasPriceMinimum, currencyAddress)
+	gasPriceMinimumContract, err := bindGasPriceMinimum(vmRunner, "getGasPriceMinimum")
+	if err == nil {
+		gasPriceMinimum, err = gasPriceMinimumContract.GetGasPriceMinimum(contracts.QueryOpts(params.MaxGasForGetGasPriceMinimum), currencyAddress)
+	}
 
 	if err == contracts.ErrSmartContractNotDeployed || err == contracts.ErrRegistryContractNotDeployed {
 		return FallbackGasPriceMinimum, nil
@@ -78,7 +85,11 @@ func UpdateGasPriceMinimum(vmRunner vm.EVMRunner, lastUsedGas uint64) (*big.Int,
 	// If an error occurs, the default block gas limit will be returned and a log statement will be produced by GetBlockGasLimitOrDefault
 	gasLimit := blockchain_parameters.GetBlockGasLimitOrDefault(vmRunner)
 
-	err := updateGasPriceMinimumMethod.Execute(vmRunner, &updatedGasPriceMinimum, common.Big0, big.NewInt(int64(lastUsedGas)), big.NewInt(int64(gasLimit)))
+	gasPriceMinimum, err := bindGasPriceMinimum(vmRunner, "updateGasPriceMinimum")
+	if err != nil {
+		return nil, err
+	}
+	err = gasPriceMinimum.UpdateGasPriceMinimum(contracts.ExecuteOpts(params.MaxGasForUpdateGasPriceMinimum), big.NewInt(int64(lastUsedGas)), big.NewInt(int64(gasLimit))).Execute(&updatedGasPriceMinimum)
 
 	if err != nil {
 		return nil, err
@@ -5,26 +5,34 @@ import (
 
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/contracts"
-	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/params"
 )
 
-var (
-	totalSupplyMethod    = contracts.NewRegisteredContractMethod(params.GoldTokenRegistryId, abis.GoldToken, "totalSupply", params.MaxGasForTotalSupply)
-	increaseSupplyMethod = contracts.NewRegisteredContractMethod(params.GoldTokenRegistryId, abis.GoldToken, "increaseSupply", params.MaxGasForIncreaseSupply)
-	mintMethod           = contracts.NewRegisteredContractMethod(params.GoldTokenRegistryId, abis.GoldToken, "mint", params.MaxGasForMintGas)
-)
+// bindGoldToken binds the GoldToken contract to call method on.
+func bindGoldToken(vmRunner vm.EVMRunner, method string) (*bindings.GoldToken, error) {
+	address, err := contracts.ResolveAddressForCall(vmRunner, params.GoldTokenRegistryId, method)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewGoldToken(address, contracts.NewEVMBackend(vmRunner))
+}
 
 func GetTotalSupply(vmRunner vm.EVMRunner) (*big.Int, error) {
-	var totalSupply *big.Int
-	err := totalSupplyMethod.Query(vmRunner, &totalSupply)
-	return totalSupply, err
+	goldToken, err := bindGoldToken(vmRunner, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+	return goldToken.TotalSupply(contracts.QueryOpts(params.MaxGasForTotalSupply))
 }
 
 func IncreaseSupply(vmRunner vm.EVMRunner, value *big.Int) error {
-	err := increaseSupplyMethod.Execute(vmRunner, nil, common.Big0, value)
-	return err
+	goldToken, err := bindGoldToken(vmRunner, "increaseSupply")
+	if err != nil {
+		return err
+	}
+	return goldToken.IncreaseSupply(contracts.ExecuteOpts(params.MaxGasForIncreaseSupply), value).Execute(nil)
 }
 
 func Mint(vmRunner vm.EVMRunner, beneficiary common.Address, value *big.Int) error {
@@ -32,6 +40,9 @@ func Mint(vmRunner vm.EVMRunner, beneficiary common.Address, value *big.Int) err
 		return nil
 	}
 
-	err := mintMethod.Execute(vmRunner, nil, common.Big0, beneficiary, value)
-	return err
+	goldToken, err := bindGoldToken(vmRunner, "mint")
+	if err != nil {
+		return err
+	}
+	return goldToken.Mint(contracts.ExecuteOpts(params.MaxGasForMintGas), beneficiary, value).Execute(nil)
 }
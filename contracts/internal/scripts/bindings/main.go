@@ -0,0 +1,99 @@
+// This program generates the typed bindings of the core contracts in
+// contracts/bindings, from the truffle build files of the celo-monorepo.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/aaronwinter/celo-blockchain/accounts/abi/bind_v2"
+)
+
+// contractNames are the core contracts to generate bindings for. Contracts
+// sharing their implementation (e.g. StableTokenEUR) use the same binding.
+var contractNames = []string{
+	"Accounts",
+	"BlockchainParameters",
+	"DoubleSigningSlasher",
+	"DowntimeSlasher",
+	"Election",
+	"EpochRewards",
+	"Exchange",
+	"FeeCurrencyWhitelist",
+	"Freezer",
+	"GasPriceMinimum",
+	"GoldToken",
+	"Governance",
+	"GovernanceSlasher",
+	"LockedGold",
+	"Random",
+	"Registry",
+	"Reserve",
+	"SortedOracles",
+	"StableToken",
+	"Validators",
+}
+
+var buildPath = flag.String("buildpath", "", "the folder where truffle contract build live (on monorepo ./packages/protocol/build/contracts )")
+var outPath = flag.String("outpath", "./contracts/bindings", "relative path to contracts/bindings package")
+
+func readABI(truffleJsonFile string) (string, error) {
+	jsonData, err := ioutil.ReadFile(truffleJsonFile)
+	if err != nil {
+		return "", fmt.Errorf("Can't read build file for %s: %w", truffleJsonFile, err)
+	}
+
+	var data struct {
+		ABI json.RawMessage `json:"abi"`
+	}
+
+	err = json.Unmarshal(jsonData, &data)
+	if err != nil {
+		return "", fmt.Errorf("Can't read ABI for %s: %w", truffleJsonFile, err)
+	}
+
+	return string(data.ABI), nil
+}
+
+var wordBoundary = regexp.MustCompile("([a-z0-9])([A-Z])")
+
+// fileName returns the name of the generated file for a contract, e.g.
+// gen_locked_gold.go for LockedGold.
+func fileName(contract string) string {
+	return "gen_" + strings.ToLower(wordBoundary.ReplaceAllString(contract, "${1}_${2}")) + ".go"
+}
+
+func main() {
+	flag.Parse()
+
+	if buildPath == nil || *buildPath == "" {
+		fmt.Println("Missing --buildpath variable")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	fmt.Printf("Generating bindings on %s.\nReading contracts from %s\n", *outPath, *buildPath)
+
+	for _, name := range contractNames {
+		abi, err := readABI(path.Join(*buildPath, name+".json"))
+		die(err)
+
+		code, err := bind_v2.Bind([]string{name}, []string{abi}, []string{""}, nil, "bindings", bind_v2.LangGo, nil, nil)
+		die(err)
+
+		die(ioutil.WriteFile(path.Join(*outPath, fileName(name)), []byte(code), 0600))
+	}
+}
+
+func die(err error) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -6,19 +6,20 @@ import (
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
 	"github.com/aaronwinter/celo-blockchain/contracts"
-	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/log"
 	"github.com/aaronwinter/celo-blockchain/params"
 )
 
-var (
-	revealAndCommitMethod    = contracts.NewRegisteredContractMethod(params.RandomRegistryId, abis.Random, "revealAndCommit", params.MaxGasForRevealAndCommit)
-	commitmentsMethod        = contracts.NewRegisteredContractMethod(params.RandomRegistryId, abis.Random, "commitments", params.MaxGasForCommitments)
-	computeCommitmentMethod  = contracts.NewRegisteredContractMethod(params.RandomRegistryId, abis.Random, "computeCommitment", params.MaxGasForComputeCommitment)
-	randomMethod             = contracts.NewRegisteredContractMethod(params.RandomRegistryId, abis.Random, "random", params.MaxGasForBlockRandomness)
-	getBlockRandomnessMethod = contracts.NewRegisteredContractMethod(params.RandomRegistryId, abis.Random, "getBlockRandomness", params.MaxGasForBlockRandomness)
-)
+// bindRandom binds the Random contract to call method on.
+func bindRandom(vmRunner vm.EVMRunner, method string) (*bindings.Random, error) {
+	address, err := contracts.ResolveAddressForCall(vmRunner, params.RandomRegistryId, method)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewRandom(address, contracts.NewEVMBackend(vmRunner))
+}
 
 func IsRunning(vmRunner vm.EVMRunner) bool {
 	randomAddress, err := contracts.GetRegisteredAddress(vmRunner, params.RandomRegistryId)
@@ -35,7 +36,10 @@ func IsRunning(vmRunner vm.EVMRunner) bool {
 // GetLastCommitment returns up the last commitment in the smart contract
 func GetLastCommitment(vmRunner vm.EVMRunner, validator common.Address) (common.Hash, error) {
 	lastCommitment := common.Hash{}
-	err := commitmentsMethod.Query(vmRunner, &lastCommitment, validator)
+	random, err := bindRandom(vmRunner, "commitments")
+	if err == nil {
+		lastCommitment, err = random.Commitments(contracts.QueryOpts(params.MaxGasForCommitments), validator)
+	}
 	if err != nil {
 		log.Error("Failed to get last commitment", "err", err)
 		return lastCommitment, err
@@ -51,8 +55,11 @@ func GetLastCommitment(vmRunner vm.EVMRunner, validator common.Address) (common.
 // ComputeCommitment calulcates the commitment for a given randomness.
 func ComputeCommitment(vmRunner vm.EVMRunner, randomness common.Hash) (common.Hash, error) {
 	commitment := common.Hash{}
-	// TODO(asa): Make an issue to not have to do this via StaticCall
-	err := computeCommitmentMethod.Query(vmRunner, &commitment, randomness)
+	random, err := bindRandom(vmRunner, "computeCommitment")
+	if err == nil {
+		// TODO(asa): Make an issue to not have to do this via StaticCall
+		commitment, err = random.ComputeCommitment(contracts.QueryOpts(params.MaxGasForComputeCommitment), randomness)
+	}
 	if err != nil {
 		log.Error("Failed to call computeCommitment()", "err", err)
 		return common.Hash{}, err
@@ -67,20 +74,26 @@ func ComputeCommitment(vmRunner vm.EVMRunner, randomness common.Hash) (common.Ha
 func RevealAndCommit(vmRunner vm.EVMRunner, randomness, newCommitment common.Hash, proposer common.Address) error {
 
 	log.Trace("Revealing and committing randomness", "randomness", randomness.Hex(), "commitment", newCommitment.Hex())
-	err := revealAndCommitMethod.Execute(vmRunner, nil, common.Big0, randomness, newCommitment, proposer)
-
-	return err
+	random, err := bindRandom(vmRunner, "revealAndCommit")
+	if err != nil {
+		return err
+	}
+	return random.RevealAndCommit(contracts.ExecuteOpts(params.MaxGasForRevealAndCommit), randomness, newCommitment, proposer).Execute(nil)
 }
 
 // Random performs an internal call to the EVM to retrieve the current randomness from the official Random contract.
 func Random(vmRunner vm.EVMRunner) (common.Hash, error) {
-	randomness := common.Hash{}
-	err := randomMethod.Query(vmRunner, &randomness)
-	return randomness, err
+	random, err := bindRandom(vmRunner, "random")
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return random.Random(contracts.QueryOpts(params.MaxGasForBlockRandomness))
 }
 
 func BlockRandomness(vmRunner vm.EVMRunner, blockNumber uint64) (common.Hash, error) {
-	randomness := common.Hash{}
-	err := getBlockRandomnessMethod.Query(vmRunner, &randomness, big.NewInt(int64(blockNumber)))
-	return randomness, err
+	random, err := bindRandom(vmRunner, "getBlockRandomness")
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return random.GetBlockRandomness(contracts.QueryOpts(params.MaxGasForBlockRandomness), big.NewInt(int64(blockNumber)))
 }
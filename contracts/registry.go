@@ -2,14 +2,13 @@ package contracts
 
 import (
 	"github.com/aaronwinter/celo-blockchain/accounts/abi"
+	bind "github.com/aaronwinter/celo-blockchain/accounts/abi/bind_v2"
 	"github.com/aaronwinter/celo-blockchain/common"
-	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/params"
 )
 
-var getAddressMethod = NewBoundMethod(params.RegistrySmartContractAddress, abis.Registry, "getAddressFor", params.MaxGasForGetAddressFor)
-
 // TODO(kevjue) - Re-Enable caching of the retrieved registered address
 // See this commit for the removed code for caching:  https://github.com/celo-org/geth/commit/43a275273c480d307a3d2b3c55ca3b3ee31ec7dd.
 
@@ -19,12 +18,15 @@ func GetRegisteredAddress(vmRunner vm.EVMRunner, registryId common.Hash) (common
 	vmRunner.StopGasMetering()
 	defer vmRunner.StartGasMetering()
 
-	var contractAddress common.Address
-	err := getAddressMethod.Query(vmRunner, &contractAddress, registryId)
+	registry, err := bindings.NewRegistry(params.RegistrySmartContractAddress, NewEVMBackend(vmRunner))
+	if err != nil {
+		return common.ZeroAddress, err
+	}
+	contractAddress, err := registry.GetAddressFor(QueryOpts(params.MaxGasForGetAddressFor), registryId)
 
 	// TODO (mcortesi) Remove ErrEmptyArguments check after we change Proxy to fail on unset impl
 	// TODO(asa): Why was this change necessary?
-	if err == abi.ErrEmptyArguments || err == vm.ErrExecutionReverted {
+	if err == bind.ErrNoCode || err == abi.ErrEmptyArguments || err == vm.ErrExecutionReverted {
 		return common.ZeroAddress, ErrRegistryContractNotDeployed
 	} else if err != nil {
 		return common.ZeroAddress, err
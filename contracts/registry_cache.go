@@ -0,0 +1,195 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package contracts
+
+import (
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/core/vm"
+	"github.com/aaronwinter/celo-blockchain/crypto"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/params"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// CoreContractNames are the registry identifiers of the Celo core contracts.
+// The names are taken from celo-monorepo/packages/protocol/lib/registry-utils.ts
+var CoreContractNames = []string{
+	"Accounts",
+	"Attestations",
+	"BlockchainParameters",
+	"DoubleSigningSlasher",
+	"DowntimeSlasher",
+	"Election",
+	"EpochRewards",
+	"Escrow",
+	"Exchange",
+	"ExchangeEUR",
+	"FeeCurrencyWhitelist",
+	"Freezer",
+	"GasPriceMinimum",
+	"GoldToken",
+	"Governance",
+	"GovernanceApproverMultiSig",
+	"LockedGold",
+	"Random",
+	"Reserve",
+	"ReserveSpenderMultiSig",
+	"SortedOracles",
+	"StableToken",
+	"StableTokenEUR",
+	"TransferWhitelist",
+	"Validators",
+}
+
+var (
+	registryUpdatedEvent = abis.Registry.Events["RegistryUpdated"]
+
+	coreContracts = make(map[string]bool, len(CoreContractNames))
+)
+
+func init() {
+	for _, name := range CoreContractNames {
+		coreContracts[name] = true
+	}
+}
+
+// Registry maps the identifiers of the contracts in the registry to their addresses.
+type Registry map[string]common.Address
+
+// Copy returns a copy of the registry.
+func (r Registry) Copy() Registry {
+	cpy := make(Registry, len(r))
+	for name, addr := range r {
+		cpy[name] = addr
+	}
+	return cpy
+}
+
+// Apply updates the registry with the changes made to core contracts. Contracts
+// set to the zero address are removed.
+func (r Registry) Apply(updates []*RegistryUpdate) {
+	for _, update := range updates {
+		if !coreContracts[update.Identifier] {
+			continue
+		}
+		if update.Address == common.ZeroAddress {
+			delete(r, update.Identifier)
+		} else {
+			r[update.Identifier] = update.Address
+		}
+	}
+}
+
+// GetRegistry returns the addresses of all the core contracts in the registry.
+// Core contracts which are not registered are left out.
+func GetRegistry(vmRunner vm.EVMRunner) (Registry, error) {
+	registry := make(Registry, len(CoreContractNames))
+	for _, name := range CoreContractNames {
+		addr, err := GetRegisteredAddress(vmRunner, crypto.Keccak256Hash([]byte(name)))
+		if err == ErrSmartContractNotDeployed {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		registry[name] = addr
+	}
+	return registry, nil
+}
+
+// RegistryUpdate is a change of the address registered for an identifier,
+// as announced by a RegistryUpdated event.
+type RegistryUpdate struct {
+	Identifier     string         `json:"identifier"`
+	IdentifierHash common.Hash    `json:"identifierHash"`
+	Address        common.Address `json:"address"`
+
+	BlockNumber uint64      `json:"blockNumber"`
+	BlockHash   common.Hash `json:"blockHash"`
+	TxHash      common.Hash `json:"transactionHash"`
+}
+
+// MayContainRegistryUpdates reports whether the given bloom may contain
+// RegistryUpdated events emitted by the registry contract.
+func MayContainRegistryUpdates(bloom types.Bloom) bool {
+	return types.BloomLookup(bloom, params.RegistrySmartContractAddress) && types.BloomLookup(bloom, registryUpdatedEvent.ID)
+}
+
+// ParseRegistryUpdates extracts the registry changes from the given logs.
+// Malformed events are logged and skipped.
+func ParseRegistryUpdates(logs []*types.Log) []*RegistryUpdate {
+	var updates []*RegistryUpdate
+	for _, l := range logs {
+		if l.Address != params.RegistrySmartContractAddress || len(l.Topics) != 3 || l.Topics[0] != registryUpdatedEvent.ID {
+			continue
+		}
+		values, err := registryUpdatedEvent.Inputs.NonIndexed().UnpackValues(l.Data)
+		if err != nil || len(values) != 1 {
+			log.Warn("Invalid RegistryUpdated event", "block", l.BlockNumber, "tx", l.TxHash, "err", err)
+			continue
+		}
+		identifier, _ := values[0].(string)
+		updates = append(updates, &RegistryUpdate{
+			Identifier:     identifier,
+			IdentifierHash: l.Topics[1],
+			Address:        common.BytesToAddress(l.Topics[2].Bytes()),
+			BlockNumber:    l.BlockNumber,
+			BlockHash:      l.BlockHash,
+			TxHash:         l.TxHash,
+		})
+	}
+	return updates
+}
+
+// RegistryCache keeps the registry contents of recent states, keyed by state root.
+// Registries are derived from the parent state's one when possible, applying the
+// RegistryUpdated events of the block instead of querying every contract again.
+type RegistryCache struct {
+	cache *lru.Cache
+}
+
+// NewRegistryCache creates a cache holding the registries of up to size states.
+func NewRegistryCache(size int) *RegistryCache {
+	cache, _ := lru.New(size)
+	return &RegistryCache{cache: cache}
+}
+
+// Get returns a copy of the registry cached for the given state root.
+func (rc *RegistryCache) Get(root common.Hash) (Registry, bool) {
+	if cached, ok := rc.cache.Get(root); ok {
+		return cached.(Registry).Copy(), true
+	}
+	return nil, false
+}
+
+// Add stores the registry at the given state root.
+func (rc *RegistryCache) Add(root common.Hash, registry Registry) {
+	rc.cache.Add(root, registry.Copy())
+}
+
+// Derive computes the registry at root from the one cached for parentRoot and
+// the updates made in between, returning false if the parent's is not cached.
+func (rc *RegistryCache) Derive(parentRoot, root common.Hash, updates []*RegistryUpdate) (Registry, bool) {
+	registry, ok := rc.Get(parentRoot)
+	if !ok {
+		return nil, false
+	}
+	registry.Apply(updates)
+	rc.Add(root, registry)
+	return registry.Copy(), true
+}
@@ -0,0 +1,85 @@
+package contracts_test
+
+import (
+	"testing"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/contracts"
+	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/testutil"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/crypto"
+	"github.com/aaronwinter/celo-blockchain/params"
+	. "github.com/onsi/gomega"
+)
+
+func registryUpdatedLog(name string, addr common.Address) *types.Log {
+	event := abis.Registry.Events["RegistryUpdated"]
+	data, err := event.Inputs.NonIndexed().Pack(name)
+	if err != nil {
+		panic(err)
+	}
+	return &types.Log{
+		Address: params.RegistrySmartContractAddress,
+		Topics:  []common.Hash{event.ID, crypto.Keccak256Hash([]byte(name)), addr.Hash()},
+		Data:    data,
+	}
+}
+
+func TestGetRegistry(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	runner := testutil.NewMockEVMRunner()
+	registry := testutil.NewRegistryMock()
+	runner.RegisterContract(params.RegistrySmartContractAddress, registry)
+	registry.AddContract(params.ElectionRegistryId, common.HexToAddress("0x01"))
+	registry.AddContract(params.GoldTokenRegistryId, common.HexToAddress("0x02"))
+
+	contents, err := contracts.GetRegistry(runner)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(contents).To(Equal(contracts.Registry{
+		"Election":  common.HexToAddress("0x01"),
+		"GoldToken": common.HexToAddress("0x02"),
+	}))
+}
+
+func TestRegistryCacheDerive(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	logs := []*types.Log{
+		registryUpdatedLog("Election", common.HexToAddress("0x03")),
+		registryUpdatedLog("GoldToken", common.ZeroAddress),
+		registryUpdatedLog("NotACoreContract", common.HexToAddress("0x04")),
+		{Address: common.HexToAddress("0x05"), Topics: []common.Hash{abis.Registry.Events["RegistryUpdated"].ID}},
+	}
+	updates := contracts.ParseRegistryUpdates(logs)
+	g.Expect(updates).To(HaveLen(3))
+	g.Expect(updates[0].Identifier).To(Equal("Election"))
+	g.Expect(updates[0].IdentifierHash).To(Equal(common.Hash(params.ElectionRegistryId)))
+	g.Expect(updates[0].Address).To(Equal(common.HexToAddress("0x03")))
+
+	var bloom types.Bloom
+	g.Expect(contracts.MayContainRegistryUpdates(bloom)).To(BeFalse())
+	bloom = types.BytesToBloom(types.LogsBloom(logs).Bytes())
+	g.Expect(contracts.MayContainRegistryUpdates(bloom)).To(BeTrue())
+
+	cache := contracts.NewRegistryCache(4)
+	parentRoot, root := common.HexToHash("0x10"), common.HexToHash("0x11")
+
+	_, ok := cache.Derive(parentRoot, root, updates)
+	g.Expect(ok).To(BeFalse())
+
+	cache.Add(parentRoot, contracts.Registry{
+		"Election":  common.HexToAddress("0x01"),
+		"GoldToken": common.HexToAddress("0x02"),
+	})
+	derived, ok := cache.Derive(parentRoot, root, updates)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(derived).To(Equal(contracts.Registry{"Election": common.HexToAddress("0x03")}))
+
+	// The parent's registry is left untouched
+	parent, _ := cache.Get(parentRoot)
+	g.Expect(parent).To(HaveLen(2))
+	cached, _ := cache.Get(root)
+	g.Expect(cached).To(Equal(derived))
+}
@@ -1,12 +1,12 @@
 package reserve
 
 import (
-	"encoding/binary"
 	"errors"
 	"math/big"
 
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/contracts"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/params"
 )
@@ -31,18 +31,18 @@ func TobinTax(vmRunner vm.EVMRunner, sender common.Address) (tax Ratio, reserveA
 		return Ratio{}, common.ZeroAddress, err
 	}
 
-	ret, err := vmRunner.ExecuteFrom(sender, reserveAddress, params.TobinTaxFunctionSelector, params.MaxGasForGetOrComputeTobinTax, big.NewInt(0))
+	reserve, err := bindings.NewReserve(reserveAddress, contracts.NewEVMBackend(vmRunner))
 	if err != nil {
 		return Ratio{}, common.ZeroAddress, err
 	}
 
-	// Expected size of ret is 64 bytes because getOrComputeTobinTax() returns two uint256 values,
-	// each of which is equivalent to 32 bytes
-	if binary.Size(ret) != 64 {
-		return Ratio{}, common.ZeroAddress, errors.New("length of tobin tax not equal to 64 bytes")
+	// getOrComputeTobinTax() updates the cached tax, so it is executed on behalf of the sender
+	opts := contracts.ExecuteOpts(params.MaxGasForGetOrComputeTobinTax)
+	opts.From = sender
+	var numerator, denominator *big.Int
+	if err := reserve.GetOrComputeTobinTax(opts).Execute(&[]interface{}{&numerator, &denominator}); err != nil {
+		return Ratio{}, common.ZeroAddress, err
 	}
-	numerator := new(big.Int).SetBytes(ret[0:32])
-	denominator := new(big.Int).SetBytes(ret[32:64])
 	if denominator.Cmp(common.Big0) == 0 {
 		return Ratio{}, common.ZeroAddress, ErrTobinTaxZeroDenominator
 	}
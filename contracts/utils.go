@@ -37,8 +37,10 @@ func unpackError(result []byte) (string, error) {
 	return vs[0].(string), nil
 }
 
-func resolveAddressForCall(caller vm.EVMRunner, registryId common.Hash, method string) (common.Address, error) {
-	contractAddress, err := GetRegisteredAddress(caller, registryId)
+// ResolveAddressForCall returns the address registered for registryId, to call
+// method on.
+func ResolveAddressForCall(vmRunner vm.EVMRunner, registryId common.Hash, method string) (common.Address, error) {
+	contractAddress, err := GetRegisteredAddress(vmRunner, registryId)
 
 	if err != nil {
 		hexRegistryId := hexutil.Encode(registryId[:])
@@ -53,8 +55,3 @@ func resolveAddressForCall(caller vm.EVMRunner, registryId common.Hash, method s
 	}
 	return contractAddress, nil
 }
-
-// noopResolver returns a address resolver function that always resolve to the same address
-func noopResolver(addr common.Address) func(vm.EVMRunner) (common.Address, error) {
-	return func(e vm.EVMRunner) (common.Address, error) { return addr, nil }
-}
@@ -22,7 +22,7 @@ import (
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
 	"github.com/aaronwinter/celo-blockchain/contracts"
-	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
 	"github.com/aaronwinter/celo-blockchain/params"
@@ -36,20 +36,23 @@ type ValidatorContractData struct {
 	Signer         common.Address
 }
 
-var (
-	getRegisteredValidatorSignersMethod      = contracts.NewRegisteredContractMethod(params.ValidatorsRegistryId, abis.Validators, "getRegisteredValidatorSigners", params.MaxGasForGetRegisteredValidators)
-	getRegisteredValidatorsMethod            = contracts.NewRegisteredContractMethod(params.ValidatorsRegistryId, abis.Validators, "getRegisteredValidators", params.MaxGasForGetRegisteredValidators)
-	getValidatorBlsPublicKeyFromSignerMethod = contracts.NewRegisteredContractMethod(params.ValidatorsRegistryId, abis.Validators, "getValidatorBlsPublicKeyFromSigner", params.MaxGasForGetValidator)
-	getMembershipInLastEpochFromSignerMethod = contracts.NewRegisteredContractMethod(params.ValidatorsRegistryId, abis.Validators, "getMembershipInLastEpochFromSigner", params.MaxGasForGetMembershipInLastEpoch)
-	getValidatorMethod                       = contracts.NewRegisteredContractMethod(params.ValidatorsRegistryId, abis.Validators, "getValidator", params.MaxGasForGetValidator)
-	updateValidatorScoreFromSignerMethod     = contracts.NewRegisteredContractMethod(params.ValidatorsRegistryId, abis.Validators, "updateValidatorScoreFromSigner", params.MaxGasForUpdateValidatorScore)
-	distributeEpochPaymentsFromSignerMethod  = contracts.NewRegisteredContractMethod(params.ValidatorsRegistryId, abis.Validators, "distributeEpochPaymentsFromSigner", params.MaxGasForDistributeEpochPayment)
-)
+// bindValidators binds the Validators contract to call method on.
+func bindValidators(vmRunner vm.EVMRunner, method string) (*bindings.Validators, error) {
+	address, err := contracts.ResolveAddressForCall(vmRunner, params.ValidatorsRegistryId, method)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewValidators(address, contracts.NewEVMBackend(vmRunner))
+}
 
 func RetrieveRegisteredValidatorSigners(vmRunner vm.EVMRunner) ([]common.Address, error) {
+	validators, err := bindValidators(vmRunner, "getRegisteredValidatorSigners")
+	if err != nil {
+		return nil, err
+	}
 	// Get the new epoch's validator signer set
-	var regVals []common.Address
-	if err := getRegisteredValidatorSignersMethod.Query(vmRunner, &regVals); err != nil {
+	regVals, err := validators.GetRegisteredValidatorSigners(contracts.QueryOpts(params.MaxGasForGetRegisteredValidators))
+	if err != nil {
 		return nil, err
 	}
 
@@ -57,9 +60,13 @@ func RetrieveRegisteredValidatorSigners(vmRunner vm.EVMRunner) ([]common.Address
 }
 
 func RetrieveRegisteredValidators(vmRunner vm.EVMRunner) ([]common.Address, error) {
+	validators, err := bindValidators(vmRunner, "getRegisteredValidators")
+	if err != nil {
+		return nil, err
+	}
 	// Get the new epoch's validator set
-	var regVals []common.Address
-	if err := getRegisteredValidatorsMethod.Query(vmRunner, &regVals); err != nil {
+	regVals, err := validators.GetRegisteredValidators(contracts.QueryOpts(params.MaxGasForGetRegisteredValidators))
+	if err != nil {
 		return nil, err
 	}
 
@@ -67,8 +74,12 @@ func RetrieveRegisteredValidators(vmRunner vm.EVMRunner) ([]common.Address, erro
 }
 
 func GetValidator(vmRunner vm.EVMRunner, validatorAddress common.Address) (ValidatorContractData, error) {
-	var validator ValidatorContractData
-	err := getValidatorMethod.Query(vmRunner, &validator, validatorAddress)
+	validators, err := bindValidators(vmRunner, "getValidator")
+	if err != nil {
+		return ValidatorContractData{}, err
+	}
+	data, err := validators.GetValidator(contracts.QueryOpts(params.MaxGasForGetValidator), validatorAddress)
+	validator := ValidatorContractData(data)
 	if err != nil {
 		return validator, err
 	}
@@ -79,10 +90,13 @@ func GetValidator(vmRunner vm.EVMRunner, validatorAddress common.Address) (Valid
 }
 
 func GetValidatorData(vmRunner vm.EVMRunner, validatorAddresses []common.Address) ([]istanbul.ValidatorData, error) {
+	validators, err := bindValidators(vmRunner, "getValidatorBlsPublicKeyFromSigner")
+	if err != nil {
+		return nil, err
+	}
 	var validatorData []istanbul.ValidatorData
 	for _, addr := range validatorAddresses {
-		var blsKey []byte
-		err := getValidatorBlsPublicKeyFromSignerMethod.Query(vmRunner, &blsKey, addr)
+		blsKey, err := validators.GetValidatorBlsPublicKeyFromSigner(contracts.QueryOpts(params.MaxGasForGetValidator), addr)
 		if err != nil {
 			return nil, err
 		}
@@ -102,19 +116,29 @@ func GetValidatorData(vmRunner vm.EVMRunner, validatorAddresses []common.Address
 }
 
 func UpdateValidatorScore(vmRunner vm.EVMRunner, address common.Address, uptime *big.Int) error {
-	err := updateValidatorScoreFromSignerMethod.Execute(vmRunner, nil, common.Big0, address, uptime)
-	return err
+	validators, err := bindValidators(vmRunner, "updateValidatorScoreFromSigner")
+	if err != nil {
+		return err
+	}
+	return validators.UpdateValidatorScoreFromSigner(contracts.ExecuteOpts(params.MaxGasForUpdateValidatorScore), address, uptime).Execute(nil)
 }
 
 func DistributeEpochReward(vmRunner vm.EVMRunner, address common.Address, maxReward *big.Int) (*big.Int, error) {
+	validators, err := bindValidators(vmRunner, "distributeEpochPaymentsFromSigner")
+	if err != nil {
+		return nil, err
+	}
 	var epochReward *big.Int
-	err := distributeEpochPaymentsFromSignerMethod.Execute(vmRunner, &epochReward, common.Big0, address, maxReward)
+	err = validators.DistributeEpochPaymentsFromSigner(contracts.ExecuteOpts(params.MaxGasForDistributeEpochPayment), address, maxReward).Execute(&epochReward)
 	return epochReward, err
 }
 
 func GetMembershipInLastEpoch(vmRunner vm.EVMRunner, validator common.Address) (common.Address, error) {
-	var group common.Address
-	err := getMembershipInLastEpochFromSignerMethod.Query(vmRunner, &group, validator)
+	validators, err := bindValidators(vmRunner, "getMembershipInLastEpochFromSigner")
+	if err != nil {
+		return common.ZeroAddress, err
+	}
+	group, err := validators.GetMembershipInLastEpochFromSigner(contracts.QueryOpts(params.MaxGasForGetMembershipInLastEpoch), validator)
 	if err != nil {
 		return common.ZeroAddress, err
 	}
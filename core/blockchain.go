@@ -127,6 +127,7 @@ type CacheConfig struct {
 	TrieDirtyDisabled   bool          // Whether to disable trie write caching and GC altogether (archive node)
 	TrieTimeLimit       time.Duration // Time limit after which to flush the current in-memory trie to disk
 	SnapshotLimit       int           // Memory allowance (MB) to use for caching snapshot entries in memory
+	ContractCallLimit   int           // Memory allowance (MB) to use for caching read only contract calls of RPC callers
 
 	SnapshotWait bool // Wait for snapshot construction on startup. TODO(karalabe): This is a dirty hack for testing, nuke it
 }
@@ -134,11 +135,12 @@ type CacheConfig struct {
 // defaultCacheConfig are the default caching values if none are specified by the
 // user (also used during testing).
 var defaultCacheConfig = &CacheConfig{
-	TrieCleanLimit: 256,
-	TrieDirtyLimit: 256,
-	TrieTimeLimit:  5 * time.Minute,
-	SnapshotLimit:  256,
-	SnapshotWait:   true,
+	TrieCleanLimit:    256,
+	TrieDirtyLimit:    256,
+	TrieTimeLimit:     5 * time.Minute,
+	SnapshotLimit:     256,
+	ContractCallLimit: 0,
+	SnapshotWait:      true,
 }
 
 // BlockChain represents the canonical chain given a database with a genesis
@@ -194,6 +196,8 @@ type BlockChain struct {
 	txLookupCache *lru.Cache     // Cache for the most recent transaction lookup data.
 	futureBlocks  *lru.Cache     // future blocks are blocks added for later processing
 
+	queryCache *vmcontext.QueryCache // Cache for read only contract calls on committed states, shared by RPC runners
+
 	quit          chan struct{}  // blockchain quit channel
 	wg            sync.WaitGroup // chain processing wait group for shutting down
 	running       int32          // 0 if chain is running, 1 when stopped
@@ -244,6 +248,9 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 		vmConfig:       vmConfig,
 		badBlocks:      badBlocks,
 	}
+	if cacheConfig.ContractCallLimit > 0 {
+		bc.queryCache = vmcontext.NewQueryCache(cacheConfig.ContractCallLimit * 1024 * 1024)
+	}
 	bc.validator = NewBlockValidator(chainConfig, bc, engine)
 	bc.prefetcher = newStatePrefetcher(chainConfig, bc, engine)
 	bc.processor = NewStateProcessor(chainConfig, bc, engine)
@@ -403,6 +410,13 @@ func (bc *BlockChain) NewEVMRunner(header *types.Header, state vm.StateDB) vm.EV
 	return vmcontext.NewEVMRunner(bc, header, state)
 }
 
+// NewCachedEVMRunner creates the System's EVMRunner for given header & state for
+// RPC callers. Queries made on committed states are served from the chain's contract
+// call cache, so it must never be used while processing or producing blocks.
+func (bc *BlockChain) NewCachedEVMRunner(header *types.Header, state vm.StateDB) vm.EVMRunner {
+	return bc.queryCache.Wrap(vmcontext.NewEVMRunner(bc, header, state), header, state)
+}
+
 // NewEVMRunnerForCurrentBlock creates the System's EVMRunner for current block & state
 func (bc *BlockChain) NewEVMRunnerForCurrentBlock() (vm.EVMRunner, error) {
 	block := bc.CurrentBlock()
@@ -411,7 +425,7 @@ func (bc *BlockChain) NewEVMRunnerForCurrentBlock() (vm.EVMRunner, error) {
 		log.Error("Can't create EVMRunner for current block (error fetching state)", "number", block.Number(), "stateRoot", block.Root().Hex(), "err", err)
 		return nil, err
 	}
-	return vmcontext.NewEVMRunner(bc, block.Header(), state), nil
+	return bc.NewEVMRunner(block.Header(), state), nil
 }
 
 // empty returns an indicator whether the blockchain is empty.
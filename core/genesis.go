@@ -221,6 +221,13 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	if genesis == nil && stored != params.MainnetGenesisHash {
 		return storedcfg, stored, nil
 	}
+	// A local fork of a known network keeps its fork settings, those are never part
+	// of the network's config.
+	if storedcfg.LocalFork != nil && newcfg.LocalFork == nil {
+		cpy := *newcfg
+		cpy.LocalFork = storedcfg.LocalFork
+		newcfg = &cpy
+	}
 
 	// Check config compatibility and write the config. Compatibility errors
 	// are returned to the caller unless we're already at block zero.
@@ -377,9 +384,12 @@ func DefaultAlfajoresGenesisBlock() *Genesis {
 }
 
 // DeveloperGenesisBlock returns the 'geth --dev' genesis block.
-func DeveloperGenesisBlock() *Genesis {
+func DeveloperGenesisBlock(period uint64) *Genesis {
 	// Override the default period to the user requested one
 	config := *params.DeveloperChainConfig
+	istanbulConfig := *config.Istanbul
+	istanbulConfig.BlockPeriod = period
+	config.Istanbul = &istanbulConfig
 	devAlloc := &GenesisAlloc{}
 	devAlloc.UnmarshalJSON([]byte(devAllocJSON))
 	// Assemble and return the genesis with the precompiles and faucet pre-funded
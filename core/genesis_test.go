@@ -184,7 +184,7 @@ func TestRegistryInGenesis(t *testing.T) {
 	}{
 		{
 			name:    "dev",
-			genesis: DeveloperGenesisBlock,
+			genesis: func() *Genesis { return DeveloperGenesisBlock(1) },
 		},
 		{
 			name:    "alfajores",
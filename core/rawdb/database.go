@@ -238,9 +238,24 @@ func NewLevelDBDatabaseWithFreezer(file string, cache int, handles int, freezer
 	return frdb, nil
 }
 
+// DatabaseStat is the size and the number of entries of a category of data.
+type DatabaseStat struct {
+	Database string
+	Category string
+	Size     common.StorageSize
+	Count    uint64
+}
+
+// Add accounts an entry of the given size to the category.
+func (s *DatabaseStat) Add(size common.StorageSize) {
+	s.Size += size
+	s.Count++
+}
+
 // InspectDatabase traverses the entire database and checks the size
-// of all different categories of data.
-func InspectDatabase(db ethdb.Database) error {
+// of all different categories of data. The stats of any additional
+// databases are rendered along with the ones of the chain database.
+func InspectDatabase(db ethdb.Database, extra ...DatabaseStat) error {
 	it := db.NewIterator(nil, nil)
 	defer it.Release()
 
@@ -250,34 +265,38 @@ func InspectDatabase(db ethdb.Database) error {
 		logged = time.Now()
 
 		// Key-value store statistics
-		total           common.StorageSize
-		headerSize      common.StorageSize
-		bodySize        common.StorageSize
-		receiptSize     common.StorageSize
-		tdSize          common.StorageSize
-		numHashPairing  common.StorageSize
-		hashNumPairing  common.StorageSize
-		trieSize        common.StorageSize
-		txlookupSize    common.StorageSize
-		accountSnapSize common.StorageSize
-		storageSnapSize common.StorageSize
-		preimageSize    common.StorageSize
-		bloomBitsSize   common.StorageSize
+		total          common.StorageSize
+		headers        DatabaseStat
+		bodies         DatabaseStat
+		receipts       DatabaseStat
+		tds            DatabaseStat
+		numHashPairing DatabaseStat
+		hashNumPairing DatabaseStat
+		tries          DatabaseStat
+		txLookups      DatabaseStat
+		accountSnaps   DatabaseStat
+		storageSnaps   DatabaseStat
+		preimages      DatabaseStat
+		bloomBits      DatabaseStat
+
+		// Celo statistics
+		uptimes           DatabaseStat
+		istanbulSnapshots DatabaseStat
 
 		// Ancient store statistics
-		ancientHeaders  common.StorageSize
-		ancientBodies   common.StorageSize
-		ancientReceipts common.StorageSize
-		ancientHashes   common.StorageSize
-		ancientTds      common.StorageSize
+		ancientHeaders  DatabaseStat
+		ancientBodies   DatabaseStat
+		ancientReceipts DatabaseStat
+		ancientHashes   DatabaseStat
+		ancientTds      DatabaseStat
 
 		// Les statistic
-		chtTrieNodes   common.StorageSize
-		bloomTrieNodes common.StorageSize
+		chtTrieNodes   DatabaseStat
+		bloomTrieNodes DatabaseStat
 
 		// Meta- and unaccounted data
-		metadata    common.StorageSize
-		unaccounted common.StorageSize
+		metadata    DatabaseStat
+		unaccounted DatabaseStat
 	)
 	// Inspect key-value database first.
 	for it.Next() {
@@ -288,44 +307,48 @@ func InspectDatabase(db ethdb.Database) error {
 		total += size
 		switch {
 		case bytes.HasPrefix(key, headerPrefix) && bytes.HasSuffix(key, headerTDSuffix):
-			tdSize += size
+			tds.Add(size)
 		case bytes.HasPrefix(key, headerPrefix) && bytes.HasSuffix(key, headerHashSuffix):
-			numHashPairing += size
+			numHashPairing.Add(size)
 		case bytes.HasPrefix(key, headerPrefix) && len(key) == (len(headerPrefix)+8+common.HashLength):
-			headerSize += size
+			headers.Add(size)
 		case bytes.HasPrefix(key, headerNumberPrefix) && len(key) == (len(headerNumberPrefix)+common.HashLength):
-			hashNumPairing += size
+			hashNumPairing.Add(size)
 		case bytes.HasPrefix(key, blockBodyPrefix) && len(key) == (len(blockBodyPrefix)+8+common.HashLength):
-			bodySize += size
+			bodies.Add(size)
 		case bytes.HasPrefix(key, blockReceiptsPrefix) && len(key) == (len(blockReceiptsPrefix)+8+common.HashLength):
-			receiptSize += size
+			receipts.Add(size)
 		case bytes.HasPrefix(key, txLookupPrefix) && len(key) == (len(txLookupPrefix)+common.HashLength):
-			txlookupSize += size
+			txLookups.Add(size)
 		case bytes.HasPrefix(key, SnapshotAccountPrefix) && len(key) == (len(SnapshotAccountPrefix)+common.HashLength):
-			accountSnapSize += size
+			accountSnaps.Add(size)
 		case bytes.HasPrefix(key, SnapshotStoragePrefix) && len(key) == (len(SnapshotStoragePrefix)+2*common.HashLength):
-			storageSnapSize += size
+			storageSnaps.Add(size)
 		case bytes.HasPrefix(key, preimagePrefix) && len(key) == (len(preimagePrefix)+common.HashLength):
-			preimageSize += size
+			preimages.Add(size)
 		case bytes.HasPrefix(key, bloomBitsPrefix) && len(key) == (len(bloomBitsPrefix)+10+common.HashLength):
-			bloomBitsSize += size
+			bloomBits.Add(size)
+		case bytes.HasPrefix(key, uptimePrefix) && len(key) == (len(uptimePrefix)+8):
+			uptimes.Add(size)
+		case bytes.HasPrefix(key, istanbulSnapshotPrefix) && len(key) == (len(istanbulSnapshotPrefix)+common.HashLength):
+			istanbulSnapshots.Add(size)
 		case bytes.HasPrefix(key, []byte("cht-")) && len(key) == 4+common.HashLength:
-			chtTrieNodes += size
+			chtTrieNodes.Add(size)
 		case bytes.HasPrefix(key, []byte("blt-")) && len(key) == 4+common.HashLength:
-			bloomTrieNodes += size
+			bloomTrieNodes.Add(size)
 		case len(key) == common.HashLength:
-			trieSize += size
+			tries.Add(size)
 		default:
 			var accounted bool
 			for _, meta := range [][]byte{databaseVerisionKey, headHeaderKey, headBlockKey, headFastBlockKey, fastTrieProgressKey} {
 				if bytes.Equal(key, meta) {
-					metadata += size
+					metadata.Add(size)
 					accounted = true
 					break
 				}
 			}
 			if !accounted {
-				unaccounted += size
+				unaccounted.Add(size)
 			}
 		}
 		count += 1
@@ -335,44 +358,57 @@ func InspectDatabase(db ethdb.Database) error {
 		}
 	}
 	// Inspect append-only file store then.
-	ancients := []*common.StorageSize{&ancientHeaders, &ancientBodies, &ancientReceipts, &ancientHashes, &ancientTds}
+	items, _ := db.Ancients()
+	ancients := []*DatabaseStat{&ancientHeaders, &ancientBodies, &ancientReceipts, &ancientHashes, &ancientTds}
 	for i, category := range []string{freezerHeaderTable, freezerBodiesTable, freezerReceiptTable, freezerHashTable, freezerDifficultyTable} {
 		if size, err := db.AncientSize(category); err == nil {
-			*ancients[i] += common.StorageSize(size)
+			ancients[i].Size += common.StorageSize(size)
+			ancients[i].Count = items
 			total += common.StorageSize(size)
 		}
 	}
 	// Display the database statistic.
 	stats := [][]string{
-		{"Key-Value store", "Headers", headerSize.String()},
-		{"Key-Value store", "Bodies", bodySize.String()},
-		{"Key-Value store", "Receipts", receiptSize.String()},
-		{"Key-Value store", "Difficulties", tdSize.String()},
-		{"Key-Value store", "Block number->hash", numHashPairing.String()},
-		{"Key-Value store", "Block hash->number", hashNumPairing.String()},
-		{"Key-Value store", "Transaction index", txlookupSize.String()},
-		{"Key-Value store", "Bloombit index", bloomBitsSize.String()},
-		{"Key-Value store", "Trie nodes", trieSize.String()},
-		{"Key-Value store", "Trie preimages", preimageSize.String()},
-		{"Key-Value store", "Account snapshot", accountSnapSize.String()},
-		{"Key-Value store", "Storage snapshot", storageSnapSize.String()},
-		{"Key-Value store", "Singleton metadata", metadata.String()},
-		{"Ancient store", "Headers", ancientHeaders.String()},
-		{"Ancient store", "Bodies", ancientBodies.String()},
-		{"Ancient store", "Receipts", ancientReceipts.String()},
-		{"Ancient store", "Difficulties", ancientTds.String()},
-		{"Ancient store", "Block number->hash", ancientHashes.String()},
-		{"Light client", "CHT trie nodes", chtTrieNodes.String()},
-		{"Light client", "Bloom trie nodes", bloomTrieNodes.String()},
+		statRow("Key-Value store", "Headers", headers),
+		statRow("Key-Value store", "Bodies", bodies),
+		statRow("Key-Value store", "Receipts", receipts),
+		statRow("Key-Value store", "Difficulties", tds),
+		statRow("Key-Value store", "Block number->hash", numHashPairing),
+		statRow("Key-Value store", "Block hash->number", hashNumPairing),
+		statRow("Key-Value store", "Transaction index", txLookups),
+		statRow("Key-Value store", "Bloombit index", bloomBits),
+		statRow("Key-Value store", "Trie nodes", tries),
+		statRow("Key-Value store", "Trie preimages", preimages),
+		statRow("Key-Value store", "Account snapshot", accountSnaps),
+		statRow("Key-Value store", "Storage snapshot", storageSnaps),
+		statRow("Key-Value store", "Epoch uptimes", uptimes),
+		statRow("Key-Value store", "Istanbul snapshots", istanbulSnapshots),
+		statRow("Key-Value store", "Singleton metadata", metadata),
+		statRow("Ancient store", "Headers", ancientHeaders),
+		statRow("Ancient store", "Bodies", ancientBodies),
+		statRow("Ancient store", "Receipts", ancientReceipts),
+		statRow("Ancient store", "Difficulties", ancientTds),
+		statRow("Ancient store", "Block number->hash", ancientHashes),
+		statRow("Light client", "CHT trie nodes", chtTrieNodes),
+		statRow("Light client", "Bloom trie nodes", bloomTrieNodes),
+	}
+	for _, stat := range extra {
+		stats = append(stats, statRow(stat.Database, stat.Category, stat))
+		total += stat.Size
 	}
 	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Database", "Category", "Size"})
-	table.SetFooter([]string{"", "Total", total.String()})
+	table.SetHeader([]string{"Database", "Category", "Size", "Items"})
+	table.SetFooter([]string{"", "Total", total.String(), " "})
 	table.AppendBulk(stats)
 	table.Render()
 
-	if unaccounted > 0 {
-		log.Error("Database contains unaccounted data", "size", unaccounted)
+	if unaccounted.Size > 0 {
+		log.Error("Database contains unaccounted data", "size", unaccounted.Size, "count", unaccounted.Count)
 	}
 	return nil
 }
+
+// statRow formats a category of data as a row of the inspection table.
+func statRow(database, category string, stat DatabaseStat) []string {
+	return []string{database, category, stat.Size.String(), fmt.Sprintf("%d", stat.Count)}
+}
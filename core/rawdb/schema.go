@@ -79,6 +79,9 @@ var (
 	preimagePrefix = []byte("secure-key-")      // preimagePrefix + hash -> preimage
 	configPrefix   = []byte("ethereum-config-") // config prefix for the db
 
+	uptimePrefix           = []byte("uptime")            // uptimePrefix + epoch (uint64 big endian) -> accumulated epoch uptime
+	istanbulSnapshotPrefix = []byte("istanbul-snapshot") // istanbulSnapshotPrefix + hash -> istanbul validator set snapshot
+
 	// Chain index prefixes (use `i` + single byte to avoid mixing data types).
 	BloomBitsIndexPrefix = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
 
@@ -146,7 +149,7 @@ func headerTDKey(number uint64, hash common.Hash) []byte {
 // uptimeKey = uptimePrefix + epoch number
 func uptimeKey(epoch uint64) []byte {
 	// abuse encodeBlockNumber for epochs
-	return append([]byte("uptime"), encodeBlockNumber(epoch)...)
+	return append(uptimePrefix, encodeBlockNumber(epoch)...)
 }
 
 // headerHashKey = headerPrefix + num (uint64 big endian) + headerHashSuffix
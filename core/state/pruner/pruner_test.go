@@ -0,0 +1,193 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package pruner
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	mockEngine "github.com/aaronwinter/celo-blockchain/consensus/consensustest"
+	"github.com/aaronwinter/celo-blockchain/core"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/state"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/core/vm"
+	"github.com/aaronwinter/celo-blockchain/crypto"
+	"github.com/aaronwinter/celo-blockchain/ethdb"
+	"github.com/aaronwinter/celo-blockchain/params"
+)
+
+// pruneTest is a chain whose every state was persisted, along with the
+// datadir it lives in.
+type pruneTest struct {
+	datadir string
+	db      ethdb.Database
+	genesis *types.Block
+	blocks  []*types.Block
+}
+
+// newPruneTest generates a chain of the given length, moving funds to a new
+// account on every block, and imports it as an archive node with snapshots.
+func newPruneTest(t *testing.T, length int) *pruneTest {
+	datadir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("Failed to create temporary datadir %v", err)
+	}
+	db, err := rawdb.NewLevelDBDatabaseWithFreezer(filepath.Join(datadir, "chaindata"), 0, 0, filepath.Join(datadir, "ancient"), "")
+	if err != nil {
+		t.Fatalf("Failed to create persistent database %v", err)
+	}
+	var (
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		gspec   = &core.Genesis{
+			Config: params.IstanbulTestChainConfig,
+			Alloc:  core.GenesisAlloc{address: {Balance: big.NewInt(1000000000)}},
+		}
+		genesis = gspec.MustCommit(db)
+		gendb   = rawdb.NewMemoryDatabase()
+		signer  = types.NewEIP155Signer(gspec.Config.ChainID)
+		engine  = mockEngine.NewFaker()
+	)
+	gspec.MustCommit(gendb)
+
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, engine, gendb, length, func(i int, block *core.BlockGen) {
+		recipient := common.BigToAddress(big.NewInt(int64(i + 1)))
+		tx, err := types.SignTx(types.NewTransaction(block.TxNonce(address), recipient, big.NewInt(1000), params.TxGas, nil, nil, nil, nil, nil), signer, key)
+		if err != nil {
+			panic(err)
+		}
+		block.AddTx(tx)
+	})
+	cacheConfig := &core.CacheConfig{
+		TrieCleanLimit:    256,
+		TrieDirtyLimit:    256,
+		TrieDirtyDisabled: true, // Persist every state, so there is something to prune
+		SnapshotLimit:     256,
+		SnapshotWait:      true,
+	}
+	chain, err := core.NewBlockChain(db, cacheConfig, gspec.Config, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create chain: %v", err)
+	}
+	if n, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("Failed to import block %d: %v", n, err)
+	}
+	// Journal the snapshot layers on shutdown, they are needed to pick the target
+	chain.Stop()
+
+	return &pruneTest{datadir: datadir, db: db, genesis: genesis, blocks: blocks}
+}
+
+func (pt *pruneTest) teardown() {
+	pt.db.Close()
+	os.RemoveAll(pt.datadir)
+}
+
+// verify checks that only the state of the target block and the genesis survived
+// the pruning, and that the pruning left no bloom filter behind.
+func (pt *pruneTest) verify(t *testing.T, target int) {
+	for i, block := range pt.blocks {
+		has, _ := pt.db.Has(block.Root().Bytes())
+		if i == target && !has {
+			t.Fatalf("State of block %d was pruned", block.NumberU64())
+		} else if i < target && has {
+			t.Errorf("State of block %d was not pruned", block.NumberU64())
+		}
+	}
+	for _, root := range []common.Hash{pt.genesis.Root(), pt.blocks[target].Root()} {
+		statedb, err := state.New(root, state.NewDatabase(pt.db), nil)
+		if err != nil {
+			t.Fatalf("Failed to open state %x: %v", root, err)
+		}
+		it := state.NewNodeIterator(statedb)
+		for it.Next() {
+		}
+		if it.Error != nil {
+			t.Fatalf("State %x is incomplete: %v", root, it.Error)
+		}
+	}
+	if path, _, _ := findBloomFilter(pt.datadir); path != "" {
+		t.Errorf("State bloom %s was not removed", path)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	pt := newPruneTest(t, 200)
+	defer pt.teardown()
+
+	pruner, err := NewPruner(pt.db, pt.datadir, filepath.Join(pt.datadir, "triecache"), 256)
+	if err != nil {
+		t.Fatalf("Failed to create pruner: %v", err)
+	}
+	if err := pruner.Prune(common.Hash{}); err != nil {
+		t.Fatalf("Failed to prune state: %v", err)
+	}
+	// The target is the bottom-most of the 128 diff layers
+	target := len(pt.blocks) - 128
+	pt.verify(t, target)
+
+	if root := rawdb.ReadSnapshotRoot(pt.db); root != pt.blocks[target].Root() {
+		t.Errorf("Snapshot disk layer mismatch: have %x, want %x", root, pt.blocks[target].Root())
+	}
+}
+
+func TestPruneNotEnoughLayers(t *testing.T) {
+	pt := newPruneTest(t, 64)
+	defer pt.teardown()
+
+	pruner, err := NewPruner(pt.db, pt.datadir, filepath.Join(pt.datadir, "triecache"), 256)
+	if err != nil {
+		t.Fatalf("Failed to create pruner: %v", err)
+	}
+	if err := pruner.Prune(common.Hash{}); err == nil {
+		t.Fatal("Pruned a state without enough diff layers above it")
+	}
+}
+
+func TestRecoverPruning(t *testing.T) {
+	pt := newPruneTest(t, 200)
+	defer pt.teardown()
+
+	// Simulate an interrupted pruning: the state bloom is committed, but
+	// nothing was deleted yet.
+	target := len(pt.blocks) - 128
+	root := pt.blocks[target].Root()
+
+	bloom, err := newStateBloomWithSize(256)
+	if err != nil {
+		t.Fatalf("Failed to create state bloom: %v", err)
+	}
+	if err := extractState(root, pt.db, bloom); err != nil {
+		t.Fatalf("Failed to extract state: %v", err)
+	}
+	if err := extractGenesis(pt.db, bloom); err != nil {
+		t.Fatalf("Failed to extract genesis: %v", err)
+	}
+	filterName := bloomFilterName(pt.datadir, root)
+	if err := bloom.Commit(filterName, filterName+stateBloomFileTempSuffix); err != nil {
+		t.Fatalf("Failed to commit state bloom: %v", err)
+	}
+	if err := RecoverPruning(pt.datadir, pt.db, filepath.Join(pt.datadir, "triecache")); err != nil {
+		t.Fatalf("Failed to recover pruning: %v", err)
+	}
+	pt.verify(t, target)
+}
@@ -816,6 +816,22 @@ func (s *StateDB) IntermediateRoot(deleteEmptyObjects bool) common.Hash {
 	return s.trie.Hash()
 }
 
+// CommittedRoot returns the root hash of the state trie the StateDB was opened at
+// or last committed to, along with the number of journalled modifications made on
+// top of it since. The last return value is false if the state holds finalised but
+// uncommitted changes or overridden storage, in which case the root is meaningless.
+func (s *StateDB) CommittedRoot() (common.Hash, int, bool) {
+	if len(s.stateObjectsPending) > 0 || len(s.stateObjectsDirty) > 0 {
+		return common.Hash{}, 0, false
+	}
+	for _, obj := range s.stateObjects {
+		if obj.fakeStorage != nil {
+			return common.Hash{}, 0, false
+		}
+	}
+	return s.trie.Hash(), s.journal.length(), true
+}
+
 // Prepare sets the current transaction hash and index and block hash which is
 // used when the EVM emits new state logs.
 func (s *StateDB) Prepare(thash, bhash common.Hash, ti int) {
@@ -303,7 +303,7 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		config:          config,
 		chainconfig:     chainconfig,
 		chain:           chain,
-		signer:          types.NewEIP155Signer(chainconfig.ChainID),
+		signer:          types.LatestSigner(chainconfig),
 		pending:         make(map[common.Address]*txList),
 		queue:           make(map[common.Address]*txList),
 		beats:           make(map[common.Address]time.Time),
@@ -533,6 +533,12 @@ func (pool *TxPool) stats() (int, int) {
 	return pending, queued
 }
 
+// CurrencyStats retrieves the number of transactions in the pool, pending as well
+// as queued, paying fees in the native currency and in each other fee currency.
+func (pool *TxPool) CurrencyStats() (int, map[common.Address]int) {
+	return pool.all.CurrencyCounts()
+}
+
 // Content retrieves the data content of the transaction pool, returning all the
 // pending as well as queued transactions, grouped by account and sorted by nonce.
 func (pool *TxPool) Content() (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
@@ -1742,6 +1748,21 @@ func (t *txLookup) Slots() int {
 	return t.slots
 }
 
+// CurrencyCounts returns the number of transactions in the lookup paying fees in
+// the native currency and in each other fee currency.
+func (t *txLookup) CurrencyCounts() (int, map[common.Address]int) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	currencies := make(map[common.Address]int, len(t.nonNilCurrencyTxCurrCount))
+	for currency, count := range t.nonNilCurrencyTxCurrCount {
+		if count > 0 {
+			currencies[currency] = int(count)
+		}
+	}
+	return int(t.nilCurrencyTxCurrCount), currencies
+}
+
 // Add adds a transaction to the lookup.
 func (t *txLookup) Add(tx *types.Transaction) {
 	t.lock.Lock()
@@ -2017,6 +2017,37 @@ func TestTransactionSlotCount(t *testing.T) {
 	}
 }
 
+// Tests that the transactions of the pool are counted by fee currency.
+func TestTransactionCurrencyCounts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		lookup = newTxLookup()
+		cusd   = common.HexToAddress("0x02")
+		newTx  = func(nonce uint64, currency *common.Address) *types.Transaction {
+			return types.NewTransaction(nonce, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), currency, nil, nil, nil)
+		}
+	)
+	lookup.Add(newTx(0, nil))
+	lookup.Add(newTx(1, &cusd))
+	removed := newTx(2, &cusd)
+	lookup.Add(removed)
+	lookup.Remove(removed.Hash())
+
+	native, currencies := lookup.CurrencyCounts()
+	if native != 1 {
+		t.Errorf("native currency count mismatch: have %d, want %d", native, 1)
+	}
+	if len(currencies) != 1 || currencies[cusd] != 1 {
+		t.Errorf("fee currency counts mismatch: have %v, want %v", currencies, map[common.Address]int{cusd: 1})
+	}
+	// Currencies without transactions left are not reported
+	lookup.Remove(newTx(1, &cusd).Hash())
+	if _, currencies = lookup.CurrencyCounts(); len(currencies) != 0 {
+		t.Errorf("fee currency counts mismatch: have %v, want none", currencies)
+	}
+}
+
 // Benchmarks the speed of validating the contents of the pending queue of the
 // transaction pool.
 func BenchmarkPendingDemotion100(b *testing.B)   { benchmarkPendingDemotion(b, 100) }
@@ -49,6 +49,19 @@ func MakeSigner(config *params.ChainConfig, blockNumber *big.Int) Signer {
 	default:
 		signer = FrontierSigner{}
 	}
+	if config.IsImpersonationEnabled(blockNumber) {
+		signer = NewImpersonationSigner(signer)
+	}
+	return signer
+}
+
+// LatestSigner returns the Signer used to validate transactions that are yet to be
+// included in a block, such as the ones in the transaction pool.
+func LatestSigner(config *params.ChainConfig) Signer {
+	var signer Signer = NewEIP155Signer(config.ChainID)
+	if config.LocalFork != nil && config.LocalFork.Impersonation {
+		signer = NewImpersonationSigner(signer)
+	}
 	return signer
 }
 
@@ -299,3 +312,50 @@ func deriveChainId(v *big.Int) *big.Int {
 	v = new(big.Int).Sub(v, big.NewInt(35))
 	return v.Div(v, big.NewInt(2))
 }
+
+// ImpersonationSigner wraps a Signer to also accept impersonated transactions, which carry
+// the sender address in place of a signature. It is only used on local forks.
+type ImpersonationSigner struct {
+	Signer
+}
+
+// NewImpersonationSigner returns a Signer that accepts impersonated transactions on top of
+// the ones accepted by inner
+func NewImpersonationSigner(inner Signer) ImpersonationSigner {
+	return ImpersonationSigner{Signer: inner}
+}
+
+func (s ImpersonationSigner) Equal(s2 Signer) bool {
+	impersonation, ok := s2.(ImpersonationSigner)
+	return ok && s.Signer.Equal(impersonation.Signer)
+}
+
+func (s ImpersonationSigner) Sender(tx *Transaction) (common.Address, error) {
+	if from, ok := tx.ImpersonatedSender(); ok {
+		return from, nil
+	}
+	return s.Signer.Sender(tx)
+}
+
+// Impersonate returns a copy of tx that claims to be sent by from. Such transaction is
+// only valid for an ImpersonationSigner.
+func Impersonate(tx *Transaction, from common.Address) *Transaction {
+	cpy := &Transaction{
+		data: tx.data,
+		time: tx.time,
+	}
+	cpy.data.R = new(big.Int).SetBytes(from.Bytes())
+	cpy.data.S = new(big.Int)
+	cpy.data.V = new(big.Int)
+	return cpy
+}
+
+// ImpersonatedSender returns the sender claimed by an impersonated transaction.
+// A valid signature never has a zero S value, so impersonated transactions can't
+// be confused with signed ones.
+func (tx *Transaction) ImpersonatedSender() (common.Address, bool) {
+	if tx.data.V.Sign() != 0 || tx.data.S.Sign() != 0 || tx.data.R.Sign() == 0 || tx.data.R.BitLen() > 8*common.AddressLength {
+		return common.Address{}, false
+	}
+	return common.BigToAddress(tx.data.R), true
+}
@@ -131,3 +131,32 @@ func TestChainId(t *testing.T) {
 		t.Error("expected no error")
 	}
 }
+
+func TestImpersonationSigning(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	impersonated := common.HexToAddress("0x471ece3750da237f93b8e339c536989b8978a438")
+
+	signer := NewImpersonationSigner(NewEIP155Signer(big.NewInt(18)))
+	tx := Impersonate(NewTransaction(0, addr, new(big.Int), 0, new(big.Int), nil, nil, nil, nil), impersonated)
+
+	from, err := Sender(signer, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != impersonated {
+		t.Errorf("exected from and impersonated address to be equal. Got %x want %x", from, impersonated)
+	}
+	if _, err := Sender(NewEIP155Signer(big.NewInt(18)), tx); err == nil {
+		t.Error("expected impersonated tx to be rejected by the EIP155 signer")
+	}
+
+	// Signed transactions keep working
+	signed, err := SignTx(NewTransaction(0, addr, new(big.Int), 0, new(big.Int), nil, nil, nil, nil), signer, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from, err := Sender(signer, signed); err != nil || from != addr {
+		t.Errorf("exected from and address to be equal. Got %x want %x (err %v)", from, addr, err)
+	}
+}
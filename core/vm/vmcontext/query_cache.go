@@ -0,0 +1,173 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package vmcontext
+
+import (
+	"encoding/binary"
+	"math/big"
+	"time"
+
+	"github.com/VictoriaMetrics/fastcache"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/core/state"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/core/vm"
+	"github.com/aaronwinter/celo-blockchain/crypto"
+	"github.com/aaronwinter/celo-blockchain/metrics"
+)
+
+var (
+	queryCacheHitMeter   = metrics.NewRegisteredMeter("vm/querycache/hit", nil)
+	queryCacheMissMeter  = metrics.NewRegisteredMeter("vm/querycache/miss", nil)
+	queryCacheSavedTimer = metrics.NewRegisteredTimer("vm/querycache/saved", nil)
+)
+
+// QueryCache memoizes the results of read only contract calls (EVMRunner.Query)
+// made against committed states, keyed by the state root, the block the call is
+// made in, the contract and the calldata. Core contract reads issued by RPC
+// handlers and the consensus engine for the same block share the cached results.
+//
+// The block hash is part of the key because the Celo precompiles (e.g. validator
+// set lookups) read from the header chain and not only from the state.
+// It is safe for concurrent use; memory usage is bounded by the configured size.
+type QueryCache struct {
+	cache *fastcache.Cache
+}
+
+// NewQueryCache creates a query cache using at most maxBytes of memory.
+func NewQueryCache(maxBytes int) *QueryCache {
+	return &QueryCache{cache: fastcache.New(maxBytes)}
+}
+
+// Wrap returns a runner serving queries from the cache when possible. The runner
+// is returned unchanged if the cache is nil or the state holds modifications on
+// top of its root, since then the root does not identify what the calls read.
+func (c *QueryCache) Wrap(runner vm.EVMRunner, header *types.Header, statedb vm.StateDB) vm.EVMRunner {
+	if c == nil {
+		return runner
+	}
+	sdb, ok := statedb.(*state.StateDB)
+	if !ok {
+		return runner
+	}
+	root, changes, ok := sdb.CommittedRoot()
+	if !ok || changes > 0 {
+		return runner
+	}
+	blockHash := header.Hash()
+	return &cachingRunner{
+		EVMRunner: runner,
+		cache:     c,
+		state:     sdb,
+		root:      root,
+		prefix:    append(root.Bytes(), blockHash.Bytes()...),
+	}
+}
+
+// Reset drops all cached results.
+func (c *QueryCache) Reset() {
+	c.cache.Reset()
+}
+
+// cachingRunner is an EVMRunner serving queries through a QueryCache. Caching is
+// turned off for good as soon as the state is modified, either by the runner
+// itself or by anyone else sharing the StateDB (e.g. transactions being applied).
+type cachingRunner struct {
+	vm.EVMRunner
+	cache *QueryCache
+	state *state.StateDB
+	root  common.Hash
+
+	prefix       []byte // state root ‖ block hash
+	changes      int    // journal entries left by the queries run so far (account touches)
+	dontMeterGas bool
+	disabled     bool
+}
+
+// unmodified reports whether the state is still the one at the runner's root,
+// disabling the cache otherwise.
+func (cr *cachingRunner) unmodified() bool {
+	if cr.disabled {
+		return false
+	}
+	root, changes, ok := cr.state.CommittedRoot()
+	if !ok || changes != cr.changes || root != cr.root {
+		cr.disabled = true
+	}
+	return !cr.disabled
+}
+
+func (cr *cachingRunner) key(recipient common.Address, input []byte, gas uint64) []byte {
+	var params [9]byte
+	binary.BigEndian.PutUint64(params[:8], gas)
+	if cr.dontMeterGas {
+		params[8] = 1
+	}
+	return crypto.Keccak256(cr.prefix, recipient.Bytes(), params[:], input)
+}
+
+func (cr *cachingRunner) Execute(recipient common.Address, input []byte, gas uint64, value *big.Int) (ret []byte, err error) {
+	cr.disabled = true
+	return cr.EVMRunner.Execute(recipient, input, gas, value)
+}
+
+func (cr *cachingRunner) ExecuteFrom(sender, recipient common.Address, input []byte, gas uint64, value *big.Int) (ret []byte, err error) {
+	cr.disabled = true
+	return cr.EVMRunner.ExecuteFrom(sender, recipient, input, gas, value)
+}
+
+func (cr *cachingRunner) Query(recipient common.Address, input []byte, gas uint64) (ret []byte, err error) {
+	if !cr.unmodified() {
+		return cr.EVMRunner.Query(recipient, input, gas)
+	}
+	key := cr.key(recipient, input, gas)
+	if enc, ok := cr.cache.cache.HasGet(nil, key); ok && len(enc) >= 8 {
+		queryCacheHitMeter.Mark(1)
+		queryCacheSavedTimer.Update(time.Duration(binary.BigEndian.Uint64(enc[:8])))
+		return common.CopyBytes(enc[8:]), nil
+	}
+	queryCacheMissMeter.Mark(1)
+
+	start := time.Now()
+	ret, err = cr.EVMRunner.Query(recipient, input, gas)
+	// Remember the account touches left behind by the call, so they are not taken
+	// as modifications of the state by the next query.
+	_, cr.changes, _ = cr.state.CommittedRoot()
+	if err != nil || cr.state.Error() != nil {
+		return ret, err
+	}
+	enc := make([]byte, 8+len(ret))
+	binary.BigEndian.PutUint64(enc[:8], uint64(time.Since(start)))
+	copy(enc[8:], ret)
+	cr.cache.cache.Set(key, enc)
+	return ret, nil
+}
+
+func (cr *cachingRunner) StopGasMetering() {
+	cr.dontMeterGas = true
+	cr.EVMRunner.StopGasMetering()
+}
+
+func (cr *cachingRunner) StartGasMetering() {
+	cr.dontMeterGas = false
+	cr.EVMRunner.StartGasMetering()
+}
+
+// GetStateDB implements Backend.GetStateDB
+func (cr *cachingRunner) GetStateDB() vm.StateDB {
+	return cr.state
+}
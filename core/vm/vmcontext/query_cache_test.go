@@ -0,0 +1,109 @@
+package vmcontext
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/state"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/core/vm"
+)
+
+// countingRunner answers every query with the balance of the recipient and counts the calls.
+type countingRunner struct {
+	state   vm.StateDB
+	queries int
+}
+
+func (r *countingRunner) Execute(recipient common.Address, input []byte, gas uint64, value *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (r *countingRunner) ExecuteFrom(sender, recipient common.Address, input []byte, gas uint64, value *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (r *countingRunner) Query(recipient common.Address, input []byte, gas uint64) ([]byte, error) {
+	r.queries++
+	return r.state.GetBalance(recipient).Bytes(), nil
+}
+
+func (r *countingRunner) StopGasMetering()  {}
+func (r *countingRunner) StartGasMetering() {}
+
+func TestQueryCache(t *testing.T) {
+	db := state.NewDatabase(rawdb.NewMemoryDatabase())
+	addr := common.HexToAddress("0x01")
+
+	statedb, _ := state.New(common.Hash{}, db, nil)
+	statedb.SetBalance(addr, big.NewInt(42))
+	root, err := statedb.Commit(true)
+	if err != nil {
+		t.Fatalf("failed to commit state: %v", err)
+	}
+	header := &types.Header{Number: big.NewInt(1), Root: root}
+	cache := NewQueryCache(1024 * 1024)
+
+	query := func(statedb *state.StateDB, header *types.Header) (*countingRunner, []byte) {
+		inner := &countingRunner{state: statedb}
+		ret, err := cache.Wrap(inner, header, statedb).Query(addr, []byte{1}, 100000)
+		if err != nil {
+			t.Fatalf("query failed: %v", err)
+		}
+		return inner, ret
+	}
+
+	// The first query runs, the second one on a fresh state at the same root is served from the cache.
+	fresh, _ := state.New(root, db, nil)
+	if inner, ret := query(fresh, header); inner.queries != 1 || new(big.Int).SetBytes(ret).Int64() != 42 {
+		t.Fatalf("first query: have %d calls, result %x", inner.queries, ret)
+	}
+	fresh, _ = state.New(root, db, nil)
+	if inner, ret := query(fresh, header); inner.queries != 0 || new(big.Int).SetBytes(ret).Int64() != 42 {
+		t.Fatalf("cached query: have %d calls, result %x", inner.queries, ret)
+	}
+
+	// A different block on the same state is not served from the cache.
+	fresh, _ = state.New(root, db, nil)
+	if inner, _ := query(fresh, &types.Header{Number: big.NewInt(2), Root: root}); inner.queries != 1 {
+		t.Fatalf("other block: have %d calls, want 1", inner.queries)
+	}
+
+	// A modified state is never cached.
+	fresh, _ = state.New(root, db, nil)
+	fresh.SetBalance(addr, big.NewInt(7))
+	if inner, ret := query(fresh, header); inner.queries != 1 || new(big.Int).SetBytes(ret).Int64() != 7 {
+		t.Fatalf("modified state: have %d calls, result %x", inner.queries, ret)
+	}
+
+	// Modifications made after the runner is created disable the cache.
+	fresh, _ = state.New(root, db, nil)
+	inner := &countingRunner{state: fresh}
+	runner := cache.Wrap(inner, header, fresh)
+	fresh.SetBalance(addr, big.NewInt(7))
+	if ret, _ := runner.Query(addr, []byte{1}, 100000); inner.queries != 1 || new(big.Int).SetBytes(ret).Int64() != 7 {
+		t.Fatalf("state modified after wrapping: have %d calls, result %x", inner.queries, ret)
+	}
+
+	// Executing through the runner disables the cache as well.
+	fresh, _ = state.New(root, db, nil)
+	inner = &countingRunner{state: fresh}
+	runner = cache.Wrap(inner, header, fresh)
+	runner.Execute(addr, nil, 100000, common.Big0)
+	if runner.Query(addr, []byte{1}, 100000); inner.queries != 1 {
+		t.Fatalf("query after execute: have %d calls, want 1", inner.queries)
+	}
+
+	// Other calldata or gas settings are cached separately.
+	fresh, _ = state.New(root, db, nil)
+	inner = &countingRunner{state: fresh}
+	runner = cache.Wrap(inner, header, fresh)
+	runner.StopGasMetering()
+	runner.Query(addr, []byte{1}, 100000)
+	runner.Query(addr, []byte{2}, 100000)
+	if inner.queries != 2 {
+		t.Fatalf("distinct queries: have %d calls, want 2", inner.queries)
+	}
+}
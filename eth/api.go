@@ -115,6 +115,32 @@ func (api *PrivateMinerAPI) Stop() {
 	api.e.StopMining()
 }
 
+// SealBlock seals a new block on top of the current head, including the pending transactions
+// if there are any, and returns its hash. It is only available in instant seal mode (--dev).
+func (api *PrivateMinerAPI) SealBlock(ctx context.Context) (common.Hash, error) {
+	headCh := make(chan core.ChainHeadEvent, 1)
+	sub := api.e.blockchain.SubscribeChainHeadEvent(headCh)
+	defer sub.Unsubscribe()
+
+	number, err := api.e.Miner().SealBlock(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	// Other blocks may be sealed meanwhile, wait for the requested one
+	for {
+		select {
+		case ev := <-headCh:
+			if ev.Block.NumberU64() >= number {
+				return api.e.blockchain.GetCanonicalHash(number), nil
+			}
+		case err := <-sub.Err():
+			return common.Hash{}, err
+		case <-ctx.Done():
+			return common.Hash{}, ctx.Err()
+		}
+	}
+}
+
 // SetExtra sets the extra data string that is included when this miner mines a block.
 func (api *PrivateMinerAPI) SetExtra(extra string) (bool, error) {
 	if err := api.e.Miner().SetExtra([]byte(extra)); err != nil {
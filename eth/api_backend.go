@@ -256,6 +256,10 @@ func (b *EthAPIBackend) Stats() (pending int, queued int) {
 	return b.eth.txPool.Stats()
 }
 
+func (b *EthAPIBackend) TxPoolCurrencyStats() (int, map[common.Address]int) {
+	return b.eth.txPool.CurrencyStats()
+}
+
 func (b *EthAPIBackend) TxPoolContent() (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
 	return b.eth.TxPool().Content()
 }
@@ -291,12 +295,12 @@ func (b *EthAPIBackend) GetBlockGasLimit(ctx context.Context, blockNrOrHash rpc.
 		return params.DefaultGasLimit
 	}
 
-	vmRunner := b.eth.BlockChain().NewEVMRunner(header, statedb)
+	vmRunner := b.eth.BlockChain().NewCachedEVMRunner(header, statedb)
 	return blockchain_parameters.GetBlockGasLimitOrDefault(vmRunner)
 }
 
 func (b *EthAPIBackend) NewEVMRunner(header *types.Header, state vm.StateDB) vm.EVMRunner {
-	return b.eth.BlockChain().NewEVMRunner(header, state)
+	return b.eth.BlockChain().NewCachedEVMRunner(header, state)
 }
 
 func (b *EthAPIBackend) GetIntrinsicGasForAlternativeFeeCurrency(ctx context.Context) uint64 {
@@ -36,6 +36,7 @@ import (
 	"github.com/aaronwinter/celo-blockchain/core/bloombits"
 	"github.com/aaronwinter/celo-blockchain/core/rawdb"
 	"github.com/aaronwinter/celo-blockchain/core/state"
+	"github.com/aaronwinter/celo-blockchain/core/state/pruner"
 	"github.com/aaronwinter/celo-blockchain/core/types"
 	"github.com/aaronwinter/celo-blockchain/core/vm"
 	"github.com/aaronwinter/celo-blockchain/eth/downloader"
@@ -121,6 +122,11 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Finish an offline state pruning that was interrupted, it can't be
+	// left half done as the node would run with a partially deleted state.
+	if err := pruner.RecoverPruning(stack.ResolvePath(""), chainDb, stack.ResolvePath(config.TrieCleanCacheJournal)); err != nil {
+		log.Error("Failed to recover state", "error", err)
+	}
 	chainConfig, genesisHash, genesisErr := core.SetupGenesisBlockWithOverride(chainDb, config.Genesis, config.OverrideEHardfork)
 	if _, ok := genesisErr.(*params.ConfigCompatError); genesisErr != nil && !ok {
 		return nil, genesisErr
@@ -175,6 +181,7 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 			TrieDirtyDisabled:   config.NoPruning,
 			TrieTimeLimit:       config.TrieTimeout,
 			SnapshotLimit:       config.SnapshotCache,
+			ContractCallLimit:   config.ContractCallCache,
 		}
 	)
 	eth.blockchain, err = core.NewBlockChain(chainDb, cacheConfig, chainConfig, eth.engine, vmConfig, eth.shouldPreserve, &config.TxLookupLimit)
@@ -213,6 +220,8 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 				stateRoot := eth.blockchain.GetHeaderByHash(hash).Root
 				return eth.blockchain.StateAt(stateRoot)
 			})
+		// Validator scores are computed from the stored uptimes, make sure they're sane
+		istanbul.CheckEpochData()
 	}
 
 	eth.miner = miner.New(eth, &config.Miner, chainConfig, eth.EventMux(), eth.engine, chainDb)
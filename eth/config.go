@@ -42,6 +42,7 @@ var DefaultConfig = Config{
 	TrieDirtyCache:          256,
 	TrieTimeout:             60 * time.Minute,
 	SnapshotCache:           102,
+	ContractCallCache:       32,
 	GatewayFee:              big.NewInt(0),
 
 	TxPool:      core.DefaultTxPoolConfig,
@@ -105,6 +106,7 @@ type Config struct {
 	TrieDirtyCache          int
 	TrieTimeout             time.Duration
 	SnapshotCache           int
+	ContractCallCache       int // Memory allowance (MB) for caching read only core contract calls of RPC callers
 
 	// Mining options
 	Miner miner.Config
@@ -140,6 +142,12 @@ type Config struct {
 	// CheckpointOracle is the configuration for checkpoint oracle.
 	CheckpointOracle *params.CheckpointOracleConfig `toml:",omitempty"`
 
+	// TrustedEpoch is a hardcoded epoch lightest sync starts from, which can be nil.
+	TrustedEpoch *params.TrustedEpoch `toml:",omitempty"`
+
+	// EpochBundle is the path of an epoch bundle lightest sync is bootstrapped from.
+	EpochBundle string `toml:",omitempty"`
+
 	// E block override (TODO: remove after the fork)
 	OverrideEHardfork *big.Int `toml:",omitempty"`
 }
@@ -1005,6 +1005,13 @@ func (d *Downloader) fetchHeaders(p *peerConnection, from uint64, pivot uint64,
 		ttl = d.requestTTL()
 		timeout.Reset(ttl)
 
+		// Peers serving epoch header requests return the last block of each epoch directly
+		if ep, ok := p.peer.(EpochHeaderPeer); ok && ep.SupportsEpochHeaders() {
+			p.log.Trace("Fetching epoch headers", "count", MaxEpochHeaderFetch, "from", fromEpochBlock)
+			go ep.RequestEpochHeaders(fromEpochBlock, MaxEpochHeaderFetch)
+			return
+		}
+
 		// if epoch is 100 and we fetch from=1000 and skip=100 then we will get
 		// 1000, 1101, 1202, 1303 ...
 		// So, skip has to be epoch - 1 to get the right set of blocks.
@@ -266,13 +266,13 @@ func (dl *downloadTester) InsertHeaderChain(headers []*types.Header, checkFreq i
 	dl.lock.Lock()
 	defer dl.lock.Unlock()
 	// Do a quick check, as the blockchain.InsertHeaderChain doesn't insert anything in case of errors
-	if dl.getHeaderByHash(headers[0].ParentHash) == nil {
+	if contiguousHeaders && dl.getHeaderByHash(headers[0].ParentHash) == nil {
 		return 0, fmt.Errorf("InsertHeaderChain: unknown parent at first position, parent of number %d", headers[0].Number)
 	}
 	var hashes []common.Hash
 	for i := 1; i < len(headers); i++ {
 		hash := headers[i-1].Hash()
-		if headers[i].ParentHash != headers[i-1].Hash() {
+		if contiguousHeaders && headers[i].ParentHash != headers[i-1].Hash() {
 			return i, fmt.Errorf("non-contiguous import at position %d", i)
 		}
 		hashes = append(hashes, hash)
@@ -284,7 +284,7 @@ func (dl *downloadTester) InsertHeaderChain(headers []*types.Header, checkFreq i
 		if dl.getHeaderByHash(hash) != nil {
 			continue
 		}
-		if dl.getHeaderByHash(header.ParentHash) == nil {
+		if contiguousHeaders && dl.getHeaderByHash(header.ParentHash) == nil {
 			// This _should_ be impossible, due to precheck and induction
 			return i, fmt.Errorf("InsertHeaderChain: unknown parent at position %d", i)
 		}
@@ -457,6 +457,28 @@ func (dlp *downloadTesterPeer) RequestHeadersByNumber(origin uint64, amount int,
 	return nil
 }
 
+// downloadTesterEpochPeer is a download tester peer which also serves the last
+// header of consecutive epochs in a single request.
+type downloadTesterEpochPeer struct {
+	*downloadTesterPeer
+	epoch    uint64
+	requests int32 // Number of epoch header requests served
+}
+
+func (dlp *downloadTesterEpochPeer) SupportsEpochHeaders() bool {
+	return true
+}
+
+// RequestEpochHeaders constructs a GetEpochHeaders function associated with a
+// particular peer in the download tester.
+func (dlp *downloadTesterEpochPeer) RequestEpochHeaders(origin uint64, amount int) error {
+	atomic.AddInt32(&dlp.requests, 1)
+
+	result := dlp.chain.headersByNumber(origin, amount, int(dlp.epoch-1))
+	go dlp.dl.downloader.DeliverHeaders(dlp.id, result)
+	return nil
+}
+
 // RequestBodies constructs a getBlockBodies method associated with a particular
 // peer in the download tester. The returned function can be used to retrieve
 // batches of block bodies from the particularly requested peer.
@@ -562,6 +584,54 @@ func testCanonicalSynchronisation(t *testing.T, protocol int, mode SyncMode) {
 	assertOwnChain(t, tester, chain.len())
 }
 
+// Tests that lightest sync only retrieves the last header of each epoch before the
+// chain head, through epoch header requests if the peer serves them.
+func TestLightestSynchronisation66(t *testing.T) { testLightestSynchronisation(t, 66, false) }
+func TestLightestSynchronisation66EpochHeaders(t *testing.T) {
+	testLightestSynchronisation(t, 66, true)
+}
+
+func testLightestSynchronisation(t *testing.T, protocol int, epochHeaders bool) {
+	t.Parallel()
+
+	tester := newTester()
+	defer tester.terminate()
+
+	epoch := uint64(100)
+	tester.downloader.epoch = epoch
+	tester.downloader.ibftConsensus = true
+
+	chain := testChainBase.shorten(int(5*epoch) + 10)
+	peer := &downloadTesterPeer{dl: tester, id: "peer", chain: chain}
+	tester.peers["peer"] = peer
+	if epochHeaders {
+		epochPeer := &downloadTesterEpochPeer{downloadTesterPeer: peer, epoch: epoch}
+		if err := tester.downloader.RegisterPeer("peer", protocol, epochPeer); err != nil {
+			t.Fatalf("failed to register peer: %v", err)
+		}
+		defer func() {
+			if atomic.LoadInt32(&epochPeer.requests) == 0 {
+				t.Error("no epoch header requests made")
+			}
+		}()
+	} else if err := tester.downloader.RegisterPeer("peer", protocol, peer); err != nil {
+		t.Fatalf("failed to register peer: %v", err)
+	}
+	if err := tester.sync("peer", nil, LightestSync); err != nil {
+		t.Fatalf("failed to synchronise headers: %v", err)
+	}
+	head := uint64(chain.len() - 1)
+	if have := tester.CurrentHeader().Number.Uint64(); have != head {
+		t.Fatalf("head mismatch: have %d, want %d", have, head)
+	}
+	for number := uint64(1); number < head/epoch*epoch; number++ {
+		_, ok := tester.ownHeaders[chain.headerm[chain.chain[number]].Hash()]
+		if want := number%epoch == 0; ok != want {
+			t.Errorf("header %d presence mismatch: have %v, want %v", number, ok, want)
+		}
+	}
+}
+
 // Tests that if a large batch of blocks are being downloaded, it is throttled
 // until the cached blocks are retrieved.
 func TestThrottling64Full(t *testing.T) { testThrottling(t, 64, FullSync) }
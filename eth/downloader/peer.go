@@ -81,6 +81,13 @@ type LightPeer interface {
 	RequestHeadersByNumber(uint64, int, int, bool) error
 }
 
+// EpochHeaderPeer is implemented by light peers which can serve the last header of
+// consecutive epochs in a single request, used by the lightest sync mode.
+type EpochHeaderPeer interface {
+	SupportsEpochHeaders() bool
+	RequestEpochHeaders(origin uint64, amount int) error
+}
+
 // Peer encapsulates the methods required to synchronise with a remote full peer.
 type Peer interface {
 	LightPeer
@@ -101,6 +108,13 @@ func (w *lightPeerWrapper) RequestHeadersByHash(h common.Hash, amount int, skip
 func (w *lightPeerWrapper) RequestHeadersByNumber(i uint64, amount int, skip int, reverse bool) error {
 	return w.peer.RequestHeadersByNumber(i, amount, skip, reverse)
 }
+func (w *lightPeerWrapper) SupportsEpochHeaders() bool {
+	p, ok := w.peer.(EpochHeaderPeer)
+	return ok && p.SupportsEpochHeaders()
+}
+func (w *lightPeerWrapper) RequestEpochHeaders(origin uint64, amount int) error {
+	return w.peer.(EpochHeaderPeer).RequestEpochHeaders(origin, amount)
+}
 func (w *lightPeerWrapper) RequestBodies([]common.Hash) error {
 	panic("RequestBodies not supported in light client mode sync")
 }
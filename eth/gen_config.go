@@ -47,6 +47,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		TrieDirtyCache          int
 		TrieTimeout             time.Duration
 		SnapshotCache           int
+		ContractCallCache       int
 		Miner                   miner.Config
 		TxPool                  core.TxPoolConfig
 		EnablePreimageRecording bool
@@ -58,6 +59,8 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		RPCTxFeeCap             float64                        `toml:",omitempty"`
 		Checkpoint              *params.TrustedCheckpoint      `toml:",omitempty"`
 		CheckpointOracle        *params.CheckpointOracleConfig `toml:",omitempty"`
+		TrustedEpoch            *params.TrustedEpoch           `toml:",omitempty"`
+		EpochBundle             string                         `toml:",omitempty"`
 		OverrideEHardfork       *big.Int                       `toml:",omitempty"`
 	}
 	var enc Config
@@ -91,6 +94,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.TrieDirtyCache = c.TrieDirtyCache
 	enc.TrieTimeout = c.TrieTimeout
 	enc.SnapshotCache = c.SnapshotCache
+	enc.ContractCallCache = c.ContractCallCache
 	enc.Miner = c.Miner
 	enc.TxPool = c.TxPool
 	enc.EnablePreimageRecording = c.EnablePreimageRecording
@@ -102,6 +106,8 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.RPCTxFeeCap = c.RPCTxFeeCap
 	enc.Checkpoint = c.Checkpoint
 	enc.CheckpointOracle = c.CheckpointOracle
+	enc.TrustedEpoch = c.TrustedEpoch
+	enc.EpochBundle = c.EpochBundle
 	enc.OverrideEHardfork = c.OverrideEHardfork
 	return &enc, nil
 }
@@ -139,6 +145,7 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		TrieDirtyCache          *int
 		TrieTimeout             *time.Duration
 		SnapshotCache           *int
+		ContractCallCache       *int
 		Miner                   *miner.Config
 		TxPool                  *core.TxPoolConfig
 		EnablePreimageRecording *bool
@@ -150,6 +157,8 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		RPCTxFeeCap             *float64                       `toml:",omitempty"`
 		Checkpoint              *params.TrustedCheckpoint      `toml:",omitempty"`
 		CheckpointOracle        *params.CheckpointOracleConfig `toml:",omitempty"`
+		TrustedEpoch            *params.TrustedEpoch           `toml:",omitempty"`
+		EpochBundle             *string                        `toml:",omitempty"`
 		OverrideEhardfork       *big.Int                       `toml:",omitempty"`
 	}
 	var dec Config
@@ -246,6 +255,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.SnapshotCache != nil {
 		c.SnapshotCache = *dec.SnapshotCache
 	}
+	if dec.ContractCallCache != nil {
+		c.ContractCallCache = *dec.ContractCallCache
+	}
 	if dec.Miner != nil {
 		c.Miner = *dec.Miner
 	}
@@ -279,6 +291,12 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.CheckpointOracle != nil {
 		c.CheckpointOracle = dec.CheckpointOracle
 	}
+	if dec.TrustedEpoch != nil {
+		c.TrustedEpoch = dec.TrustedEpoch
+	}
+	if dec.EpochBundle != nil {
+		c.EpochBundle = *dec.EpochBundle
+	}
 	if dec.OverrideEhardfork != nil {
 		c.OverrideEHardfork = dec.OverrideEhardfork
 	}
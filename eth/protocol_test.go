@@ -169,8 +169,8 @@ func TestForkIDSplit(t *testing.T) {
 		dbNoFork  = rawdb.NewMemoryDatabase()
 		dbProFork = rawdb.NewMemoryDatabase()
 
-		gspecNoFork  = core.DeveloperGenesisBlock()
-		gspecProFork = core.DeveloperGenesisBlock()
+		gspecNoFork  = core.DeveloperGenesisBlock(1)
+		gspecProFork = core.DeveloperGenesisBlock(1)
 
 		genesisNoFork  = gspecNoFork.MustCommit(dbNoFork)
 		genesisProFork = gspecProFork.MustCommit(dbProFork)
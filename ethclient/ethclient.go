@@ -533,6 +533,69 @@ func (ec *Client) PendingCallContract(ctx context.Context, msg ethereum.CallMsg)
 	return hex, nil
 }
 
+// CallResult is the outcome of a call of a batch.
+type CallResult struct {
+	Output []byte // Returned data, or revert data of a failed multicall
+	Err    error  // Failure of the call, if any
+}
+
+// BatchCallContract executes the message calls in a single request batch,
+// returning the outcome of each call. The calls are independent of each other,
+// each of them running on the state of the block at the time it's served.
+//
+// blockNumber selects the block height at which the calls run, as in CallContract.
+// The returned error is only set if the batch itself failed.
+func (ec *Client) BatchCallContract(ctx context.Context, msgs []ethereum.CallMsg, blockNumber *big.Int) ([]CallResult, error) {
+	outputs := make([]hexutil.Bytes, len(msgs))
+	reqs := make([]rpc.BatchElem, len(msgs))
+	for i, msg := range msgs {
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args:   []interface{}{toCallArg(msg), toBlockNumArg(blockNumber)},
+			Result: &outputs[i],
+		}
+	}
+	if err := ec.c.BatchCallContext(ctx, reqs); err != nil {
+		return nil, err
+	}
+	results := make([]CallResult, len(msgs))
+	for i := range reqs {
+		results[i] = CallResult{Output: outputs[i], Err: reqs[i].Error}
+	}
+	return results, nil
+}
+
+// MulticallContract executes the read-only message calls with celo_multicall,
+// all of them seeing the same state of the block, and returns the outcome of
+// each call. The calls can't carry value and their sender is ignored.
+//
+// blockNumber selects the block height at which the calls run, as in CallContract.
+// The returned error is only set if the multicall itself failed.
+func (ec *Client) MulticallContract(ctx context.Context, msgs []ethereum.CallMsg, blockNumber *big.Int) ([]CallResult, error) {
+	args := make([]interface{}, len(msgs))
+	for i, msg := range msgs {
+		args[i] = toCallArg(msg)
+	}
+	var raw []struct {
+		Output hexutil.Bytes `json:"output"`
+		Error  string        `json:"error"`
+	}
+	if err := ec.c.CallContext(ctx, &raw, "celo_multicall", toBlockNumArg(blockNumber), args); err != nil {
+		return nil, err
+	}
+	if len(raw) != len(msgs) {
+		return nil, fmt.Errorf("multicall returned %d results for %d calls", len(raw), len(msgs))
+	}
+	results := make([]CallResult, len(raw))
+	for i, r := range raw {
+		results[i].Output = r.Output
+		if r.Error != "" {
+			results[i].Err = errors.New(r.Error)
+		}
+	}
+	return results, nil
+}
+
 // SuggestGasPrice retrieves the currently suggested gas price to allow a timely
 // execution of a transaction.
 func (ec *Client) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -182,6 +183,11 @@ var (
 	testKey, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
 	testAddr    = crypto.PubkeyToAddress(testKey.PublicKey)
 	testBalance = big.NewInt(2e10)
+
+	// Contracts returning 42, reverting and looping forever, respectively
+	testReturnAddr = common.HexToAddress("0x1000")
+	testRevertAddr = common.HexToAddress("0x1001")
+	testLoopAddr   = common.HexToAddress("0x1002")
 )
 
 func newTestBackend(t *testing.T) (*node.Node, []*types.Block) {
@@ -215,8 +221,13 @@ func generateTestChain() (*core.Genesis, []*types.Block) {
 	engine := mockEngine.NewFaker()
 
 	genesis := &core.Genesis{
-		Config:    config,
-		Alloc:     core.GenesisAlloc{testAddr: {Balance: testBalance}},
+		Config: config,
+		Alloc: core.GenesisAlloc{
+			testAddr:       {Balance: testBalance},
+			testReturnAddr: {Balance: new(big.Int), Code: common.FromHex("602a60005260206000f3")},
+			testRevertAddr: {Balance: new(big.Int), Code: common.FromHex("60006000fd")},
+			testLoopAddr:   {Balance: new(big.Int), Code: common.FromHex("5b600056")},
+		},
 		ExtraData: []byte("test genesis"),
 		Timestamp: 9000,
 	}
@@ -353,3 +364,72 @@ func TestChainID(t *testing.T) {
 		t.Fatalf("ChainID returned wrong number: %+v", id)
 	}
 }
+
+func TestBatchCallContract(t *testing.T) {
+	backend, _ := newTestBackend(t)
+	client, _ := backend.Attach()
+	defer backend.Close()
+	defer client.Close()
+	ec := NewClient(client)
+
+	calls := map[string]func(context.Context, []ethereum.CallMsg, *big.Int) ([]CallResult, error){
+		"batch":     ec.BatchCallContract,
+		"multicall": ec.MulticallContract,
+	}
+	msgs := []ethereum.CallMsg{
+		{From: testAddr, To: &testReturnAddr},
+		{From: testAddr, To: &testRevertAddr},
+		{From: testAddr, To: &common.Address{1}},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			results, err := call(context.Background(), msgs, big.NewInt(1))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(results) != len(msgs) {
+				t.Fatalf("got %d results, want %d", len(results), len(msgs))
+			}
+			if err := results[0].Err; err != nil {
+				t.Errorf("call 0: unexpected error: %v", err)
+			}
+			if want := common.LeftPadBytes([]byte{42}, 32); !reflect.DeepEqual(results[0].Output, want) {
+				t.Errorf("call 0: output %x, want %x", results[0].Output, want)
+			}
+			if err := results[1].Err; err == nil || err.Error() != "execution reverted" {
+				t.Errorf("call 1: error %v, want execution reverted", err)
+			}
+			if err := results[2].Err; err != nil {
+				t.Errorf("call 2: unexpected error: %v", err)
+			}
+			if len(results[2].Output) != 0 {
+				t.Errorf("call 2: output %x, want none", results[2].Output)
+			}
+		})
+	}
+
+	// The block must exist, failing the whole batch otherwise
+	if _, err := ec.MulticallContract(context.Background(), msgs, big.NewInt(1000000000)); err == nil {
+		t.Error("multicall on a future block: expected error")
+	}
+	// Calls running out of their own gas only fail themselves
+	loops := []ethereum.CallMsg{
+		{To: &testLoopAddr, Gas: 100000},
+		{To: &testReturnAddr},
+	}
+	results, err := ec.MulticallContract(context.Background(), loops, big.NewInt(1))
+	if err != nil {
+		t.Fatalf("multicall with gas limited calls: unexpected error: %v", err)
+	}
+	if err := results[0].Err; err == nil || err.Error() != "out of gas" {
+		t.Errorf("call 0: error %v, want out of gas", err)
+	}
+	if err := results[1].Err; err != nil {
+		t.Errorf("call 1: unexpected error: %v", err)
+	}
+	// But exhausting the gas budget of the multicall fails it
+	loops[0].Gas = 0
+	if _, err := ec.MulticallContract(context.Background(), loops, big.NewInt(1)); err == nil || !strings.Contains(err.Error(), "gas budget") {
+		t.Errorf("multicall exhausting its gas budget: error %v, want gas budget exhausted", err)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethstats
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	gpm "github.com/aaronwinter/celo-blockchain/contracts/gasprice_minimum"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/rpc"
+)
+
+// consensusStats is the information to report about the consensus progress of
+// the local node and the participation of the validators in the latest blocks.
+type consensusStats struct {
+	Number       *big.Int    `json:"number"`
+	Hash         common.Hash `json:"hash"`
+	Validating   bool        `json:"validating"`
+	Sequence     *big.Int    `json:"sequence,omitempty"`
+	Round        *big.Int    `json:"round,omitempty"`
+	RoundChanges uint64      `json:"roundChanges"`
+	ParentSeal   *sealStats  `json:"parentSeal,omitempty"`
+}
+
+// sealStats is the participation of the validators in the aggregated seal of a
+// block, as recorded in the parent seal bitmap of its child.
+type sealStats struct {
+	Number     *big.Int            `json:"number"`
+	Round      *big.Int            `json:"round"`
+	Signers    int                 `json:"signers"`
+	Validators []sealParticipation `json:"validators"`
+}
+
+// sealParticipation is whether a validator signed an aggregated seal.
+type sealParticipation struct {
+	Address common.Address `json:"address"`
+	Signed  bool           `json:"signed"`
+}
+
+// txPoolStats is the information to report about the transaction pool, along with
+// the number of transactions paying fees in each currency.
+type txPoolStats struct {
+	Pending    int                 `json:"pending"`
+	Queued     int                 `json:"queued"`
+	Currencies []currencyPoolStats `json:"currencies"`
+}
+
+// currencyPoolStats is the information to report about the transactions of the
+// pool, pending or queued, paying fees in a given currency.
+type currencyPoolStats struct {
+	Currency        *common.Address `json:"currency"` // nil for the native currency
+	Transactions    int             `json:"transactions"`
+	GasPriceMinimum *big.Int        `json:"gasPriceMinimum"`
+}
+
+// reportConsensus reports the consensus progress of the local node and the
+// participation of the validators in the parent seal of the given block, or of
+// the current head if block is nil.
+func (s *Service) reportConsensus(conn *connWrapper, block *types.Block) error {
+	var header *types.Header
+	if block != nil {
+		header = block.Header()
+	} else {
+		header = s.backend.CurrentHeader()
+	}
+	details := &consensusStats{
+		Number:     header.Number,
+		Hash:       header.Hash(),
+		Validating: s.istanbulBackend.IsValidating(),
+	}
+	if view, roundChanges, err := s.istanbulBackend.CurrentView(); err == nil {
+		details.Sequence, details.Round, details.RoundChanges = view.Sequence, view.Round, roundChanges
+	}
+	details.ParentSeal = s.assembleParentSealStats(header)
+
+	log.Trace("Sending consensus details to ethstats", "number", details.Number, "round", details.Round)
+
+	stats := map[string]interface{}{
+		"id":        s.istanbulBackend.ValidatorAddress().String(),
+		"consensus": details,
+	}
+	return s.sendStats(conn, actionConsensus, stats)
+}
+
+// assembleParentSealStats retrieves the validators which were expected to sign the
+// parent of the given header, and checks them against its parent seal bitmap.
+func (s *Service) assembleParentSealStats(header *types.Header) *sealStats {
+	number := header.Number.Uint64()
+	if number <= 1 {
+		return nil
+	}
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		log.Debug("Failed to extract istanbul extra", "number", number, "err", err)
+		return nil
+	}
+	parent, _ := s.backend.HeaderByNumber(context.Background(), rpc.BlockNumber(number-1))
+	if parent == nil || parent.Hash() != header.ParentHash {
+		return nil
+	}
+	// The parent was sealed by the validators elected as of its own parent
+	validators := s.istanbulBackend.GetValidators(new(big.Int).SetUint64(number-2), parent.ParentHash)
+	return assembleSealStats(parent.Number, validators, &extra.ParentAggregatedSeal)
+}
+
+// assembleSealStats checks which of the validators signed an aggregated seal.
+func assembleSealStats(number *big.Int, validators []istanbul.Validator, seal *types.IstanbulAggregatedSeal) *sealStats {
+	stats := &sealStats{
+		Number:     number,
+		Round:      seal.Round,
+		Validators: make([]sealParticipation, len(validators)),
+	}
+	for i, validator := range validators {
+		signed := seal.Bitmap != nil && seal.Bitmap.Bit(i) == 1
+		if signed {
+			stats.Signers++
+		}
+		stats.Validators[i] = sealParticipation{Address: validator.Address(), Signed: signed}
+	}
+	return stats
+}
+
+// reportTxPool reports the size of the transaction pool by fee currency, along
+// with the gas price minimum of each currency. As the gas price minimums are
+// retrieved from the state, it's only sent along with the full reports.
+func (s *Service) reportTxPool(conn *connWrapper) error {
+	fullBackend, ok := s.backend.(fullNodeBackend)
+	if !ok {
+		// Light nodes only know about their own transactions, skip
+		return nil
+	}
+	pending, queued := fullBackend.Stats()
+	native, currencies := fullBackend.TxPoolCurrencyStats()
+	details := assembleTxPoolStats(pending, queued, native, currencies)
+
+	header := fullBackend.CurrentHeader()
+	stateDB, _, err := s.backend.StateAndHeaderByNumberOrHash(context.Background(), rpc.BlockNumberOrHashWithHash(header.Hash(), true))
+	if err != nil {
+		return err
+	}
+	vmRunner := s.backend.NewEVMRunner(header, stateDB)
+	for i := range details.Currencies {
+		currency := &details.Currencies[i]
+		minimum, err := gpm.GetGasPriceMinimum(vmRunner, currency.Currency)
+		if err != nil {
+			log.Debug("Failed to retrieve gas price minimum", "currency", currency.Currency, "err", err)
+		}
+		currency.GasPriceMinimum = minimum
+	}
+	log.Trace("Sending transaction pool details to ethstats", "pending", details.Pending, "queued", details.Queued)
+
+	stats := map[string]interface{}{
+		"id":     s.istanbulBackend.ValidatorAddress().String(),
+		"txpool": details,
+	}
+	return s.sendStats(conn, actionTxPool, stats)
+}
+
+// assembleTxPoolStats assembles the transaction pool stats from the pool counters.
+// The native currency always comes first, the others are sorted by address.
+func assembleTxPoolStats(pending, queued, native int, currencies map[common.Address]int) *txPoolStats {
+	stats := &txPoolStats{
+		Pending:    pending,
+		Queued:     queued,
+		Currencies: []currencyPoolStats{{Transactions: native}},
+	}
+	others := make([]currencyPoolStats, 0, len(currencies))
+	for currency, count := range currencies {
+		currency := currency
+		others = append(others, currencyPoolStats{Currency: &currency, Transactions: count})
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return bytes.Compare(others[i].Currency.Bytes(), others[j].Currency.Bytes()) < 0
+	})
+	stats.Currencies = append(stats.Currencies, others...)
+	return stats
+}
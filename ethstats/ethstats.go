@@ -83,14 +83,16 @@ const (
 	// valSetInterval is the frequency in blocks to send the validator set
 	valSetInterval = 11
 
-	actionBlock    = "block"
-	actionHello    = "hello"
-	actionHistory  = "history"
-	actionLatency  = "latency"
-	actionNodePing = "node-ping"
-	actionNodePong = "node-pong"
-	actionPending  = "pending"
-	actionStats    = "stats"
+	actionBlock     = "block"
+	actionConsensus = "consensus"
+	actionHello     = "hello"
+	actionHistory   = "history"
+	actionLatency   = "latency"
+	actionNodePing  = "node-ping"
+	actionNodePong  = "node-pong"
+	actionPending   = "pending"
+	actionStats     = "stats"
+	actionTxPool    = "txpool"
 )
 
 // backend encompasses the bare-minimum functionality needed for ethstats reporting
@@ -115,6 +117,7 @@ type fullNodeBackend interface {
 	BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error)
 	CurrentBlock() *types.Block
 	SuggestPrice(ctx context.Context, currencyAddress *common.Address) (*big.Int, error)
+	TxPoolCurrencyStats() (native int, currencies map[common.Address]int)
 }
 
 // StatsPayload todo: document this
@@ -381,6 +384,9 @@ func (s *Service) loop(ctx context.Context) {
 							if err = s.reportPending(conn); err != nil {
 								log.Warn("Post-block transaction stats report failed", "err", err)
 							}
+							if err = s.reportConsensus(conn, head); err != nil {
+								log.Warn("Consensus stats report failed", "err", err)
+							}
 						case <-txCh:
 							if err = s.reportPending(conn); err != nil {
 								log.Warn("Transaction stats report failed", "err", err)
@@ -790,6 +796,12 @@ func (s *Service) report(conn *connWrapper, sendCh chan *StatsPayload) error {
 	if err := s.reportStats(conn); err != nil {
 		return err
 	}
+	if err := s.reportConsensus(conn, nil); err != nil {
+		return err
+	}
+	if err := s.reportTxPool(conn); err != nil {
+		return err
+	}
 	return nil
 }
 
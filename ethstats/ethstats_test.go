@@ -0,0 +1,139 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethstats
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/validator"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
+	"github.com/aaronwinter/celo-blockchain/ethstats/ethstatstest"
+	"github.com/gorilla/websocket"
+)
+
+func TestAssembleSealStats(t *testing.T) {
+	validators := make([]istanbul.Validator, 4)
+	for i := range validators {
+		validators[i] = validator.New(common.BytesToAddress([]byte{byte(i + 1)}), blscrypto.SerializedPublicKey{})
+	}
+	seal := &types.IstanbulAggregatedSeal{Bitmap: big.NewInt(0xb), Round: big.NewInt(2)}
+
+	stats := assembleSealStats(big.NewInt(10), validators, seal)
+	if stats.Signers != 3 {
+		t.Errorf("signers mismatch: have %d, want %d", stats.Signers, 3)
+	}
+	if stats.Round.Cmp(seal.Round) != 0 || stats.Number.Uint64() != 10 {
+		t.Errorf("seal mismatch: have number %v round %v, want 10, 2", stats.Number, stats.Round)
+	}
+	for i, signed := range []bool{true, true, false, true} {
+		if have := stats.Validators[i]; have.Address != validators[i].Address() || have.Signed != signed {
+			t.Errorf("validator %d mismatch: have %+v, want signed %v", i, have, signed)
+		}
+	}
+}
+
+func TestAssembleTxPoolStats(t *testing.T) {
+	var (
+		cusd = common.HexToAddress("0x02")
+		ceur = common.HexToAddress("0x01")
+	)
+	stats := assembleTxPoolStats(3, 3, 3, map[common.Address]int{cusd: 2, ceur: 1})
+	if stats.Pending != 3 || stats.Queued != 3 {
+		t.Fatalf("totals mismatch: have %d pending %d queued, want 3, 3", stats.Pending, stats.Queued)
+	}
+	want := []struct {
+		currency     *common.Address
+		transactions int
+	}{
+		{nil, 3},
+		{&ceur, 1},
+		{&cusd, 2},
+	}
+	if len(stats.Currencies) != len(want) {
+		t.Fatalf("currencies mismatch: have %d, want %d", len(stats.Currencies), len(want))
+	}
+	for i, w := range want {
+		have := stats.Currencies[i]
+		if (have.Currency == nil) != (w.currency == nil) || (w.currency != nil && *have.Currency != *w.currency) {
+			t.Errorf("currency %d mismatch: have %v, want %v", i, have.Currency, w.currency)
+		}
+		if have.Transactions != w.transactions {
+			t.Errorf("currency %d count mismatch: have %d, want %d", i, have.Transactions, w.transactions)
+		}
+	}
+}
+
+func TestLocalStatsServer(t *testing.T) {
+	server := ethstatstest.NewServer()
+	defer server.Close()
+
+	var name, host string
+	if err := parseStatsConnectionURL(server.URL("node"), &name, &host); err != nil {
+		t.Fatal(err)
+	}
+	if name != "node" {
+		t.Fatalf("node name mismatch: have %q, want %q", name, "node")
+	}
+	c, _, err := websocket.DefaultDialer.Dial(host+"/api", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := newConnectionWrapper(c)
+	defer conn.Close()
+
+	s := new(Service)
+	hello := &StatsPayload{Action: actionHello, Stats: map[string]interface{}{"id": "node"}}
+	if err := s.handleDelegateSend(conn, hello); err != nil {
+		t.Fatal(err)
+	}
+	var ack map[string][]string
+	if err := conn.ReadJSON(&ack); err != nil {
+		t.Fatal(err)
+	}
+	if emit := ack["emit"]; len(emit) != 1 || emit[0] != "ready" {
+		t.Fatalf("login not acknowledged: %v", ack)
+	}
+
+	consensus := &StatsPayload{Action: actionConsensus, Stats: map[string]interface{}{
+		"consensus": &consensusStats{Number: big.NewInt(5), Round: big.NewInt(1), RoundChanges: 3},
+	}}
+	if err := s.handleDelegateSend(conn, consensus); err != nil {
+		t.Fatal(err)
+	}
+	report, err := server.WaitFor(actionConsensus, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stats struct {
+		Consensus consensusStats `json:"consensus"`
+	}
+	if err := json.Unmarshal(report.Stats, &stats); err != nil {
+		t.Fatal(err)
+	}
+	if stats.Consensus.Number.Uint64() != 5 || stats.Consensus.Round.Uint64() != 1 || stats.Consensus.RoundChanges != 3 {
+		t.Errorf("consensus report mismatch: %+v", stats.Consensus)
+	}
+	if reports := server.Reports(); len(reports) != 2 || reports[0].Action != actionHello {
+		t.Errorf("reports mismatch: %v", reports)
+	}
+}
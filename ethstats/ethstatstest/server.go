@@ -0,0 +1,219 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package ethstatstest implements a local stats server speaking the celostats
+// protocol, so that the stats reporting service can be exercised offline.
+package ethstatstest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// errTimeout is returned by WaitFor if no matching report arrived in time.
+var errTimeout = errors.New("timed out waiting for report")
+
+// Report is a message emitted by a node to the stats server.
+type Report struct {
+	Action string          // Action of the message (hello, block, stats, ...)
+	Stats  json.RawMessage // Payload of the message, including the signature proof
+}
+
+// Server is a local stats server recording the reports of the connected nodes. It
+// acknowledges logins and answers pings the way the real server does.
+type Server struct {
+	server   *httptest.Server
+	upgrader websocket.Upgrader
+
+	lock    sync.Mutex
+	reports []Report
+	conns   map[*websocket.Conn]*sync.Mutex
+	notify  chan struct{} // Closed and replaced on every new report
+	reject  bool          // Whether logins are refused
+}
+
+// NewServer starts a local stats server listening on a random loopback port.
+func NewServer() *Server {
+	s := &Server{
+		conns:  make(map[*websocket.Conn]*sync.Mutex),
+		notify: make(chan struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api", s.serve)
+	s.server = httptest.NewServer(mux)
+	return s
+}
+
+// URL returns the stats connection url to pass to a node, nodename@host:port.
+func (s *Server) URL(name string) string {
+	return fmt.Sprintf("%s@%s", name, strings.Replace(s.server.URL, "http://", "ws://", 1))
+}
+
+// Close disconnects all the nodes and shuts the server down.
+func (s *Server) Close() {
+	s.lock.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.lock.Unlock()
+	s.server.Close()
+}
+
+// RejectLogins sets whether the server refuses the logins of new nodes.
+func (s *Server) RejectLogins(reject bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.reject = reject
+}
+
+// Reports returns all the reports received so far, in arrival order.
+func (s *Server) Reports() []Report {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]Report{}, s.reports...)
+}
+
+// WaitFor waits until a report with the given action arrives, returning the
+// first one already received if any.
+func (s *Server) WaitFor(action string, timeout time.Duration) (Report, error) {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for seen := 0; ; {
+		s.lock.Lock()
+		for ; seen < len(s.reports); seen++ {
+			if s.reports[seen].Action == action {
+				report := s.reports[seen]
+				s.lock.Unlock()
+				return report, nil
+			}
+		}
+		notify := s.notify
+		s.lock.Unlock()
+
+		select {
+		case <-notify:
+		case <-deadline.C:
+			return Report{}, errTimeout
+		}
+	}
+}
+
+// RequestHistory asks all the connected nodes to report the given blocks, or
+// their latest ones if the list is empty.
+func (s *Server) RequestHistory(list []uint64) error {
+	if list == nil {
+		list = []uint64{}
+	}
+	return s.broadcast(map[string][]interface{}{
+		"emit": {"history", map[string]interface{}{"list": list}},
+	})
+}
+
+// broadcast sends a message to all the connected nodes.
+func (s *Server) broadcast(msg interface{}) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for conn, wlock := range s.conns {
+		wlock.Lock()
+		err := conn.WriteJSON(msg)
+		wlock.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serve upgrades a node connection and processes its messages until it drops.
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	wlock := new(sync.Mutex)
+	s.lock.Lock()
+	s.conns[conn] = wlock
+	s.lock.Unlock()
+
+	defer func() {
+		s.lock.Lock()
+		delete(s.conns, conn)
+		s.lock.Unlock()
+		conn.Close()
+	}()
+	write := func(msg interface{}) error {
+		wlock.Lock()
+		defer wlock.Unlock()
+		return conn.WriteJSON(msg)
+	}
+	for {
+		var msg struct {
+			Emit []json.RawMessage `json:"emit"`
+		}
+		if err := conn.ReadJSON(&msg); err != nil {
+			return
+		}
+		if len(msg.Emit) != 2 {
+			continue
+		}
+		var report Report
+		if err := json.Unmarshal(msg.Emit[0], &report.Action); err != nil {
+			continue
+		}
+		report.Stats = msg.Emit[1]
+		s.record(report)
+
+		switch report.Action {
+		case "hello":
+			s.lock.Lock()
+			reject := s.reject
+			s.lock.Unlock()
+			if reject {
+				return
+			}
+			err = write(map[string][]string{"emit": {"ready"}})
+		case "node-ping":
+			err = write(map[string][]interface{}{
+				"emit": {"node-pong", map[string]interface{}{"serverTime": time.Now().UnixNano() / int64(time.Millisecond)}},
+			})
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// record stores a report and wakes up the waiters.
+func (s *Server) record(report Report) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.reports = append(s.reports, report)
+	close(s.notify)
+	s.notify = make(chan struct{})
+}
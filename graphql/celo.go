@@ -0,0 +1,221 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/rpc"
+)
+
+var errGenesisValidators = errors.New("the genesis block is not signed by validators")
+
+func (t *Transaction) FeeCurrency(ctx context.Context) (*common.Address, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return tx.FeeCurrency(), nil
+}
+
+func (t *Transaction) GatewayFeeRecipient(ctx context.Context) (*common.Address, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return tx.GatewayFeeRecipient(), nil
+}
+
+func (t *Transaction) GatewayFee(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZero(tx.GatewayFee()), nil
+}
+
+func (t *Transaction) EthCompatible(ctx context.Context) (bool, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return false, err
+	}
+	return tx.EthCompatible(), nil
+}
+
+// Randomness represents the randomness of a block.
+type Randomness struct {
+	randomness *types.Randomness
+}
+
+func (r *Randomness) Revealed() common.Hash {
+	return r.randomness.Revealed
+}
+
+func (r *Randomness) Committed() common.Hash {
+	return r.randomness.Committed
+}
+
+// AggregatedSeal represents an aggregated BLS signature of a block.
+type AggregatedSeal struct {
+	seal types.IstanbulAggregatedSeal
+}
+
+func (s *AggregatedSeal) Bitmap() hexutil.Big {
+	return bigOrZero(s.seal.Bitmap)
+}
+
+func (s *AggregatedSeal) Signature() hexutil.Bytes {
+	return hexutil.Bytes(s.seal.Signature)
+}
+
+func (s *AggregatedSeal) Round() hexutil.Big {
+	return bigOrZero(s.seal.Round)
+}
+
+// IstanbulExtra represents the Istanbul consensus data of a block.
+type IstanbulExtra struct {
+	extra *types.IstanbulExtra
+}
+
+func (e *IstanbulExtra) AddedValidators() []common.Address {
+	return e.extra.AddedValidators
+}
+
+func (e *IstanbulExtra) AddedValidatorsPublicKeys() []hexutil.Bytes {
+	ret := make([]hexutil.Bytes, 0, len(e.extra.AddedValidatorsPublicKeys))
+	for _, key := range e.extra.AddedValidatorsPublicKeys {
+		ret = append(ret, hexutil.Bytes(key[:]))
+	}
+	return ret
+}
+
+func (e *IstanbulExtra) RemovedValidators() hexutil.Big {
+	return bigOrZero(e.extra.RemovedValidators)
+}
+
+func (e *IstanbulExtra) AggregatedSeal() *AggregatedSeal {
+	return &AggregatedSeal{e.extra.AggregatedSeal}
+}
+
+func (e *IstanbulExtra) ParentAggregatedSeal() *AggregatedSeal {
+	return &AggregatedSeal{e.extra.ParentAggregatedSeal}
+}
+
+// Validator represents a member of a validator set.
+type Validator struct {
+	validator istanbul.Validator
+}
+
+func (v *Validator) Address() common.Address {
+	return v.validator.Address()
+}
+
+func (v *Validator) BlsPublicKey() hexutil.Bytes {
+	key := v.validator.BLSPublicKey()
+	return hexutil.Bytes(key[:])
+}
+
+func (b *Block) Randomness(ctx context.Context) (*Randomness, error) {
+	block, err := b.resolve(ctx)
+	if err != nil || block == nil || block.Randomness() == nil {
+		return nil, err
+	}
+	return &Randomness{block.Randomness()}, nil
+}
+
+func (b *Block) EpochNumber(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(istanbul.GetEpochNumber(header.Number.Uint64(), b.backend.Engine().EpochSize())), nil
+}
+
+func (b *Block) IstanbulExtra(ctx context.Context) (*IstanbulExtra, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	return &IstanbulExtra{extra}, nil
+}
+
+func (b *Block) BlockLogs(ctx context.Context) (*[]*Log, error) {
+	block, err := b.resolve(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	receipts, err := b.resolveReceipts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Log, 0)
+	// The block receipt, if any, follows the receipts of the transactions
+	if index := len(block.Transactions()); len(receipts) > index {
+		for _, log := range receipts[index].Logs {
+			ret = append(ret, &Log{
+				backend: b.backend,
+				log:     log,
+			})
+		}
+	}
+	return &ret, nil
+}
+
+func (r *Resolver) Validators(ctx context.Context, args BlockNumberArgs) ([]*Validator, error) {
+	// The validators of a block are elected as of its parent
+	var number uint64
+	if args.Block != nil {
+		number = uint64(*args.Block)
+	} else {
+		number = r.backend.CurrentHeader().Number.Uint64()
+	}
+	if number == 0 {
+		return nil, errGenesisValidators
+	}
+	parent, err := r.backend.HeaderByNumber(ctx, rpc.BlockNumber(number-1))
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		return nil, fmt.Errorf("block #%d not found", number-1)
+	}
+	validators := r.backend.Engine().GetValidators(parent.Number, parent.Hash())
+	ret := make([]*Validator, 0, len(validators))
+	for _, validator := range validators {
+		ret = append(ret, &Validator{validator})
+	}
+	return ret, nil
+}
+
+// bigOrZero returns the integer, or zero if it's nil.
+func bigOrZero(i *big.Int) hexutil.Big {
+	if i == nil {
+		return hexutil.Big{}
+	}
+	return hexutil.Big(*i)
+}
@@ -88,7 +88,8 @@ func (a *Account) Storage(ctx context.Context, args struct{ Slot common.Hash })
 	return state.GetState(a.address, args.Slot), nil
 }
 
-// Log represents an individual log message. All arguments are mandatory.
+// Log represents an individual log message. All arguments are mandatory, but
+// for the transaction of the logs of the block receipt.
 type Log struct {
 	backend     ethapi.Backend
 	transaction *Transaction
@@ -631,9 +632,14 @@ func runFilter(ctx context.Context, be ethapi.Backend, filter *filters.Filter) (
 	}
 	ret := make([]*Log, 0, len(logs))
 	for _, log := range logs {
+		var transaction *Transaction
+		// The logs of the block receipt are keyed by the block hash
+		if log.TxHash != log.BlockHash {
+			transaction = &Transaction{backend: be, hash: log.TxHash}
+		}
 		ret = append(ret, &Log{
 			backend:     be,
-			transaction: &Transaction{backend: be, hash: log.TxHash},
+			transaction: transaction,
 			log:         log,
 		})
 	}
@@ -17,14 +17,26 @@
 package graphql
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net/http"
+	"os"
 	"strings"
 	"testing"
 
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/core"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/core/vm"
+	"github.com/aaronwinter/celo-blockchain/crypto"
 	"github.com/aaronwinter/celo-blockchain/eth"
 	"github.com/aaronwinter/celo-blockchain/node"
+	"github.com/aaronwinter/celo-blockchain/params"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -132,3 +144,258 @@ func doHTTPRequest(t *testing.T, req *http.Request) *http.Response {
 	}
 	return resp
 }
+
+func TestCeloTransactionFields(t *testing.T) {
+	ctx := context.Background()
+	feeCurrency, gatewayFeeRecipient := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+
+	celoTx := &Transaction{tx: types.NewTransaction(0, common.Address{}, common.Big0, 21000, common.Big1, &feeCurrency, &gatewayFeeRecipient, big.NewInt(100), nil)}
+	gotFeeCurrency, _ := celoTx.FeeCurrency(ctx)
+	assert.Equal(t, &feeCurrency, gotFeeCurrency)
+	gotRecipient, _ := celoTx.GatewayFeeRecipient(ctx)
+	assert.Equal(t, &gatewayFeeRecipient, gotRecipient)
+	gotFee, _ := celoTx.GatewayFee(ctx)
+	assert.Equal(t, big.NewInt(100), gotFee.ToInt())
+	ethCompatible, _ := celoTx.EthCompatible(ctx)
+	assert.False(t, ethCompatible)
+
+	ethTx := &Transaction{tx: types.NewTransactionEthCompatible(0, common.Address{}, common.Big0, 21000, common.Big1, nil)}
+	gotFeeCurrency, _ = ethTx.FeeCurrency(ctx)
+	assert.Nil(t, gotFeeCurrency)
+	gotRecipient, _ = ethTx.GatewayFeeRecipient(ctx)
+	assert.Nil(t, gotRecipient)
+	gotFee, _ = ethTx.GatewayFee(ctx)
+	assert.Equal(t, 0, gotFee.ToInt().Sign())
+	ethCompatible, _ = ethTx.EthCompatible(ctx)
+	assert.True(t, ethCompatible)
+}
+
+// Tests the Celo fields of the genesis block and its validators
+func TestGraphQLCeloBlockFields(t *testing.T) {
+	// The consensus engine keeps its databases open after the node is closed, so
+	// place them in a data directory of our own
+	datadir, err := ioutil.TempDir("", "graphql-test")
+	if err != nil {
+		t.Fatalf("could not create data directory: %v", err)
+	}
+	defer os.RemoveAll(datadir)
+
+	stack, err := node.New(&node.Config{
+		DataDir:  datadir,
+		HTTPHost: "127.0.0.1",
+		HTTPPort: 9393,
+	})
+	if err != nil {
+		t.Fatalf("could not create node: %v", err)
+	}
+	defer stack.Close()
+	config := eth.DefaultConfig
+	config.Istanbul.ReplicaStateDBPath = stack.ResolvePath(config.Istanbul.ReplicaStateDBPath)
+	config.Istanbul.ValidatorEnodeDBPath = stack.ResolvePath(config.Istanbul.ValidatorEnodeDBPath)
+	config.Istanbul.VersionCertificateDBPath = stack.ResolvePath(config.Istanbul.VersionCertificateDBPath)
+	config.Istanbul.RoundStateDBPath = stack.ResolvePath(config.Istanbul.RoundStateDBPath)
+	ethBackend, err := eth.New(stack, &config)
+	if err != nil {
+		t.Fatalf("could not create eth backend: %v", err)
+	}
+	if err := New(stack, ethBackend.APIBackend, []string{}, []string{}); err != nil {
+		t.Fatalf("could not create graphql service: %v", err)
+	}
+	if err := stack.Start(); err != nil {
+		t.Fatalf("could not start node: %v", err)
+	}
+	query := `{
+		block(number: 0) {
+			epochNumber
+			randomness { revealed committed }
+			istanbulExtra { addedValidators removedValidators aggregatedSeal { round } parentAggregatedSeal { round } }
+			blockLogs { index }
+		}
+		validators(block: 1) { address }
+	}`
+	var result struct {
+		Data struct {
+			Block struct {
+				EpochNumber   hexutil.Uint64
+				Randomness    struct{ Revealed, Committed common.Hash }
+				IstanbulExtra struct {
+					AddedValidators      []common.Address
+					RemovedValidators    hexutil.Big
+					AggregatedSeal       struct{ Round hexutil.Big }
+					ParentAggregatedSeal struct{ Round hexutil.Big }
+				}
+				BlockLogs []struct{ Index int }
+			}
+			Validators []struct{ Address common.Address }
+		}
+		Errors []interface{}
+	}
+	postGraphQL(t, "127.0.0.1:9393", query, &result)
+	assert.Empty(t, result.Errors)
+
+	genesis := core.MainnetGenesisBlock()
+	extra, err := types.ExtractIstanbulExtra(&types.Header{Extra: genesis.ExtraData})
+	if err != nil {
+		t.Fatalf("could not decode genesis extra data: %v", err)
+	}
+	block := result.Data.Block
+	assert.Equal(t, hexutil.Uint64(0), block.EpochNumber)
+	assert.Equal(t, common.Hash{}, block.Randomness.Revealed)
+	assert.Equal(t, extra.AddedValidators, block.IstanbulExtra.AddedValidators)
+	assert.Equal(t, 0, block.IstanbulExtra.RemovedValidators.ToInt().Sign())
+	assert.Empty(t, block.BlockLogs)
+
+	validators := make([]common.Address, 0, len(result.Data.Validators))
+	for _, validator := range result.Data.Validators {
+		validators = append(validators, validator.Address)
+	}
+	assert.Equal(t, extra.AddedValidators, validators)
+}
+
+// Tests the Celo fields of a block holding a transaction paying its fees in
+// another currency, and logs emitted both by the transaction and the block.
+func TestGraphQLCeloTransactionFields(t *testing.T) {
+	var (
+		key, _           = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		feeCurrency      = common.HexToAddress("0x2000") // ERC20 reporting a maximal balance and accepting fee transfers
+		logger           = common.HexToAddress("0x2001") // Contract logging 42
+		random           = common.HexToAddress("0x2002") // Random contract logging 7 on revealAndCommit
+		gatewayRecipient = common.HexToAddress("0x2003")
+	)
+	// Registry resolving the Random contract only, so the randomness is revealed
+	// and committed, outside of transactions, by every block
+	registryCode := "600435" + "7f" + common.Bytes2Hex(params.RandomRegistryId[:]) + "14602d57" + "60206000f3" +
+		"5b" + "73" + common.Bytes2Hex(random.Bytes()) + "60005260206000f3"
+
+	genesis := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: core.GenesisAlloc{
+			crypto.PubkeyToAddress(key.PublicKey): {Balance: big.NewInt(1e18)},
+			params.RegistrySmartContractAddress:   {Balance: new(big.Int), Code: common.FromHex(registryCode)},
+			feeCurrency:                           {Balance: new(big.Int), Code: common.FromHex("60001960005260206000f3")},
+			logger:                                {Balance: new(big.Int), Code: common.FromHex("602a60005260206000a000")},
+			random:                                {Balance: new(big.Int), Code: common.FromHex("600760005260206000a000")},
+		},
+	}
+	stack, err := node.New(&node.Config{
+		HTTPHost: "127.0.0.1",
+		HTTPPort: 9393,
+	})
+	if err != nil {
+		t.Fatalf("could not create node: %v", err)
+	}
+	defer stack.Close()
+	ethBackend, err := eth.New(stack, &eth.Config{Genesis: genesis})
+	if err != nil {
+		t.Fatalf("could not create eth backend: %v", err)
+	}
+	if err := New(stack, ethBackend.APIBackend, []string{}, []string{}); err != nil {
+		t.Fatalf("could not create graphql service: %v", err)
+	}
+	if err := stack.Start(); err != nil {
+		t.Fatalf("could not start node: %v", err)
+	}
+
+	// Build the block by processing it on the genesis state, as the chain generator
+	// runs the core contract calls against mocks lacking the fee currency
+	chain := ethBackend.BlockChain()
+	tx, err := types.SignTx(types.NewTransaction(0, logger, new(big.Int), 200000, big.NewInt(1), &feeCurrency, &gatewayRecipient, big.NewInt(5), nil), types.NewEIP155Signer(genesis.Config.ChainID), key)
+	if err != nil {
+		t.Fatalf("could not sign transaction: %v", err)
+	}
+	parent := chain.CurrentBlock()
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     big.NewInt(1),
+		Time:       parent.Time() + 10,
+	}
+	statedb, err := chain.StateAt(parent.Root())
+	if err != nil {
+		t.Fatalf("could not retrieve genesis state: %v", err)
+	}
+	txs := []*types.Transaction{tx}
+	receipts, _, gasUsed, err := chain.Processor().Process(types.NewBlock(header, txs, nil, nil), statedb, vm.Config{})
+	if err != nil {
+		t.Fatalf("could not process block: %v", err)
+	}
+	header.GasUsed = gasUsed
+	header.Root = statedb.IntermediateRoot(true)
+	if _, err := chain.InsertChain(types.Blocks{types.NewBlock(header, txs, receipts, nil)}); err != nil {
+		t.Fatalf("could not import block: %v", err)
+	}
+
+	var result struct {
+		Data struct {
+			Block struct {
+				Transactions []struct {
+					FeeCurrency         *common.Address
+					GatewayFeeRecipient *common.Address
+					GatewayFee          hexutil.Big
+					EthCompatible       bool
+					Logs                []struct {
+						Index   int
+						Account struct{ Address common.Address }
+						Data    hexutil.Bytes
+					}
+				}
+				BlockLogs []struct {
+					Index       int
+					Account     struct{ Address common.Address }
+					Data        hexutil.Bytes
+					Transaction *struct{ Hash common.Hash }
+				}
+			}
+		}
+		Errors []interface{}
+	}
+	postGraphQL(t, "127.0.0.1:9393", `{
+		block(number: 1) {
+			transactions { feeCurrency gatewayFeeRecipient gatewayFee ethCompatible logs { index account { address } data } }
+			blockLogs { index account { address } data transaction { hash } }
+		}
+	}`, &result)
+	assert.Empty(t, result.Errors)
+
+	block := result.Data.Block
+	if assert.Len(t, block.Transactions, 1) {
+		tx := block.Transactions[0]
+		assert.Equal(t, &feeCurrency, tx.FeeCurrency)
+		assert.Equal(t, &gatewayRecipient, tx.GatewayFeeRecipient)
+		assert.Equal(t, big.NewInt(5), tx.GatewayFee.ToInt())
+		assert.False(t, tx.EthCompatible)
+		if assert.Len(t, tx.Logs, 1) {
+			assert.Equal(t, 0, tx.Logs[0].Index)
+			assert.Equal(t, logger, tx.Logs[0].Account.Address)
+			assert.Equal(t, common.LeftPadBytes([]byte{42}, 32), []byte(tx.Logs[0].Data))
+		}
+	}
+	if assert.Len(t, block.BlockLogs, 1) {
+		log := block.BlockLogs[0]
+		assert.Equal(t, 1, log.Index)
+		assert.Equal(t, random, log.Account.Address)
+		assert.Equal(t, common.LeftPadBytes([]byte{7}, 32), []byte(log.Data))
+		assert.Nil(t, log.Transaction)
+	}
+}
+
+// postGraphQL runs the query against the GraphQL endpoint, decoding the response
+// into result. A connection of its own is used, since the pooled ones may have
+// been opened to the node of a previous test serving the same endpoint.
+func postGraphQL(t *testing.T, endpoint string, query string, result interface{}) {
+	body, _ := json.Marshal(map[string]interface{}{"query": query})
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s/graphql", endpoint), bytes.NewReader(body))
+	if err != nil {
+		t.Fatal("could not issue new http request ", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal("could not issue a POST request to the given endpoint", err)
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		t.Fatalf("could not decode response body: %v", err)
+	}
+}
@@ -65,8 +65,10 @@ const schema string = `
         topics: [Bytes32!]!
         # Data is unindexed data for this log.
         data: Bytes!
-        # Transaction is the transaction that generated this log entry.
-        transaction: Transaction!
+        # Transaction is the transaction that generated this log entry. This is
+        # null for the logs of the block receipt, emitted by the core contract
+        # calls made outside of transactions.
+        transaction: Transaction
     }
 
     # Transaction is an Ethereum transaction.
@@ -90,6 +92,17 @@ const schema string = `
         gasPrice: BigInt!
         # Gas is the maximum amount of gas this transaction can consume.
         gas: Long!
+        # FeeCurrency is the address of the token the fees are paid in. This is
+        # null if the fees are paid in CELO.
+        feeCurrency: Address
+        # GatewayFeeRecipient is the account receiving the gateway fee. This is
+        # null if no gateway fee is paid.
+        gatewayFeeRecipient: Address
+        # GatewayFee is the fee paid to the gateway fee recipient, in the fee currency.
+        gatewayFee: BigInt!
+        # EthCompatible is true if the transaction was encoded as an Ethereum
+        # transaction, without the Celo specific fields.
+        ethCompatible: Boolean!
         # InputData is the data supplied to the target of the transaction.
         inputData: Bytes!
         # Block is the block this transaction was mined in. This will be null if
@@ -140,6 +153,47 @@ const schema string = `
         topics: [[Bytes32!]!]
     }
 
+    # Randomness is the randomness revealed and committed to by the proposer of a block.
+    type Randomness {
+        # Revealed is the randomness revealed by the proposer.
+        revealed: Bytes32!
+        # Committed is the commitment to the randomness the proposer will reveal next.
+        committed: Bytes32!
+    }
+
+    # IstanbulAggregatedSeal is an aggregated BLS signature of a block by the validators.
+    type IstanbulAggregatedSeal {
+        # Bitmap has an active bit for each validator that signed the block.
+        bitmap: BigInt!
+        # Signature is the aggregated BLS signature of the validators.
+        signature: Bytes!
+        # Round is the consensus round in which the signature was created.
+        round: BigInt!
+    }
+
+    # IstanbulExtra is the Istanbul consensus data carried in the extra data of a block.
+    type IstanbulExtra {
+        # AddedValidators are the validators added to the validator set in the block.
+        addedValidators: [Address!]!
+        # AddedValidatorsPublicKeys are the BLS public keys of the added validators.
+        addedValidatorsPublicKeys: [Bytes!]!
+        # RemovedValidators has an active bit for each validator removed from the
+        # validator set in the block.
+        removedValidators: BigInt!
+        # AggregatedSeal is the aggregated signature of the block.
+        aggregatedSeal: IstanbulAggregatedSeal!
+        # ParentAggregatedSeal is the aggregated signature of the parent block.
+        parentAggregatedSeal: IstanbulAggregatedSeal!
+    }
+
+    # Validator is a member of the validator set.
+    type Validator {
+        # Address is the address of the validator.
+        address: Address!
+        # BlsPublicKey is the BLS public key of the validator.
+        blsPublicKey: Bytes!
+    }
+
     # Block is an Ethereum block.
     type Block {
         # Number is the number of this block, starting at 0 for the genesis block.
@@ -171,6 +225,19 @@ const schema string = `
         # TotalDifficulty is the sum of all difficulty values up to and including
         # this block.
         totalDifficulty: BigInt!
+        # Randomness is the randomness of this block. If the block body is not
+        # available, this field will be null.
+        randomness: Randomness
+        # EpochNumber is the number of the epoch this block belongs to, the
+        # genesis block being the only block of epoch 0.
+        epochNumber: Long!
+        # IstanbulExtra is the consensus data carried in the extra data of this block.
+        istanbulExtra: IstanbulExtra!
+        # BlockLogs are the logs emitted by the core contract calls made outside
+        # of transactions when processing this block, i.e. the logs of its block
+        # receipt. If receipts are not available for this block, this field will
+        # be null.
+        blockLogs: [Log!]
         transactions: [Transaction!]
         # TransactionAt returns the transaction at the specified index. If
         # transactions are unavailable for this block, or if the index is out of
@@ -290,6 +357,9 @@ const schema string = `
         protocolVersion: Int!
         # Syncing returns information on the current synchronisation state.
         syncing: SyncState
+        # Validators returns the validators that must sign a block, the latest
+        # block if none is supplied.
+        validators(block: Long): [Validator!]!
     }
 
     type Mutation {
@@ -1148,7 +1148,9 @@ type RPCTransaction struct {
 // representation, with the given location metadata set (if available).
 func newRPCTransaction(tx *types.Transaction, blockHash common.Hash, blockNumber uint64, index uint64) *RPCTransaction {
 	var signer types.Signer = types.FrontierSigner{}
-	if tx.Protected() {
+	if _, ok := tx.ImpersonatedSender(); ok {
+		signer = types.NewImpersonationSigner(signer)
+	} else if tx.Protected() {
 		signer = types.NewEIP155Signer(tx.ChainId())
 	}
 	from, _ := types.Sender(signer, tx)
@@ -1533,7 +1535,7 @@ func SubmitTransaction(ctx context.Context, b Backend, tx *types.Transaction) (c
 		return common.Hash{}, err
 	}
 	if tx.To() == nil {
-		signer := types.MakeSigner(b.ChainConfig(), b.CurrentBlock().Number())
+		signer := types.LatestSigner(b.ChainConfig())
 		from, err := types.Sender(signer, tx)
 		if err != nil {
 			return common.Hash{}, err
@@ -1553,7 +1555,9 @@ func (s *PublicTransactionPoolAPI) SendTransaction(ctx context.Context, args Sen
 	account := accounts.Account{Address: args.From}
 
 	wallet, err := s.b.AccountManager().Find(account)
-	if err != nil {
+	// On a local fork, accounts without a local wallet can be impersonated
+	impersonate := err != nil && s.b.ChainConfig().IsImpersonationEnabled(new(big.Int).Add(s.b.CurrentBlock().Number(), common.Big1))
+	if err != nil && !impersonate {
 		return common.Hash{}, err
 	}
 
@@ -1571,6 +1575,9 @@ func (s *PublicTransactionPoolAPI) SendTransaction(ctx context.Context, args Sen
 	// Assemble the transaction and sign with the wallet
 	tx := args.toTransaction()
 
+	if impersonate {
+		return SubmitTransaction(ctx, s.b, types.Impersonate(tx, args.From))
+	}
 	signed, err := wallet.SignTx(account, tx, s.b.ChainConfig().ChainID)
 	if err != nil {
 		return common.Hash{}, err
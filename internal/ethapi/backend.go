@@ -138,6 +138,11 @@ func GetAPIs(apiBackend Backend) []rpc.API {
 			Version:   "1.0",
 			Service:   NewPrivateAccountAPI(apiBackend, nonceLock),
 			Public:    false,
+		}, {
+			Namespace: "celo",
+			Version:   "1.0",
+			Service:   NewPublicCeloAPI(apiBackend),
+			Public:    true,
 		},
 	}
 }
@@ -0,0 +1,279 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aaronwinter/celo-blockchain/accounts/abi"
+	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/contracts"
+	"github.com/aaronwinter/celo-blockchain/core"
+	"github.com/aaronwinter/celo-blockchain/core/state"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/core/vm"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/rpc"
+)
+
+const (
+	registryCacheSize = 128              // Number of states whose registry contents are kept
+	maxMulticallCalls = 1024             // Maximum number of calls of a multicall
+	maxMulticallGas   = 50000000         // Gas budget of a multicall if the RPC gas cap is unlimited
+	multicallTimeout  = 50 * time.Second // Time allowed to run all the calls of a multicall, as for eth_call
+)
+
+// PublicCeloAPI provides an API to access Celo specific chain information.
+type PublicCeloAPI struct {
+	b        Backend
+	registry *contracts.RegistryCache
+}
+
+// NewPublicCeloAPI creates a new Celo API.
+func NewPublicCeloAPI(b Backend) *PublicCeloAPI {
+	return &PublicCeloAPI{
+		b:        b,
+		registry: contracts.NewRegistryCache(registryCacheSize),
+	}
+}
+
+// GetRegistry returns the addresses of the core contracts registered at the given block.
+func (api *PublicCeloAPI) GetRegistry(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (contracts.Registry, error) {
+	// The pending state is not committed, so its registry can't be cached
+	if blockNr, ok := blockNrOrHash.Number(); ok && blockNr == rpc.PendingBlockNumber {
+		return api.readRegistry(ctx, blockNrOrHash)
+	}
+	header, err := api.b.HeaderByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, fmt.Errorf("block %v not found", blockNrOrHash)
+	}
+	if registry, ok := api.registry.Get(header.Root); ok {
+		return registry, nil
+	}
+	if registry, ok := api.deriveRegistry(ctx, header, nil); ok {
+		return registry, nil
+	}
+	registry, err := api.readRegistry(ctx, rpc.BlockNumberOrHashWithHash(header.Hash(), false))
+	if err != nil {
+		return nil, err
+	}
+	api.registry.Add(header.Root, registry)
+	return registry, nil
+}
+
+// RegistryUpdates creates a subscription that fires for every change made to the
+// registry by the blocks added to the canonical chain.
+func (api *PublicCeloAPI) RegistryUpdates(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		chainEvents := make(chan core.ChainEvent, 16)
+		chainSub := api.b.SubscribeChainEvent(chainEvents)
+		defer chainSub.Unsubscribe()
+
+		for {
+			select {
+			case ev := <-chainEvents:
+				header := ev.Block.Header()
+				updates, err := api.registryUpdates(context.Background(), header, ev.Logs)
+				if err != nil {
+					log.Warn("Failed to retrieve registry updates", "number", header.Number, "hash", ev.Hash, "err", err)
+					continue
+				}
+				// Keep the registry of the new head cached while we're at it
+				api.deriveRegistry(context.Background(), header, updates)
+				for _, update := range updates {
+					notifier.Notify(rpcSub.ID, update)
+				}
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			case <-chainSub.Err():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// MulticallResult is the outcome of a call of a multicall.
+type MulticallResult struct {
+	Output hexutil.Bytes `json:"output"`          // Returned data, or revert data of a failed call
+	Error  string        `json:"error,omitempty"` // Failure of the call, if any
+}
+
+// Multicall executes the read-only calls on the state of the given block, all
+// of them seeing the same state. The calls are run by the node itself, from the
+// zero address and without value.
+//
+// The calls share a gas budget, the RPC gas cap (or maxMulticallGas if it's
+// unlimited), and a deadline. A failing call doesn't abort the others, its
+// failure being reported in its result instead, but the whole multicall is
+// rejected once the budget is exhausted or the deadline expires.
+func (api *PublicCeloAPI) Multicall(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, calls []CallArgs) ([]MulticallResult, error) {
+	if len(calls) > maxMulticallCalls {
+		return nil, fmt.Errorf("too many calls: %d > %d", len(calls), maxMulticallCalls)
+	}
+	state, header, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, multicallTimeout)
+	defer cancel()
+
+	budget := api.b.RPCGasCap()
+	if budget == 0 {
+		budget = maxMulticallGas
+	}
+	remaining := budget
+
+	results := make([]MulticallResult, len(calls))
+	for i, args := range calls {
+		output, gasUsed, err := api.multicall(ctx, state, header, args, remaining)
+		if ctxErr := ctx.Err(); ctxErr == context.DeadlineExceeded {
+			return nil, fmt.Errorf("execution aborted (timeout = %v)", multicallTimeout)
+		} else if ctxErr != nil {
+			return nil, ctxErr
+		}
+		if err == errMulticallOutOfGas {
+			return nil, fmt.Errorf("gas budget of the multicall exhausted by call %d (budget = %d)", i, budget)
+		}
+		remaining -= gasUsed
+
+		results[i].Output = output
+		if err != nil {
+			results[i].Error = err.Error()
+		}
+	}
+	return results, nil
+}
+
+// errMulticallOutOfGas is returned when a call runs out of the gas left in the
+// budget of its multicall.
+var errMulticallOutOfGas = errors.New("multicall out of gas")
+
+// multicall executes a call of a multicall with at most the given gas, returning
+// the gas it used.
+func (api *PublicCeloAPI) multicall(ctx context.Context, state *state.StateDB, header *types.Header, args CallArgs, budget uint64) ([]byte, uint64, error) {
+	if args.To == nil {
+		return nil, 0, errors.New("missing call target")
+	}
+	if args.Value != nil && args.Value.ToInt().Sign() != 0 {
+		return nil, 0, errors.New("value transfers not supported")
+	}
+	gas := budget
+	if args.Gas != nil && uint64(*args.Gas) < gas {
+		gas = uint64(*args.Gas)
+	}
+	args.From = nil
+	msg := args.ToMessage(gas)
+
+	evm, vmError, err := api.b.GetEVM(ctx, msg, state, header)
+	if err != nil {
+		return nil, 0, err
+	}
+	// Abort the call once the multicall is cancelled or times out
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			evm.Cancel()
+		case <-done:
+		}
+	}()
+
+	output, leftOverGas, err := evm.StaticCall(vm.AccountRef(msg.From()), *args.To, msg.Data(), gas)
+	if err := vmError(); err != nil {
+		return nil, 0, err
+	}
+	if errors.Is(err, vm.ErrOutOfGas) && gas == budget && (args.Gas == nil || uint64(*args.Gas) > budget) {
+		return nil, gas, errMulticallOutOfGas
+	}
+	if errors.Is(err, vm.ErrExecutionReverted) {
+		if reason, errUnpack := abi.UnpackRevert(output); errUnpack == nil {
+			err = fmt.Errorf("execution reverted: %v", reason)
+		}
+	}
+	return output, gas - leftOverGas, err
+}
+
+// readRegistry queries the registry contract at the given block.
+func (api *PublicCeloAPI) readRegistry(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (contracts.Registry, error) {
+	state, header, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	return contracts.GetRegistry(api.b.NewEVMRunner(header, state))
+}
+
+// deriveRegistry computes the registry at header from its parent's cached one,
+// retrieving the block's registry updates unless they are given.
+func (api *PublicCeloAPI) deriveRegistry(ctx context.Context, header *types.Header, updates []*contracts.RegistryUpdate) (contracts.Registry, bool) {
+	if header.Number.Sign() == 0 {
+		return nil, false
+	}
+	parent, err := api.b.HeaderByHash(ctx, header.ParentHash)
+	if parent == nil || err != nil {
+		return nil, false
+	}
+	if _, ok := api.registry.Get(parent.Root); !ok {
+		return nil, false
+	}
+	if updates == nil {
+		if updates, err = api.registryUpdates(ctx, header, nil); err != nil {
+			return nil, false
+		}
+	}
+	return api.registry.Derive(parent.Root, header.Root, updates)
+}
+
+// registryUpdates returns the registry changes made in the block, looking at
+// the given logs or retrieving them if the block's bloom may contain any.
+func (api *PublicCeloAPI) registryUpdates(ctx context.Context, header *types.Header, logs []*types.Log) ([]*contracts.RegistryUpdate, error) {
+	if !contracts.MayContainRegistryUpdates(header.Bloom) {
+		return []*contracts.RegistryUpdate{}, nil
+	}
+	hash := header.Hash()
+	if len(logs) == 0 {
+		receiptLogs, err := api.b.GetLogs(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		for _, txLogs := range receiptLogs {
+			logs = append(logs, txLogs...)
+		}
+	}
+	updates := contracts.ParseRegistryUpdates(logs)
+	for _, update := range updates {
+		update.BlockNumber, update.BlockHash = header.Number.Uint64(), hash
+	}
+	return updates, nil
+}
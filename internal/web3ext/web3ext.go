@@ -20,6 +20,7 @@ package web3ext
 var Modules = map[string]string{
 	"accounting": AccountingJs,
 	"admin":      AdminJs,
+	"celo":       CeloJs,
 	"chequebook": ChequebookJs,
 	"debug":      DebugJs,
 	"eth":        EthJs,
@@ -530,6 +531,11 @@ web3._extend({
 			params: 0,
 			inputFormatter: []
 		}),
+		new web3._extend.Method({
+			name: 'sealBlock',
+			call: 'miner_sealBlock',
+			params: 0
+		}),
 	],
 	properties: []
 });
@@ -892,6 +898,17 @@ web3._extend({
 			name: 'setGatewayFee',
 			call: 'les_setGatewayFee',
 			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'gatewayFeeRevenue',
+			call: 'les_gatewayFeeRevenue',
+			params: 1,
+			inputFormatter: [null]
+		}),
+		new web3._extend.Method({
+			name: 'setGatewayFeePolicy',
+			call: 'les_setGatewayFeePolicy',
+			params: 1
 		})
 	],
 	properties:
@@ -919,6 +936,14 @@ web3._extend({
 		new web3._extend.Property({
 			name: 'gatewayFeeCache',
 			getter: 'les_gatewayFeeCache'
+		}),
+		new web3._extend.Property({
+			name: 'gatewayFeeTotals',
+			getter: 'les_gatewayFeeTotals'
+		}),
+		new web3._extend.Property({
+			name: 'gatewayFeePolicy',
+			getter: 'les_gatewayFeePolicy'
 		})
 	]
 });
@@ -954,3 +979,24 @@ web3._extend({
 	]
 });
 `
+
+const CeloJs = `
+web3._extend({
+	property: 'celo',
+	methods:
+	[
+		new web3._extend.Method({
+			name: 'getRegistry',
+			call: 'celo_getRegistry',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputDefaultBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'multicall',
+			call: 'celo_multicall',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputDefaultBlockNumberFormatter, null]
+		}),
+	]
+});
+`
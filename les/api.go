@@ -30,12 +30,13 @@ import (
 )
 
 var (
-	errNoCheckpoint         = errors.New("no local checkpoint provided")
-	errNotActivated         = errors.New("checkpoint registrar is not activated")
-	errUnknownBenchmarkType = errors.New("unknown benchmark type")
-	errBalanceOverflow      = errors.New("balance overflow")
-	errNoPriority           = errors.New("priority too low to raise capacity")
-	errInvalidGatewayFee    = errors.New("invalid gateway fee")
+	errNoCheckpoint            = errors.New("no local checkpoint provided")
+	errNotActivated            = errors.New("checkpoint registrar is not activated")
+	errUnknownBenchmarkType    = errors.New("unknown benchmark type")
+	errBalanceOverflow         = errors.New("balance overflow")
+	errNoPriority              = errors.New("priority too low to raise capacity")
+	errInvalidGatewayFee       = errors.New("invalid gateway fee")
+	errInvalidGatewayFeePolicy = errors.New("invalid gateway fee policy")
 )
 
 const maxBalance = math.MaxInt64
@@ -89,6 +90,34 @@ func (api *PrivateLightServerAPI) GatewayFeeRecipient() (eb common.Address, err
 	return api.server.handler.etherbase, nil
 }
 
+// GatewayFeeRevenue returns the gateway fee accounting of the given clients, or of
+// all the tracked clients if none is given
+func (api *PrivateLightServerAPI) GatewayFeeRevenue(ids []enode.ID) map[enode.ID]*GatewayFeeStats {
+	return api.server.handler.gatewayFees.clientStats(ids)
+}
+
+// GatewayFeeTotals returns the gateway fee accounting of all the clients since the
+// server started
+func (api *PrivateLightServerAPI) GatewayFeeTotals() *GatewayFeeStats {
+	return api.server.handler.gatewayFees.totalStats()
+}
+
+// GatewayFeePolicy returns how clients are treated depending on whether they pay
+// the gateway fee
+func (api *PrivateLightServerAPI) GatewayFeePolicy() GatewayFeePolicy {
+	return api.server.handler.gatewayFees.getPolicy()
+}
+
+// SetGatewayFeePolicy sets how clients are treated depending on whether they pay
+// the gateway fee
+func (api *PrivateLightServerAPI) SetGatewayFeePolicy(policy GatewayFeePolicy) error {
+	if policy.BalanceFactor < 0 {
+		return errInvalidGatewayFeePolicy
+	}
+	api.server.handler.gatewayFees.setPolicy(policy)
+	return nil
+}
+
 // ServerInfo returns global server parameters
 func (api *PrivateLightServerAPI) ServerInfo() map[string]interface{} {
 	res := make(map[string]interface{})
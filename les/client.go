@@ -157,6 +157,11 @@ func New(stack *node.Node, config *eth.Config) (*LightEthereum, error) {
 	if leth.blockchain, err = light.NewLightChain(leth.odr, leth.chainConfig, leth.engine, checkpoint); err != nil {
 		return nil, err
 	}
+	if syncMode == downloader.LightestSync {
+		if err := bootstrapEpochs(config, chainConfig, genesisHash, chainDb, leth.blockchain); err != nil {
+			return nil, err
+		}
+	}
 
 	leth.chainReader = leth.blockchain
 	leth.txPool = light.NewTxPool(leth.chainConfig, leth.blockchain, leth.relay)
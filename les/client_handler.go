@@ -337,6 +337,33 @@ func (h *clientHandler) handleMsg(p *serverPeer) error {
 				}
 			}
 		}
+	case EpochHeadersMsg:
+		p.Log().Trace("Received epoch header response message")
+		var resp struct {
+			ReqID, BV    uint64
+			EpochHeaders []*epochHeader
+		}
+		if err := msg.Decode(&resp); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		p.fcServer.ReceivedReply(resp.ReqID, resp.BV)
+		p.answeredRequest(resp.ReqID)
+
+		headers := make([]*types.Header, len(resp.EpochHeaders))
+		for i, entry := range resp.EpochHeaders {
+			if entry.Header == nil {
+				return errResp(ErrInvalidResponse, "missing epoch header")
+			}
+			if entry.Diff != nil {
+				if err := entry.verifyDiff(); err != nil {
+					return errResp(ErrInvalidResponse, "epoch header %d: %v", entry.Header.Number, err)
+				}
+			}
+			headers[i] = entry.Header
+		}
+		if err := h.downloader.DeliverHeaders(p.id, headers); err != nil {
+			log.Error("Failed to deliver epoch headers", "err", err)
+		}
 	case BlockBodiesMsg:
 		p.Log().Trace("Received block bodies response")
 		var resp struct {
@@ -549,6 +576,37 @@ func (pc *peerConnection) RequestHeadersByNumber(origin uint64, amount int, skip
 	return nil
 }
 
+// SupportsEpochHeaders returns whether the server serves epoch header requests
+func (pc *peerConnection) SupportsEpochHeaders() bool {
+	return pc.peer.serveEpochHeaders
+}
+
+// RequestEpochHeaders requests the last header of amount consecutive epochs, starting
+// from the epoch ending at origin, along with their validator set diffs.
+func (pc *peerConnection) RequestEpochHeaders(origin uint64, amount int) error {
+	rq := &distReq{
+		getCost: func(dp distPeer) uint64 {
+			peer := dp.(*serverPeer)
+			return peer.getRequestCost(GetEpochHeadersMsg, amount)
+		},
+		canSend: func(dp distPeer) bool {
+			return dp.(*serverPeer) == pc.peer
+		},
+		request: func(dp distPeer) func() {
+			reqID := genReqID()
+			peer := dp.(*serverPeer)
+			cost := peer.getRequestCost(GetEpochHeadersMsg, amount)
+			peer.fcServer.QueuedRequest(reqID, cost)
+			return func() { peer.requestEpochHeaders(reqID, origin, amount, true) }
+		},
+	}
+	_, ok := <-pc.handler.backend.reqDist.queue(rq)
+	if !ok {
+		return light.ErrNoPeers
+	}
+	return nil
+}
+
 // downloaderPeerNotify implements peerSetNotify
 type downloaderPeerNotify clientHandler
 
@@ -25,6 +25,7 @@ import (
 
 	"github.com/aaronwinter/celo-blockchain/common/mclock"
 	"github.com/aaronwinter/celo-blockchain/eth"
+	"github.com/aaronwinter/celo-blockchain/eth/downloader"
 	"github.com/aaronwinter/celo-blockchain/ethdb"
 	"github.com/aaronwinter/celo-blockchain/les/flowcontrol"
 	"github.com/aaronwinter/celo-blockchain/log"
@@ -45,6 +46,7 @@ var (
 		SendTxV2Msg:            {0, 450000},
 		GetTxStatusMsg:         {0, 250000},
 		GetEtherbaseMsg:        {10000, 1},
+		GetEpochHeadersMsg:     {150000, 60000},
 	}
 	// maximum incoming message size estimates
 	reqMaxInSize = requestCostTable{
@@ -57,6 +59,7 @@ var (
 		SendTxV2Msg:            {0, 16500},
 		GetTxStatusMsg:         {0, 50},
 		GetEtherbaseMsg:        {0, 10},
+		GetEpochHeadersMsg:     {40, 0},
 	}
 	// maximum outgoing message size estimates
 	reqMaxOutSize = requestCostTable{
@@ -69,6 +72,7 @@ var (
 		SendTxV2Msg:            {0, 100},
 		GetTxStatusMsg:         {0, 100},
 		GetEtherbaseMsg:        {0, 100},
+		GetEpochHeadersMsg:     {0, 1200},
 	}
 	// request amounts that have to fit into the minimum buffer size minBufferMultiplier times
 	minBufferReqAmount = map[uint64]uint64{
@@ -81,6 +85,7 @@ var (
 		SendTxV2Msg:            8,
 		GetTxStatusMsg:         64,
 		GetEtherbaseMsg:        1,
+		GetEpochHeadersMsg:     uint64(downloader.MaxEpochHeaderFetch),
 	}
 	minBufferMultiplier = 3
 )
@@ -285,6 +290,8 @@ func (ct *costTracker) gfLoop() {
 					switch r.msgCode {
 					case GetBlockHeadersMsg:
 						relativeCostHeaderHistogram.Update(relCost)
+					case GetEpochHeadersMsg:
+						relativeCostEpochHeaderHistogram.Update(relCost)
 					case GetBlockBodiesMsg:
 						relativeCostBodyHistogram.Update(relCost)
 					case GetReceiptsMsg:
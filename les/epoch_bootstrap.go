@@ -0,0 +1,120 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package les
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	istanbulBackend "github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/eth"
+	"github.com/aaronwinter/celo-blockchain/ethdb"
+	"github.com/aaronwinter/celo-blockchain/light"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/params"
+)
+
+// bootstrapEpochs moves the head of a lightest sync chain to a trusted epoch, so that
+// the validator set transitions are only verified from that epoch forward. The epoch
+// is either the last one of a verified epoch bundle, or a hardcoded trusted epoch.
+func bootstrapEpochs(config *eth.Config, chainConfig *params.ChainConfig, genesisHash common.Hash, db ethdb.Database, lc *light.LightChain) error {
+	if chainConfig.Istanbul == nil {
+		return nil
+	}
+	epochSize := chainConfig.Istanbul.Epoch
+
+	var trusted *istanbulBackend.TrustedEpoch
+	trustedConfig := config.TrustedEpoch
+	if trustedConfig == nil {
+		trustedConfig = params.TrustedEpochs[genesisHash]
+	}
+	if trustedConfig != nil {
+		var err error
+		if trusted, err = istanbulBackend.NewTrustedEpoch(trustedConfig, epochSize); err != nil {
+			return err
+		}
+	}
+
+	headers, epoch := []*types.Header{}, trusted
+	if config.EpochBundle != "" {
+		bundle, err := readEpochBundle(config.EpochBundle)
+		if err != nil {
+			return fmt.Errorf("failed to read epoch bundle: %v", err)
+		}
+		if bundle.GenesisHash != genesisHash {
+			return fmt.Errorf("epoch bundle of another chain: genesis %x, want %x", bundle.GenesisHash, genesisHash)
+		}
+		// Only verify from the trusted epoch if the bundle continues it
+		from, err := istanbulBackend.GenesisEpoch(lc.Genesis().Header())
+		if err != nil {
+			return err
+		}
+		if trusted != nil && len(bundle.Epochs) > 0 && bundle.Epochs[0].Header.Number.Uint64() == trusted.Header.Number.Uint64()+epochSize {
+			from = trusted
+		}
+		if epoch, err = istanbulBackend.VerifyEpochBundle(chainConfig, bundle, from); err != nil {
+			return err
+		}
+		headers = bundle.Headers()
+		log.Info("Verified epoch bundle", "epochs", len(bundle.Epochs), "number", epoch.Header.Number, "hash", epoch.Header.Hash())
+	}
+	if epoch == nil || epoch.Header.Number.Sign() == 0 {
+		return nil
+	}
+	if head := lc.CurrentHeader().Number.Uint64(); head >= epoch.Header.Number.Uint64() {
+		log.Debug("Chain head is past the trusted epoch", "head", head, "epoch", epoch.Header.Number)
+		return nil
+	}
+	if len(headers) == 0 {
+		headers = append(headers, epoch.Header)
+	}
+
+	// The snapshot has to be stored before the header becomes the head of the chain
+	if err := istanbulBackend.WriteTrustedEpoch(db, epochSize, epoch); err != nil {
+		return err
+	}
+	if _, err := lc.InsertTrustedHeaders(headers); err != nil {
+		return err
+	}
+	log.Info("Bootstrapped lightest sync from trusted epoch", "number", epoch.Header.Number, "hash", epoch.Header.Hash())
+	return nil
+}
+
+// readEpochBundle reads an epoch bundle file, which may be gzipped
+func readEpochBundle(path string) (*istanbulBackend.EpochBundle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	return istanbulBackend.ReadEpochBundle(r)
+}
@@ -0,0 +1,229 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package les
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/mclock"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/metrics"
+	"github.com/aaronwinter/celo-blockchain/p2p/enode"
+)
+
+// maxGatewayFeeClients is the number of clients the gateway fee tracker keeps
+// statistics for. The least recently active ones are evicted first.
+const maxGatewayFeeClients = 10000
+
+var (
+	errTooManyUnpaidTxs = errors.New("too many transactions without gateway fee")
+
+	gatewayFeePaidTxMeter     = metrics.NewRegisteredMeter("les/server/gatewayfee/txs/paid", nil)
+	gatewayFeeUnpaidTxMeter   = metrics.NewRegisteredMeter("les/server/gatewayfee/txs/unpaid", nil)
+	gatewayFeeRejectedTxMeter = metrics.NewRegisteredMeter("les/server/gatewayfee/txs/rejected", nil)
+	gatewayFeeRevenueCounter  = metrics.NewRegisteredCounter("les/server/gatewayfee/revenue/gwei", nil)
+)
+
+// GatewayFeePolicy controls how light clients are treated depending on whether the
+// transactions they relay pay the gateway fee of the server.
+type GatewayFeePolicy struct {
+	// BalanceFactor is the amount of positive client pool balance credited to a
+	// client per gwei of gateway fee paid in the native currency (0 = disabled).
+	// Clients with a positive balance are prioritized over non-paying ones.
+	BalanceFactor float64 `json:"balanceFactor"`
+
+	// MaxUnpaid is the number of relayed transactions which didn't pay the gateway
+	// fee after which a client is disconnected (0 = unlimited).
+	MaxUnpaid uint64 `json:"maxUnpaid"`
+}
+
+// GatewayFeeStats holds the gateway fee accounting of a light client.
+type GatewayFeeStats struct {
+	Paid     uint64                      `json:"paid"`     // Relayed transactions paying the gateway fee
+	Unpaid   uint64                      `json:"unpaid"`   // Relayed transactions not paying the gateway fee
+	Rejected uint64                      `json:"rejected"` // Transactions rejected for an invalid gateway fee
+	Revenue  map[common.Address]*big.Int `json:"revenue"`  // Gateway fees paid, by fee currency (zero address for the native currency)
+	lastSeen mclock.AbsTime
+}
+
+func (s *GatewayFeeStats) copy() *GatewayFeeStats {
+	cpy := *s
+	cpy.Revenue = make(map[common.Address]*big.Int, len(s.Revenue))
+	for currency, revenue := range s.Revenue {
+		cpy.Revenue[currency] = new(big.Int).Set(revenue)
+	}
+	return &cpy
+}
+
+// gatewayFeeTracker accounts for the gateway fees paid by the transactions light
+// clients relay through the server. Fees are accounted when the transactions are
+// accepted into the transaction pool, not when they are included in a block.
+type gatewayFeeTracker struct {
+	lock    sync.Mutex
+	clock   mclock.Clock
+	policy  GatewayFeePolicy
+	clients map[enode.ID]*GatewayFeeStats
+	total   *GatewayFeeStats
+}
+
+func newGatewayFeeTracker(clock mclock.Clock) *gatewayFeeTracker {
+	return &gatewayFeeTracker{
+		clock:   clock,
+		clients: make(map[enode.ID]*GatewayFeeStats),
+		total:   &GatewayFeeStats{Revenue: make(map[common.Address]*big.Int)},
+	}
+}
+
+// setPolicy updates the policy applied to the clients
+func (t *gatewayFeeTracker) setPolicy(policy GatewayFeePolicy) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.policy = policy
+}
+
+// getPolicy returns the policy applied to the clients
+func (t *gatewayFeeTracker) getPolicy() GatewayFeePolicy {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.policy
+}
+
+// stats returns the statistics of a client, creating them if needed. The lock
+// must be held.
+func (t *gatewayFeeTracker) stats(id enode.ID) *GatewayFeeStats {
+	s := t.clients[id]
+	if s == nil {
+		if len(t.clients) >= maxGatewayFeeClients {
+			t.evict()
+		}
+		s = &GatewayFeeStats{Revenue: make(map[common.Address]*big.Int)}
+		t.clients[id] = s
+	}
+	s.lastSeen = t.clock.Now()
+	return s
+}
+
+// evict removes the statistics of the least recently active client. The lock must
+// be held.
+func (t *gatewayFeeTracker) evict() {
+	var (
+		oldest   enode.ID
+		lastSeen mclock.AbsTime
+		found    bool
+	)
+	for id, s := range t.clients {
+		if !found || s.lastSeen < lastSeen {
+			oldest, lastSeen, found = id, s.lastSeen, true
+		}
+	}
+	delete(t.clients, oldest)
+}
+
+// relayed accounts for a transaction relayed by a client and accepted into the
+// transaction pool. It returns the positive balance to credit to the client, and
+// errTooManyUnpaidTxs if the client exceeded the unpaid transactions of the policy.
+func (t *gatewayFeeTracker) relayed(id enode.ID, tx *types.Transaction, etherbase common.Address) (int64, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	s := t.stats(id)
+	recipient, fee := tx.GatewayFeeRecipient(), tx.GatewayFee()
+	if etherbase == common.ZeroAddress || recipient == nil || *recipient != etherbase || fee == nil || fee.Sign() <= 0 {
+		s.Unpaid++
+		t.total.Unpaid++
+		gatewayFeeUnpaidTxMeter.Mark(1)
+		if t.policy.MaxUnpaid != 0 && s.Unpaid+s.Rejected > t.policy.MaxUnpaid {
+			return 0, errTooManyUnpaidTxs
+		}
+		return 0, nil
+	}
+	currency := common.ZeroAddress
+	if tx.FeeCurrency() != nil {
+		currency = *tx.FeeCurrency()
+	}
+	for _, stats := range []*GatewayFeeStats{s, t.total} {
+		stats.Paid++
+		if stats.Revenue[currency] == nil {
+			stats.Revenue[currency] = new(big.Int)
+		}
+		stats.Revenue[currency].Add(stats.Revenue[currency], fee)
+	}
+	gatewayFeePaidTxMeter.Mark(1)
+	if currency != common.ZeroAddress {
+		return 0, nil
+	}
+	gwei := new(big.Int).Div(fee, big.NewInt(1e9))
+	if gwei.IsInt64() {
+		gatewayFeeRevenueCounter.Inc(gwei.Int64())
+	}
+	if t.policy.BalanceFactor <= 0 {
+		return 0, nil
+	}
+	balance, _ := new(big.Float).Mul(new(big.Float).SetInt(gwei), big.NewFloat(t.policy.BalanceFactor)).Int64()
+	return balance, nil
+}
+
+// rejected accounts for a transaction rejected for an invalid gateway fee. It
+// returns errTooManyUnpaidTxs if the client exceeded the unpaid transactions of
+// the policy.
+func (t *gatewayFeeTracker) rejected(id enode.ID) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	s := t.stats(id)
+	s.Rejected++
+	t.total.Rejected++
+	gatewayFeeRejectedTxMeter.Mark(1)
+	if t.policy.MaxUnpaid != 0 && s.Unpaid+s.Rejected > t.policy.MaxUnpaid {
+		return errTooManyUnpaidTxs
+	}
+	return nil
+}
+
+// clientStats returns the statistics of the given clients, or of all the tracked
+// clients if none is given.
+func (t *gatewayFeeTracker) clientStats(ids []enode.ID) map[enode.ID]*GatewayFeeStats {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	res := make(map[enode.ID]*GatewayFeeStats)
+	if len(ids) == 0 {
+		for id, s := range t.clients {
+			res[id] = s.copy()
+		}
+		return res
+	}
+	for _, id := range ids {
+		if s := t.clients[id]; s != nil {
+			res[id] = s.copy()
+		}
+	}
+	return res
+}
+
+// totalStats returns the statistics of all the clients since the server started
+func (t *gatewayFeeTracker) totalStats() *GatewayFeeStats {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.total.copy()
+}
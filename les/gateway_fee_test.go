@@ -0,0 +1,145 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package les
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/mclock"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/p2p/enode"
+)
+
+func gatewayFeeTx(recipient *common.Address, feeCurrency *common.Address, fee *big.Int) *types.Transaction {
+	return types.NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), feeCurrency, recipient, fee, nil)
+}
+
+func TestGatewayFeeAccounting(t *testing.T) {
+	var (
+		tracker   = newGatewayFeeTracker(&mclock.Simulated{})
+		etherbase = common.HexToAddress("0x01")
+		other     = common.HexToAddress("0x02")
+		currency  = common.HexToAddress("0x03")
+		id        = enode.ID{0x01}
+	)
+	tracker.setPolicy(GatewayFeePolicy{BalanceFactor: 2})
+
+	// Paid in the native currency, credited as balance
+	balance, err := tracker.relayed(id, gatewayFeeTx(&etherbase, nil, big.NewInt(5e9)), etherbase)
+	if err != nil || balance != 10 {
+		t.Fatalf("paid tx: balance %d, err %v, want 10, nil", balance, err)
+	}
+	// Paid in another currency, accounted but not credited
+	balance, err = tracker.relayed(id, gatewayFeeTx(&etherbase, &currency, big.NewInt(7)), etherbase)
+	if err != nil || balance != 0 {
+		t.Fatalf("paid tx in fee currency: balance %d, err %v, want 0, nil", balance, err)
+	}
+	// Paying another recipient or no fee at all is unpaid
+	for _, tx := range []*types.Transaction{
+		gatewayFeeTx(&other, nil, big.NewInt(1e9)),
+		gatewayFeeTx(&etherbase, nil, big.NewInt(0)),
+		gatewayFeeTx(nil, nil, nil),
+	} {
+		if balance, err := tracker.relayed(id, tx, etherbase); err != nil || balance != 0 {
+			t.Fatalf("unpaid tx: balance %d, err %v, want 0, nil", balance, err)
+		}
+	}
+	if err := tracker.rejected(id); err != nil {
+		t.Fatalf("rejected tx: %v", err)
+	}
+
+	stats := tracker.clientStats([]enode.ID{id})[id]
+	if stats == nil {
+		t.Fatal("missing client stats")
+	}
+	if stats.Paid != 2 || stats.Unpaid != 3 || stats.Rejected != 1 {
+		t.Errorf("client stats mismatch: paid %d, unpaid %d, rejected %d, want 2, 3, 1", stats.Paid, stats.Unpaid, stats.Rejected)
+	}
+	if rev := stats.Revenue[common.ZeroAddress]; rev == nil || rev.Cmp(big.NewInt(5e9)) != 0 {
+		t.Errorf("native revenue mismatch: have %v, want %v", rev, 5e9)
+	}
+	if rev := stats.Revenue[currency]; rev == nil || rev.Cmp(big.NewInt(7)) != 0 {
+		t.Errorf("fee currency revenue mismatch: have %v, want %v", rev, 7)
+	}
+	total := tracker.totalStats()
+	if total.Paid != 2 || total.Unpaid != 3 || total.Rejected != 1 {
+		t.Errorf("total stats mismatch: paid %d, unpaid %d, rejected %d, want 2, 3, 1", total.Paid, total.Unpaid, total.Rejected)
+	}
+
+	// Returned stats must not alias the tracked ones
+	stats.Revenue[common.ZeroAddress].SetInt64(0)
+	if rev := tracker.totalStats().Revenue[common.ZeroAddress]; rev.Cmp(big.NewInt(5e9)) != 0 {
+		t.Errorf("total revenue modified through a copy: have %v", rev)
+	}
+}
+
+func TestGatewayFeeMaxUnpaid(t *testing.T) {
+	var (
+		tracker   = newGatewayFeeTracker(&mclock.Simulated{})
+		etherbase = common.HexToAddress("0x01")
+		id        = enode.ID{0x01}
+	)
+	tracker.setPolicy(GatewayFeePolicy{MaxUnpaid: 2})
+
+	if _, err := tracker.relayed(id, gatewayFeeTx(nil, nil, nil), etherbase); err != nil {
+		t.Fatalf("first unpaid tx: %v", err)
+	}
+	if err := tracker.rejected(id); err != nil {
+		t.Fatalf("first rejected tx: %v", err)
+	}
+	if _, err := tracker.relayed(id, gatewayFeeTx(nil, nil, nil), etherbase); err != errTooManyUnpaidTxs {
+		t.Fatalf("error mismatch: have %v, want %v", err, errTooManyUnpaidTxs)
+	}
+	// Other clients are not affected
+	if err := tracker.rejected(enode.ID{0x02}); err != nil {
+		t.Fatalf("rejected tx of other client: %v", err)
+	}
+}
+
+func TestGatewayFeeEviction(t *testing.T) {
+	var (
+		clock     = &mclock.Simulated{}
+		tracker   = newGatewayFeeTracker(clock)
+		etherbase = common.HexToAddress("0x01")
+	)
+	for i := 0; i < maxGatewayFeeClients; i++ {
+		var id enode.ID
+		id[0], id[1] = byte(i>>8), byte(i)
+		tracker.relayed(id, gatewayFeeTx(nil, nil, nil), etherbase)
+		clock.Run(time.Millisecond)
+	}
+	// Touch the oldest client so that the second one gets evicted
+	tracker.rejected(enode.ID{})
+	tracker.rejected(enode.ID{0xff, 0xff})
+
+	stats := tracker.clientStats(nil)
+	if len(stats) != maxGatewayFeeClients {
+		t.Fatalf("tracked clients mismatch: have %d, want %d", len(stats), maxGatewayFeeClients)
+	}
+	if _, ok := stats[enode.ID{}]; !ok {
+		t.Error("recently active client evicted")
+	}
+	if _, ok := stats[enode.ID{0x00, 0x01}]; ok {
+		t.Error("least recently active client not evicted")
+	}
+	if total := tracker.totalStats(); total.Unpaid != maxGatewayFeeClients || total.Rejected != 2 {
+		t.Errorf("total stats mismatch: unpaid %d, rejected %d", total.Unpaid, total.Rejected)
+	}
+}
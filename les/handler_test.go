@@ -20,16 +20,20 @@ import (
 	"encoding/binary"
 	"math/big"
 	"math/rand"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/mclock"
 	mockEngine "github.com/aaronwinter/celo-blockchain/consensus/consensustest"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/validator"
 	"github.com/aaronwinter/celo-blockchain/core"
 	"github.com/aaronwinter/celo-blockchain/core/rawdb"
 	"github.com/aaronwinter/celo-blockchain/core/types"
 	"github.com/aaronwinter/celo-blockchain/crypto"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
 	"github.com/aaronwinter/celo-blockchain/eth/downloader"
 	"github.com/aaronwinter/celo-blockchain/light"
 	"github.com/aaronwinter/celo-blockchain/p2p"
@@ -175,6 +179,91 @@ func testGetBlockHeaders(t *testing.T, protocol int) {
 	}
 }
 
+// Tests that the last header of each epoch can be retrieved from a remote chain.
+func TestGetEpochHeadersLes3(t *testing.T) { testGetEpochHeaders(t, 3) }
+
+func testGetEpochHeaders(t *testing.T, protocol int) {
+	epoch := params.IstanbulTestChainConfig.Istanbul.Epoch
+	server, tearDown := newServerEnv(t, int(3*epoch+15), protocol, nil, false, true, 0)
+	defer tearDown()
+
+	bc := server.handler.blockchain
+	tests := []struct {
+		query  *getEpochHeadersData // The query to execute for epoch header retrieval
+		expect []uint64             // The numbers of the blocks whose headers are expected
+	}{
+		// Consecutive epochs should be retrievable
+		{&getEpochHeadersData{Origin: epoch, Amount: 1}, []uint64{epoch}},
+		{&getEpochHeadersData{Origin: epoch, Amount: 3}, []uint64{epoch, 2 * epoch, 3 * epoch}},
+		// The response should stop at the chain head
+		{&getEpochHeadersData{Origin: 2 * epoch, Amount: 10}, []uint64{2 * epoch, 3 * epoch}},
+		// Validator set diffs are only served by istanbul engines
+		{&getEpochHeadersData{Origin: epoch, Amount: 2, Diffs: true}, []uint64{epoch, 2 * epoch}},
+		// Queries not starting at the end of an epoch should return nothing
+		{&getEpochHeadersData{Origin: epoch + 1, Amount: 1}, []uint64{}},
+		{&getEpochHeadersData{Origin: 0, Amount: 1}, []uint64{}},
+	}
+	var reqID uint64
+	for i, tt := range tests {
+		headers := []*epochHeader{}
+		for _, number := range tt.expect {
+			headers = append(headers, &epochHeader{Header: bc.GetHeaderByNumber(number)})
+		}
+		reqID++
+
+		sendRequest(server.peer.app, GetEpochHeadersMsg, reqID, tt.query)
+		if err := expectResponse(server.peer.app, EpochHeadersMsg, reqID, testBufLimit, headers); err != nil {
+			t.Errorf("test %d: epoch headers mismatch: %v", i, err)
+		}
+	}
+}
+
+// Tests that the validator set diff of an epoch header is resolved from its istanbul
+// extra data, and that diffs not matching the header are rejected.
+func TestEpochValidatorSetDiff(t *testing.T) {
+	var (
+		previous = []istanbul.Validator{
+			validator.New(common.Address{0x01}, blscrypto.SerializedPublicKey{0x01}),
+			validator.New(common.Address{0x02}, blscrypto.SerializedPublicKey{0x02}),
+			validator.New(common.Address{0x03}, blscrypto.SerializedPublicKey{0x03}),
+		}
+		extra = &types.IstanbulExtra{
+			AddedValidators:           []common.Address{{0x04}},
+			AddedValidatorsPublicKeys: []blscrypto.SerializedPublicKey{{0x04}},
+			RemovedValidators:         big.NewInt(2),
+			Seal:                      []byte{},
+		}
+	)
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatalf("failed to encode istanbul extra: %v", err)
+	}
+	header := &types.Header{Number: big.NewInt(100), Extra: append(make([]byte, types.IstanbulExtraVanity), payload...)}
+
+	diff, err := epochValidatorSetDiff(header, previous)
+	if err != nil {
+		t.Fatalf("failed to resolve validator set diff: %v", err)
+	}
+	want := &validatorSetDiff{
+		Added:   []istanbul.ValidatorData{{Address: common.Address{0x04}, BLSPublicKey: blscrypto.SerializedPublicKey{0x04}}},
+		Removed: []common.Address{{0x02}},
+	}
+	if !reflect.DeepEqual(diff, want) {
+		t.Fatalf("validator set diff mismatch: have %+v, want %+v", diff, want)
+	}
+	if err := (&epochHeader{Header: header, Diff: diff}).verifyDiff(); err != nil {
+		t.Errorf("valid diff rejected: %v", err)
+	}
+	added := &validatorSetDiff{Added: append(want.Added, istanbul.ValidatorData{Address: common.Address{0x05}}), Removed: want.Removed}
+	if err := (&epochHeader{Header: header, Diff: added}).verifyDiff(); err == nil {
+		t.Error("diff with an unexpected added validator accepted")
+	}
+	removed := &validatorSetDiff{Added: want.Added, Removed: []common.Address{}}
+	if err := (&epochHeader{Header: header, Diff: removed}).verifyDiff(); err == nil {
+		t.Error("diff with a missing removed validator accepted")
+	}
+}
+
 // Tests that block contents can be retrieved from a remote chain based on their hashes.
 func TestGetBlockBodiesLes2(t *testing.T) { testGetBlockBodies(t, 2) }
 func TestGetBlockBodiesLes3(t *testing.T) { testGetBlockBodies(t, 3) }
@@ -323,6 +323,7 @@ type serverPeer struct {
 	// Status fields
 	trusted                 bool   // The flag whether the server is selected as trusted server.
 	onlyAnnounce            bool   // The flag whether the server sends announcement only.
+	serveEpochHeaders       bool   // The flag whether the server serves epoch header requests.
 	chainSince, chainRecent uint64 // The range of chain server peer can serve.
 	stateSince, stateRecent uint64 // The range of state server peer can serve.
 
@@ -429,6 +430,13 @@ func (p *serverPeer) requestHeadersByNumber(reqID, origin uint64, amount int, sk
 	return p.sendRequest(GetBlockHeadersMsg, reqID, &getBlockHeadersData{Origin: hashOrNumber{Number: origin}, Amount: uint64(amount), Skip: uint64(skip), Reverse: reverse}, amount)
 }
 
+// requestEpochHeaders fetches a batch of epoch headers, the last block header of each
+// epoch starting from the one ending at origin, optionally with their validator set diffs.
+func (p *serverPeer) requestEpochHeaders(reqID, origin uint64, amount int, diffs bool) error {
+	p.Log().Debug("Fetching batch of epoch headers", "count", amount, "fromnum", origin, "diffs", diffs)
+	return p.sendRequest(GetEpochHeadersMsg, reqID, &getEpochHeadersData{Origin: origin, Amount: uint64(amount), Diffs: diffs}, amount)
+}
+
 // requestBodies fetches a batch of blocks' bodies corresponding to the hashes
 // specified.
 func (p *serverPeer) requestBodies(reqID uint64, hashes []common.Hash) error {
@@ -683,6 +691,7 @@ func (p *serverPeer) Handshake(td *big.Int, head common.Hash, headNum uint64, ge
 		if p.onlyAnnounce && !p.trusted {
 			return errResp(ErrUselessPeer, "peer cannot serve requests")
 		}
+		p.serveEpochHeaders = p.version >= lpv3 && !p.onlyAnnounce && recv.get("serveEpochHeaders", nil) == nil
 		// Parse flow control handshake packet.
 		var sParams flowcontrol.ServerParams
 		if err := recv.get("flowControl/BL", &sParams.BufLimit); err != nil {
@@ -704,6 +713,14 @@ func (p *serverPeer) Handshake(td *big.Int, head common.Hash, headNum uint64, ge
 
 		if !p.onlyAnnounce {
 			for msgCode := range reqAvgTimeCost {
+				// Requests introduced by later protocol versions are not expected
+				if msgCode >= ProtocolLengths[uint(p.version)] {
+					continue
+				}
+				// Epoch header requests are only expected from servers advertising them
+				if msgCode == GetEpochHeadersMsg && !p.serveEpochHeaders {
+					continue
+				}
 				if p.fcCosts[msgCode] == nil {
 					return errResp(ErrUselessPeer, "peer does not support message %d", msgCode)
 				}
@@ -916,6 +933,12 @@ func (p *clientPeer) replyBlockHeaders(reqID uint64, headers []*types.Header) *r
 	return &reply{p.rw, BlockHeadersMsg, reqID, data}
 }
 
+// replyEpochHeaders creates a reply with a batch of epoch headers
+func (p *clientPeer) replyEpochHeaders(reqID uint64, headers []*epochHeader) *reply {
+	data, _ := rlp.EncodeToBytes(headers)
+	return &reply{p.rw, EpochHeadersMsg, reqID, data}
+}
+
 // replyBlockBodiesRLP creates a reply with a batch of block contents from
 // an already RLP encoded format.
 func (p *clientPeer) replyBlockBodiesRLP(reqID uint64, bodies []rlp.RawValue) *reply {
@@ -1038,6 +1061,11 @@ func (p *clientPeer) Handshake(td *big.Int, head common.Hash, headNum uint64, ge
 			}
 			*lists = (*lists).add("serveRecentState", stateRecent)
 			*lists = (*lists).add("txRelay", nil)
+
+			// Celo: epoch headers are served to lightest sync clients by istanbul chains
+			if p.version >= lpv3 && server.chainConfig != nil && server.chainConfig.Istanbul != nil {
+				*lists = (*lists).add("serveEpochHeaders", nil)
+			}
 		}
 		*lists = (*lists).add("flowControl/BL", server.defParams.BufLimit)
 		*lists = (*lists).add("flowControl/MRR", server.defParams.MinRecharge)
@@ -24,7 +24,10 @@ import (
 	"math/big"
 
 	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/core/types"
 	"github.com/aaronwinter/celo-blockchain/crypto"
+	"github.com/aaronwinter/celo-blockchain/eth/downloader"
 	lpc "github.com/aaronwinter/celo-blockchain/les/lespay/client"
 	"github.com/aaronwinter/celo-blockchain/p2p/enode"
 	"github.com/aaronwinter/celo-blockchain/rlp"
@@ -45,7 +48,9 @@ var (
 )
 
 // Number of implemented message corresponding to different protocol versions.
-var ProtocolLengths = map[uint]uint64{lpv2: 24, lpv3: 26, lpv4: 28}
+// Celo: lpv3 also carries the epoch header messages, which are only requested from
+// servers advertising the serveEpochHeaders handshake key.
+var ProtocolLengths = map[uint]uint64{lpv2: 24, lpv3: 30, lpv4: 30}
 
 const (
 	NetworkId          = 1
@@ -80,8 +85,10 @@ const (
 	StopMsg   = 0x18
 	ResumeMsg = 0x19
 	// Protocol messages to be introduced in LPV4
-	GetGatewayFeeMsg = 0x1A
-	GatewayFeeMsg    = 0x1B
+	GetGatewayFeeMsg   = 0x1A
+	GatewayFeeMsg      = 0x1B
+	GetEpochHeadersMsg = 0x1C
+	EpochHeadersMsg    = 0x1D
 )
 
 type requestInfo struct {
@@ -111,6 +118,7 @@ var (
 		SendTxV2Msg:            {"SendTxV2", MaxTxSend, 1, 0},
 		GetTxStatusMsg:         {"GetTxStatus", MaxTxStatus, 10, 0},
 		GetEtherbaseMsg:        {"GetEtherbase", MaxEtherbase, 1, 0}, // TODO: revisit this as we as its costs in costtracker.go
+		GetEpochHeadersMsg:     {"GetEpochHeaders", uint64(downloader.MaxEpochHeaderFetch), 1, 100},
 	}
 	requestList    []lpc.RequestInfo
 	requestMapping map[uint32]reqMapping
@@ -243,6 +251,57 @@ type getBlockHeadersData struct {
 	Reverse bool         // Query direction (false = rising towards latest, true = falling towards genesis)
 }
 
+// getEpochHeadersData represents an epoch header query, for the last block of each
+// epoch starting from the one ending at Origin.
+type getEpochHeadersData struct {
+	Origin uint64 // Last block of the first epoch to retrieve
+	Amount uint64 // Maximum number of epoch headers to retrieve
+	Diffs  bool   // Whether to include the validator set diff of each epoch
+}
+
+// validatorSetDiff is the change made to the validator set by the election at the
+// end of an epoch.
+type validatorSetDiff struct {
+	Added   []istanbul.ValidatorData
+	Removed []common.Address
+}
+
+// epochHeader is the last block header of an epoch, along with its validator set
+// diff if it was requested.
+type epochHeader struct {
+	Header *types.Header
+	Diff   *validatorSetDiff `rlp:"nil"`
+}
+
+// verifyDiff checks that the validator set diff of an epoch header matches the
+// changes recorded in the istanbul extra data of the header.
+func (e *epochHeader) verifyDiff() error {
+	extra, err := types.ExtractIstanbulExtra(e.Header)
+	if err != nil {
+		return err
+	}
+	added, err := istanbul.CombineIstanbulExtraToValidatorData(extra.AddedValidators, extra.AddedValidatorsPublicKeys)
+	if err != nil {
+		return err
+	}
+	if len(e.Diff.Added) != len(added) {
+		return errors.New("added validators mismatch")
+	}
+	for i := range added {
+		if e.Diff.Added[i] != added[i] {
+			return errors.New("added validators mismatch")
+		}
+	}
+	removed := 0
+	for i := 0; i < extra.RemovedValidators.BitLen(); i++ {
+		removed += int(extra.RemovedValidators.Bit(i))
+	}
+	if len(e.Diff.Removed) != removed {
+		return errors.New("removed validators mismatch")
+	}
+	return nil
+}
+
 // hashOrNumber is a combined field for specifying an origin block.
 type hashOrNumber struct {
 	Hash   common.Hash // Block hash from which to retrieve headers (excludes Number)
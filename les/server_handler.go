@@ -28,10 +28,13 @@ import (
 
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/mclock"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	istanbulBackend "github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend"
 	"github.com/aaronwinter/celo-blockchain/core"
 	"github.com/aaronwinter/celo-blockchain/core/rawdb"
 	"github.com/aaronwinter/celo-blockchain/core/state"
 	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/eth/downloader"
 	"github.com/aaronwinter/celo-blockchain/ethdb"
 	"github.com/aaronwinter/celo-blockchain/light"
 	"github.com/aaronwinter/celo-blockchain/log"
@@ -76,8 +79,9 @@ type serverHandler struct {
 	synced  func() bool    // Callback function used to determine whether local node is synced.
 
 	// Celo Specific
-	etherbase  common.Address
-	gatewayFee *big.Int
+	etherbase   common.Address
+	gatewayFee  *big.Int
+	gatewayFees *gatewayFeeTracker
 
 	// Testing fields
 	addTxsSync bool
@@ -85,14 +89,15 @@ type serverHandler struct {
 
 func newServerHandler(server *LesServer, blockchain *core.BlockChain, chainDb ethdb.Database, txpool *core.TxPool, synced func() bool, etherbase common.Address, gatewayFee *big.Int) *serverHandler {
 	handler := &serverHandler{
-		server:     server,
-		blockchain: blockchain,
-		chainDb:    chainDb,
-		txpool:     txpool,
-		closeCh:    make(chan struct{}),
-		synced:     synced,
-		etherbase:  etherbase,
-		gatewayFee: gatewayFee,
+		server:      server,
+		blockchain:  blockchain,
+		chainDb:     chainDb,
+		txpool:      txpool,
+		closeCh:     make(chan struct{}),
+		synced:      synced,
+		etherbase:   etherbase,
+		gatewayFee:  gatewayFee,
+		gatewayFees: newGatewayFeeTracker(&mclock.System{}),
 	}
 	return handler
 }
@@ -786,6 +791,9 @@ func (h *serverHandler) handleMsg(p *clientPeer, wg *sync.WaitGroup) error {
 						if err := h.verifyGatewayFee(tx.GatewayFeeRecipient(), tx.GatewayFee()); err != nil {
 							p.Log().Trace("Rejected transaction from light peer for invalid gateway fee", "hash", hash.String(), "err", err)
 							stats[i].Error = err.Error()
+							if err := h.gatewayFees.rejected(p.ID()); err != nil {
+								h.dropUnpaidClient(p, err)
+							}
 							continue
 						}
 
@@ -800,6 +808,17 @@ func (h *serverHandler) handleMsg(p *clientPeer, wg *sync.WaitGroup) error {
 						}
 						stats[i] = h.txStatus(hash)
 						p.Log().Trace("Added transaction from light peer to pool", "hash", hash.String(), "tx", tx)
+
+						balance, err := h.gatewayFees.relayed(p.ID(), tx, h.etherbase)
+						if err != nil {
+							h.dropUnpaidClient(p, err)
+						}
+						if balance > 0 {
+							meta := h.server.clientPool.getPosBalance(p.ID()).meta
+							if _, _, err := h.server.clientPool.addBalance(p.ID(), balance, meta); err != nil {
+								p.Log().Debug("Failed to credit gateway fee balance", "err", err)
+							}
+						}
 					}
 				}
 				reply := p.replyTxStatus(req.ReqID, stats)
@@ -895,6 +914,43 @@ func (h *serverHandler) handleMsg(p *clientPeer, wg *sync.WaitGroup) error {
 			}()
 		}
 
+	case GetEpochHeadersMsg:
+		p.Log().Trace("Received epoch header request")
+		if metrics.EnabledExpensive {
+			miscInEpochHeaderPacketsMeter.Mark(1)
+			miscInEpochHeaderTrafficMeter.Mark(int64(msg.Size))
+		}
+		var req struct {
+			ReqID uint64
+			Query getEpochHeadersData
+		}
+		if err := msg.Decode(&req); err != nil {
+			clientErrorMeter.Mark(1)
+			return errResp(ErrDecode, "%v: %v", msg, err)
+		}
+		query := req.Query
+		if accept(req.ReqID, query.Amount, uint64(downloader.MaxEpochHeaderFetch)) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				headers, ok := h.getEpochHeaders(query, task)
+				if !ok {
+					sendResponse(req.ReqID, 0, nil, task.servingTime)
+					return
+				}
+				if len(headers) == 0 {
+					p.bumpInvalid()
+				}
+				reply := p.replyEpochHeaders(req.ReqID, headers)
+				sendResponse(req.ReqID, query.Amount, reply, task.done())
+				if metrics.EnabledExpensive {
+					miscOutEpochHeaderPacketsMeter.Mark(1)
+					miscOutEpochHeaderTrafficMeter.Mark(int64(reply.size()))
+					miscServingTimeEpochHeaderTimer.Update(time.Duration(task.servingTime))
+				}
+			}()
+		}
+
 	default:
 		p.Log().Trace("Received invalid message", "code", msg.Code)
 		clientErrorMeter.Mark(1)
@@ -909,6 +965,67 @@ func (h *serverHandler) handleMsg(p *clientPeer, wg *sync.WaitGroup) error {
 	return nil
 }
 
+// getEpochHeaders retrieves the last header of consecutive epochs, starting from the
+// epoch ending at the query origin, until the fetch or network limits are reached.
+// It returns false if the serving task was stopped.
+func (h *serverHandler) getEpochHeaders(query getEpochHeadersData, task *servingTask) ([]*epochHeader, bool) {
+	config := h.blockchain.Config()
+	if config.Istanbul == nil || query.Origin == 0 || !istanbul.IsLastBlockOfEpoch(query.Origin, config.Istanbul.Epoch) {
+		return nil, true
+	}
+	engine, _ := h.blockchain.Engine().(*istanbulBackend.Backend)
+	var (
+		epoch   = config.Istanbul.Epoch
+		bytes   common.StorageSize
+		headers []*epochHeader
+	)
+	for number := query.Origin; len(headers) < int(query.Amount) && bytes < softResponseLimit; number += epoch {
+		if len(headers) > 0 && !task.waitOrStop() {
+			return nil, false
+		}
+		header := h.blockchain.GetHeaderByNumber(number)
+		if header == nil {
+			break
+		}
+		entry := &epochHeader{Header: header}
+		if query.Diffs && engine != nil {
+			parent := h.blockchain.GetHeaderByNumber(number - epoch)
+			if parent == nil {
+				break
+			}
+			diff, err := epochValidatorSetDiff(header, engine.GetValidators(parent.Number, parent.Hash()))
+			if err != nil {
+				break
+			}
+			entry.Diff = diff
+		}
+		headers = append(headers, entry)
+		bytes += estHeaderRlpSize
+	}
+	return headers, true
+}
+
+// epochValidatorSetDiff returns the validator set diff recorded in the istanbul extra
+// data of an epoch header, resolving the removed validators against the validator set
+// of the epoch before.
+func epochValidatorSetDiff(header *types.Header, previous []istanbul.Validator) (*validatorSetDiff, error) {
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	added, err := istanbul.CombineIstanbulExtraToValidatorData(extra.AddedValidators, extra.AddedValidatorsPublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	diff := &validatorSetDiff{Added: added, Removed: []common.Address{}}
+	for i, v := range previous {
+		if extra.RemovedValidators.Bit(i) == 1 {
+			diff.Removed = append(diff.Removed, v.Address())
+		}
+	}
+	return diff, nil
+}
+
 // getAccount retrieves an account from the state based on root.
 func (h *serverHandler) getAccount(triedb *trie.Database, root, hash common.Hash) (state.Account, error) {
 	trie, err := trie.New(root, triedb)
@@ -1030,6 +1147,16 @@ func (h *serverHandler) broadcastHeaders() {
 	}
 }
 
+// dropUnpaidClient disconnects a client which exceeded the unpaid transactions of
+// the gateway fee policy.
+func (h *serverHandler) dropUnpaidClient(p *clientPeer, err error) {
+	p.Log().Debug("Dropping light client not paying the gateway fee", "err", err)
+	select {
+	case p.errCh <- errResp(ErrRequestRejected, "%v", err):
+	default:
+	}
+}
+
 func (h *serverHandler) verifyGatewayFee(gatewayFeeRecipient *common.Address, gatewayFee *big.Int) error {
 
 	// If this node does not specify an etherbase, accept any GatewayFeeRecipient.
@@ -219,3 +219,56 @@ func testMissOracleBackend(t *testing.T, hasCheckpoint bool) {
 		t.Error("checkpoint syncing timeout")
 	}
 }
+
+// Test lightest syncing which will download the last header of each epoch, through
+// epoch header requests if the server advertises serving them.
+func TestLightestSyncingLes2(t *testing.T) { testLightestSyncing(t, 2) }
+func TestLightestSyncingLes3(t *testing.T) { testLightestSyncing(t, 3) }
+
+func testLightestSyncing(t *testing.T, protocol int) {
+	epoch := params.IstanbulTestChainConfig.Istanbul.Epoch
+	server, client, tearDown := newClientServerEnv(t, downloader.LightestSync, int(3*epoch+15), protocol, nil, nil, 0, false, false, true)
+	defer tearDown()
+
+	expected := server.backend.Blockchain().CurrentHeader().Number.Uint64()
+
+	done := make(chan error)
+	client.handler.syncDone = func() {
+		header := client.handler.backend.blockchain.CurrentHeader()
+		if header.Number.Uint64() == expected {
+			done <- nil
+		} else {
+			done <- fmt.Errorf("blockchain length mismatch, want %d, got %d", expected, header.Number)
+		}
+	}
+
+	// Create connected peer pair.
+	peer1, peer2, err := newTestPeerPair("peer", protocol, server.handler, client.handler)
+	if err != nil {
+		t.Fatalf("Failed to connect testing peers %v", err)
+	}
+	defer peer1.close()
+	defer peer2.close()
+
+	if serve := peer2.speer.serveEpochHeaders; serve != (protocol >= lpv3) {
+		t.Errorf("epoch header serving mismatch, want %v, got %v", !serve, serve)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal("sync failed", err)
+		}
+	case <-time.NewTimer(10 * time.Second).C:
+		t.Fatal("lightest syncing timeout")
+	}
+	// Only the epoch headers should have been downloaded before the head
+	chain := client.handler.backend.blockchain
+	for number := epoch; number < expected; number += epoch {
+		if header := chain.GetHeaderByNumber(number); header == nil {
+			t.Errorf("epoch header %d missing", number)
+		}
+		if header := chain.GetHeaderByNumber(number + 1); header != nil {
+			t.Errorf("header %d within an epoch downloaded", number+1)
+		}
+	}
+}
@@ -435,6 +435,36 @@ func (lc *LightChain) InsertHeaderChain(chain []*types.Header, checkFreq int, co
 	return i, err
 }
 
+// InsertTrustedHeaders writes headers that were verified out of band, such as the
+// epoch headers of a verified epoch bundle, without validating them against the
+// chain. As the headers don't need to be contiguous, it is only supported by lightest
+// sync chains.
+func (lc *LightChain) InsertTrustedHeaders(headers []*types.Header) (int, error) {
+	if lc.hc.Config().FullHeaderChainAvailable {
+		return 0, errors.New("trusted headers require a lightest sync chain")
+	}
+	if len(headers) == 0 {
+		return 0, nil
+	}
+	lc.chainmu.Lock()
+	defer lc.chainmu.Unlock()
+
+	lc.wg.Add(1)
+	defer lc.wg.Done()
+	var events []interface{}
+	whFunc := func(header *types.Header) error {
+		status, err := lc.hc.WriteHeader(header)
+		if status == core.CanonStatTy {
+			log.Debug("Inserted trusted header", "number", header.Number, "hash", header.Hash())
+			events = append(events, core.ChainEvent{Block: types.NewBlockWithHeader(header), Hash: header.Hash()})
+		}
+		return err
+	}
+	i, err := lc.hc.InsertHeaderChain(headers, whFunc, time.Now())
+	lc.postChainEvents(events)
+	return i, err
+}
+
 // CurrentHeader retrieves the current head header of the canonical chain. The
 // header is retrieved from the HeaderChain's internal cache.
 func (lc *LightChain) CurrentHeader() *types.Header {
@@ -31,18 +31,21 @@ var (
 	typeSummaryTpl         = "# TYPE %s summary\n"
 	keyValueTpl            = "%s %v\n\n"
 	keyQuantileTagValueTpl = "%s {quantile=\"%s\"} %v\n"
+	keyValueLineTpl        = "%s %v\n"
 )
 
 // collector is a collection of byte buffers that aggregate Prometheus reports
 // for different metric types.
 type collector struct {
-	buff *bytes.Buffer
+	buff  *bytes.Buffer
+	typed map[string]bool // Metrics whose type was already reported
 }
 
 // newCollector creates a new Prometheus metric aggregator.
 func newCollector() *collector {
 	return &collector{
-		buff: &bytes.Buffer{},
+		buff:  &bytes.Buffer{},
+		typed: make(map[string]bool),
 	}
 }
 
@@ -62,7 +65,7 @@ func (c *collector) addHistogram(name string, m metrics.Histogram) {
 	pv := []float64{0.5, 0.75, 0.95, 0.99, 0.999, 0.9999}
 	ps := m.Percentiles(pv)
 	c.writeSummaryCounter(name, m.Count())
-	c.buff.WriteString(fmt.Sprintf(typeSummaryTpl, mutateKey(name)))
+	c.writeType(typeSummaryTpl, name)
 	for i := range pv {
 		c.writeSummaryPercentile(name, strconv.FormatFloat(pv[i], 'f', -1, 64), ps[i])
 	}
@@ -77,7 +80,7 @@ func (c *collector) addTimer(name string, m metrics.Timer) {
 	pv := []float64{0.5, 0.75, 0.95, 0.99, 0.999, 0.9999}
 	ps := m.Percentiles(pv)
 	c.writeSummaryCounter(name, m.Count())
-	c.buff.WriteString(fmt.Sprintf(typeSummaryTpl, mutateKey(name)))
+	c.writeType(typeSummaryTpl, name)
 	for i := range pv {
 		c.writeSummaryPercentile(name, strconv.FormatFloat(pv[i], 'f', -1, 64), ps[i])
 	}
@@ -91,30 +94,49 @@ func (c *collector) addResettingTimer(name string, m metrics.ResettingTimer) {
 	ps := m.Percentiles([]float64{50, 95, 99})
 	val := m.Values()
 	c.writeSummaryCounter(name, len(val))
-	c.buff.WriteString(fmt.Sprintf(typeSummaryTpl, mutateKey(name)))
+	c.writeType(typeSummaryTpl, name)
 	c.writeSummaryPercentile(name, "0.50", ps[0])
 	c.writeSummaryPercentile(name, "0.95", ps[1])
 	c.writeSummaryPercentile(name, "0.99", ps[2])
 	c.buff.WriteRune('\n')
 }
 
-func (c *collector) writeGaugeCounter(name string, value interface{}) {
+// writeType reports the type of a metric, only once for all its labeled series.
+func (c *collector) writeType(tpl string, name string) {
+	name, _ = metrics.SplitLabels(name)
 	name = mutateKey(name)
-	c.buff.WriteString(fmt.Sprintf(typeGaugeTpl, name))
-	c.buff.WriteString(fmt.Sprintf(keyValueTpl, name, value))
+	if c.typed[name] {
+		return
+	}
+	c.typed[name] = true
+	c.buff.WriteString(fmt.Sprintf(tpl, name))
+}
+
+func (c *collector) writeGaugeCounter(name string, value interface{}) {
+	c.writeType(typeGaugeTpl, name)
+	c.buff.WriteString(fmt.Sprintf(keyValueTpl, mutateKey(name), value))
 }
 
 func (c *collector) writeSummaryCounter(name string, value interface{}) {
-	name = mutateKey(name + "_count")
-	c.buff.WriteString(fmt.Sprintf(typeCounterTpl, name))
-	c.buff.WriteString(fmt.Sprintf(keyValueTpl, name, value))
+	name, labels := metrics.SplitLabels(name)
+	name = name + "_count"
+	c.writeType(typeCounterTpl, name)
+	c.buff.WriteString(fmt.Sprintf(keyValueTpl, mutateKey(name)+labels, value))
 }
 
 func (c *collector) writeSummaryPercentile(name, p string, value interface{}) {
-	name = mutateKey(name)
-	c.buff.WriteString(fmt.Sprintf(keyQuantileTagValueTpl, name, p, value))
+	name, labels := metrics.SplitLabels(name)
+	if labels == "" {
+		c.buff.WriteString(fmt.Sprintf(keyQuantileTagValueTpl, mutateKey(name), p, value))
+		return
+	}
+	labels = fmt.Sprintf("%s,quantile=\"%s\"}", labels[:len(labels)-1], p)
+	c.buff.WriteString(fmt.Sprintf(keyValueLineTpl, mutateKey(name)+labels, value))
 }
 
+// mutateKey converts a metric name to the Prometheus format, leaving the labels
+// untouched.
 func mutateKey(key string) string {
-	return strings.Replace(key, "/", "_", -1)
+	name, labels := metrics.SplitLabels(key)
+	return strings.Replace(name, "/", "_", -1) + labels
 }
@@ -108,3 +108,39 @@ test_resetting_timer {quantile="0.99"} 120000000
 		t.Fatal("unexpected collector output")
 	}
 }
+
+func TestCollectorLabels(t *testing.T) {
+	c := newCollector()
+
+	for i, reason := range []string{"commit", "timeout"} {
+		counter := metrics.NewCounter()
+		counter.Inc(int64(i + 1))
+		c.addCounter(metrics.LabeledName("test/round/changes", "reason", reason), counter)
+	}
+	histogram := metrics.NewHistogram(&metrics.NilSample{})
+	c.addHistogram(metrics.LabeledName("test/histogram", "phase", "prepared"), histogram)
+
+	const expectedOutput = `# TYPE test_round_changes gauge
+test_round_changes{reason="commit"} 1
+
+test_round_changes{reason="timeout"} 2
+
+# TYPE test_histogram_count counter
+test_histogram_count{phase="prepared"} 0
+
+# TYPE test_histogram summary
+test_histogram{phase="prepared",quantile="0.5"} 0
+test_histogram{phase="prepared",quantile="0.75"} 0
+test_histogram{phase="prepared",quantile="0.95"} 0
+test_histogram{phase="prepared",quantile="0.99"} 0
+test_histogram{phase="prepared",quantile="0.999"} 0
+test_histogram{phase="prepared",quantile="0.9999"} 0
+
+`
+	exp := c.buff.String()
+	if exp != expectedOutput {
+		t.Log("Expected Output:\n", expectedOutput)
+		t.Log("Actual Output:\n", exp)
+		t.Fatal("unexpected collector output")
+	}
+}
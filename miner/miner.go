@@ -18,6 +18,8 @@
 package miner
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/aaronwinter/celo-blockchain/common"
@@ -45,6 +47,8 @@ type Backend interface {
 type Config struct {
 	Validator common.Address `toml:",omitempty"` // Public address for block signing and randomness (default = first account)
 	ExtraData hexutil.Bytes  `toml:",omitempty"` // Block extra data set by the miner
+
+	InstantSeal bool `toml:",omitempty"` // Seal blocks only when transactions are pending or a block is requested (developer mode)
 }
 
 // Miner creates blocks and searches for proof-of-work values.
@@ -202,6 +206,19 @@ func (miner *Miner) SetExtra(extra []byte) error {
 	return nil
 }
 
+// SealBlock requests a block to be sealed on top of the current head, whether there are
+// pending transactions or not, and returns the number of the block. It is only available
+// in instant seal mode.
+func (miner *Miner) SealBlock(ctx context.Context) (uint64, error) {
+	if !miner.worker.config.InstantSeal {
+		return 0, errors.New("instant seal mode is disabled")
+	}
+	if !miner.Mining() {
+		return 0, errors.New("miner is not running")
+	}
+	return miner.worker.requestSeal(ctx)
+}
+
 // Pending returns the currently pending block and associated state.
 func (miner *Miner) Pending() (*types.Block, *state.StateDB) {
 	return miner.worker.pending()
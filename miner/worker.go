@@ -18,6 +18,7 @@ package miner
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -82,6 +83,7 @@ type worker struct {
 
 	// Channels
 	startCh chan struct{}
+	sealCh  chan chan uint64 // Requests to seal a block on demand in instant seal mode, answered with its number
 	exitCh  chan struct{}
 
 	mu             sync.RWMutex // The lock used to protect the validator, txFeeRecipient and extra fields
@@ -119,6 +121,7 @@ func newWorker(config *Config, chainConfig *params.ChainConfig, engine consensus
 		chainHeadCh:         make(chan core.ChainHeadEvent, chainHeadChanSize),
 		exitCh:              make(chan struct{}),
 		startCh:             make(chan struct{}, 1),
+		sealCh:              make(chan chan uint64),
 		db:                  db,
 		blockConstructGauge: metrics.NewRegisteredGauge("miner/worker/block_construct", nil),
 	}
@@ -227,6 +230,47 @@ func (w *worker) close() {
 	close(w.exitCh)
 }
 
+// requestSeal asks the main loop to generate and seal a block on top of the current head,
+// even if there are no pending transactions, and returns the number of the block being
+// generated. Only meaningful in instant seal mode.
+func (w *worker) requestSeal(ctx context.Context) (uint64, error) {
+	reply := make(chan uint64, 1)
+	select {
+	case w.sealCh <- reply:
+	case <-w.exitCh:
+		return 0, errors.New("miner is closed")
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	// The request is answered right away, or dropped if the worker isn't running
+	number, ok := <-reply
+	if !ok {
+		return 0, errors.New("miner is not running")
+	}
+	return number, nil
+}
+
+// hasPendingTxs returns whether the transaction pool has executable transactions that are
+// not included in the current head yet, as the pool may not have caught up with it.
+func (w *worker) hasPendingTxs() bool {
+	pending, err := w.eth.TxPool().Pending()
+	if err != nil {
+		log.Error("Failed to fetch pending transactions", "err", err)
+		return false
+	}
+	state, err := w.chain.State()
+	if err != nil {
+		log.Error("Failed to get the head state", "err", err)
+		return false
+	}
+	for addr, txs := range pending {
+		if len(txs) > 0 && txs[len(txs)-1].Nonce() >= state.GetNonce(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 // constructAndSubmitNewBlock constructs a new block and if the worker is running, submits
 // a task to the engine
 func (w *worker) constructAndSubmitNewBlock(ctx context.Context) {
@@ -379,12 +423,47 @@ func (w *worker) mainLoop() {
 		}
 	}
 
+	// In instant seal mode a block is only generated when there are pending transactions or
+	// when one is requested. sealing is set once a block has been submitted on top of the
+	// current head, so that transactions arriving meanwhile wait for the next head.
+	sealing := false
+	sealIfPending := func() {
+		if !sealing && w.hasPendingTxs() {
+			sealing = true
+			generateNewBlock()
+		}
+	}
+
 	for {
 		select {
 		case <-w.startCh:
-			generateNewBlock()
+			if w.config.InstantSeal {
+				sealing = false
+				sealIfPending()
+			} else {
+				generateNewBlock()
+			}
+
+		case ev := <-w.chainHeadCh:
+			if w.config.InstantSeal && w.isRunning() {
+				sealing = false
+				// Only keep sealing while transactions are being included, otherwise
+				// pending transactions that can't be executed would produce empty
+				// blocks forever. New transactions trigger sealing again.
+				if len(ev.Block.Transactions()) > 0 {
+					sealIfPending()
+				}
+			} else {
+				generateNewBlock()
+			}
 
-		case <-w.chainHeadCh:
+		case reply := <-w.sealCh:
+			if !w.isRunning() {
+				close(reply)
+				continue
+			}
+			sealing = true
+			reply <- w.chain.CurrentBlock().NumberU64() + 1
 			generateNewBlock()
 
 		case ev := <-w.txsCh:
@@ -396,6 +475,8 @@ func (w *worker) mainLoop() {
 				case txsCh <- ev:
 				default:
 				}
+			} else if w.config.InstantSeal {
+				sealIfPending()
 			}
 		// System stopped
 		case <-w.exitCh:
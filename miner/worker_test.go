@@ -17,6 +17,7 @@
 package miner
 
 import (
+	"context"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -403,3 +404,57 @@ func testNoTxChDeadlock(t *testing.T, validating bool) {
 		t.Error("Deadlock in mainLoop's select statement")
 	}
 }
+
+func TestInstantSeal(t *testing.T) {
+	chainConfig := params.IstanbulTestChainConfig
+	engine := mockEngine.NewFaker()
+	db := rawdb.NewMemoryDatabase()
+
+	backend := newTestWorkerBackend(t, chainConfig, engine, db, 0)
+	backend.txPool.AddLocals(pendingTxs)
+	w := newWorker(&Config{InstantSeal: true}, chainConfig, engine, backend, new(event.TypeMux), backend.db)
+	w.setTxFeeRecipient(testBankAddress)
+	w.setValidator(testBankAddress)
+	defer w.close()
+
+	headCh := make(chan core.ChainHeadEvent, 10)
+	sub := backend.chain.SubscribeChainHeadEvent(headCh)
+	defer sub.Unsubscribe()
+
+	expectBlock := func(txs int) {
+		t.Helper()
+		select {
+		case ev := <-headCh:
+			if have := len(ev.Block.Transactions()); have != txs {
+				t.Fatalf("block %d transactions mismatch: have %d, want %d", ev.Block.NumberU64(), have, txs)
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatal("timeout waiting for block")
+		}
+	}
+	expectNoBlock := func() {
+		t.Helper()
+		select {
+		case ev := <-headCh:
+			t.Fatalf("unexpected block %d", ev.Block.NumberU64())
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+
+	// Pending transactions are sealed right away, and then the worker idles
+	w.start()
+	expectBlock(1)
+	expectNoBlock()
+
+	// New transactions trigger a block
+	backend.txPool.AddLocal(backend.newRandomTx(false))
+	expectBlock(1)
+	expectNoBlock()
+
+	// Blocks can be requested without pending transactions
+	if number, err := w.requestSeal(context.Background()); err != nil || number != 3 {
+		t.Fatalf("seal request failed: number %d, err %v", number, err)
+	}
+	expectBlock(0)
+	expectNoBlock()
+}
@@ -19,10 +19,16 @@
 package geth
 
 import (
+	"fmt"
 	"math/big"
 
+	bind "github.com/aaronwinter/celo-blockchain/accounts/abi/bind_v2"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/contracts/bindings"
 	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/crypto"
 	"github.com/aaronwinter/celo-blockchain/ethclient"
+	"github.com/aaronwinter/celo-blockchain/params"
 )
 
 // EthereumClient provides access to the Ethereum APIs.
@@ -298,6 +304,13 @@ func (ec *EthereumClient) SuggestGasPrice(ctx *Context) (price *BigInt, _ error)
 	return &BigInt{rawPrice}, err
 }
 
+// SuggestGasPriceInCurrency retrieves the currently suggested gas price in the
+// given fee currency, nil meaning CELO.
+func (ec *EthereumClient) SuggestGasPriceInCurrency(ctx *Context, feeCurrency *Address) (price *BigInt, _ error) {
+	rawPrice, err := ec.client.SuggestGasPriceInCurrency(ctx.context, optionalAddress(feeCurrency))
+	return &BigInt{rawPrice}, err
+}
+
 // EstimateGas tries to estimate the gas needed to execute a specific transaction based on
 // the current pending state of the backend blockchain. There is no guarantee that this is
 // the true gas limit requirement as other transactions may be added or removed by miners,
@@ -314,3 +327,68 @@ func (ec *EthereumClient) EstimateGas(ctx *Context, msg *CallMsg) (gas int64, _
 func (ec *EthereumClient) SendTransaction(ctx *Context, tx *Transaction) error {
 	return ec.client.SendTransaction(ctx.context, tx.tx)
 }
+
+// Core Contracts
+
+// GetRegistryAddressAt returns the address of the core contract registered under
+// the given name, e.g. "StableToken". The block number can be <0, in which case
+// the registry is read at the latest known block.
+func (ec *EthereumClient) GetRegistryAddressAt(ctx *Context, contractName string, number int64) (address *Address, _ error) {
+	rawAddress, err := ec.registryAddressAt(ctx, crypto.Keccak256Hash([]byte(contractName)), number)
+	if err != nil {
+		return nil, err
+	}
+	return &Address{rawAddress}, nil
+}
+
+// GetStableTokenBalanceAt returns the Celo Dollar balance of the given account.
+// The block number can be <0, in which case the balance is taken from the latest
+// known block.
+func (ec *EthereumClient) GetStableTokenBalanceAt(ctx *Context, account *Address, number int64) (balance *BigInt, _ error) {
+	token, err := ec.registryAddressAt(ctx, params.StableTokenRegistryId, number)
+	if err != nil {
+		return nil, err
+	}
+	return ec.GetTokenBalanceAt(ctx, &Address{token}, account, number)
+}
+
+// GetTokenBalanceAt returns the balance of the given account in the given token,
+// e.g. a stable token or a fee currency. The block number can be <0, in which case
+// the balance is taken from the latest known block.
+func (ec *EthereumClient) GetTokenBalanceAt(ctx *Context, token *Address, account *Address, number int64) (balance *BigInt, _ error) {
+	contract, err := bindings.NewStableToken(token.address, ec.client)
+	if err != nil {
+		return nil, err
+	}
+	rawBalance, err := contract.BalanceOf(callOpts(ctx, number), account.address)
+	if err != nil {
+		return nil, err
+	}
+	return &BigInt{rawBalance}, nil
+}
+
+// registryAddressAt returns the address of the core contract registered under id.
+func (ec *EthereumClient) registryAddressAt(ctx *Context, id [32]byte, number int64) (common.Address, error) {
+	registry, err := bindings.NewRegistry(params.RegistrySmartContractAddress, ec.client)
+	if err != nil {
+		return common.Address{}, err
+	}
+	address, err := registry.GetAddressFor(callOpts(ctx, number), id)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if address == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("no contract registered for %x", id)
+	}
+	return address, nil
+}
+
+// callOpts returns the options of a contract call at the given block, the latest
+// known one if number is <0.
+func callOpts(ctx *Context, number int64) *bind.CallOpts {
+	opts := &bind.CallOpts{Context: ctx.context}
+	if number >= 0 {
+		opts.BlockNumber = big.NewInt(number)
+	}
+	return opts
+}
@@ -72,6 +72,47 @@ func (msg *CallMsg) SetTo(address *Address) {
 	msg.msg.To = &address.address
 }
 
+// GetFeeCurrency returns the token the fees are paid in, or nil for CELO.
+func (msg *CallMsg) GetFeeCurrency() *Address {
+	if feeCurrency := msg.msg.FeeCurrency; feeCurrency != nil {
+		return &Address{*feeCurrency}
+	}
+	return nil
+}
+
+// GetGatewayFeeRecipient returns the recipient of the gateway fee, or nil if no
+// gateway fee is paid.
+func (msg *CallMsg) GetGatewayFeeRecipient() *Address {
+	if recipient := msg.msg.GatewayFeeRecipient; recipient != nil {
+		return &Address{*recipient}
+	}
+	return nil
+}
+
+// GetGatewayFee returns the gateway fee, in the fee currency.
+func (msg *CallMsg) GetGatewayFee() *BigInt {
+	if fee := msg.msg.GatewayFee; fee != nil {
+		return &BigInt{fee}
+	}
+	return NewBigInt(0)
+}
+
+// SetFeeCurrency sets the token the fees are paid in, nil meaning CELO.
+func (msg *CallMsg) SetFeeCurrency(address *Address) {
+	msg.msg.FeeCurrency = optionalAddress(address)
+}
+
+// SetGatewayFeeRecipient sets the recipient of the gateway fee, nil meaning no
+// gateway fee is paid.
+func (msg *CallMsg) SetGatewayFeeRecipient(address *Address) {
+	msg.msg.GatewayFeeRecipient = optionalAddress(address)
+}
+
+// SetGatewayFee sets the gateway fee, in the fee currency.
+func (msg *CallMsg) SetGatewayFee(fee *BigInt) {
+	msg.msg.GatewayFee = optionalBigInt(fee)
+}
+
 // SyncProgress gives progress indications when the node is synchronising with
 // the Ethereum network.
 type SyncProgress struct {
@@ -121,6 +121,22 @@ type NodeConfig struct {
 	// See getSyncMode(syncMode int)
 	SyncMode int
 
+	// UltraLightServers is a comma separated list of the enode URLs of trusted light
+	// servers, whose announcements of new headers are accepted without verification.
+	UltraLightServers string
+
+	// UltraLightFraction is the percentage of the trusted light servers that must
+	// announce a header for it to be accepted. Zero means the default of 75%.
+	UltraLightFraction int
+
+	// LightestEpochBundle is the path of an epoch bundle to bootstrap lightest sync
+	// from, sparing the download of the epoch headers it contains.
+	LightestEpochBundle string
+
+	// LightestTrustedEpoch is the JSON encoded epoch lightest sync starts from,
+	// replacing the one hardcoded for the network. See params.TrustedEpoch.
+	LightestTrustedEpoch string
+
 	// UseLightweightKDF lowers the memory and CPU requirements of the key store
 	// scrypt KDF at the expense of security.
 	// See https://geth.ethereum.org/doc/Mobile_Account-management for reference
@@ -240,6 +256,24 @@ func NewNode(datadir string, config *NodeConfig) (stack *Node, _ error) {
 		ethConf.SyncMode = getSyncMode(config.SyncMode)
 		ethConf.NetworkId = uint64(config.EthereumNetworkID)
 		ethConf.DatabaseCache = config.EthereumDatabaseCache
+
+		// Configure the trusted light servers and the bootstrap of lightest sync
+		if config.UltraLightServers != "" {
+			ethConf.UltraLightServers = strings.Split(config.UltraLightServers, ",")
+		}
+		if config.UltraLightFraction < 0 || config.UltraLightFraction > 100 {
+			return nil, fmt.Errorf("invalid ultra light fraction: %d", config.UltraLightFraction)
+		}
+		if config.UltraLightFraction > 0 {
+			ethConf.UltraLightFraction = config.UltraLightFraction
+		}
+		ethConf.EpochBundle = config.LightestEpochBundle
+		if config.LightestTrustedEpoch != "" {
+			ethConf.TrustedEpoch = new(params.TrustedEpoch)
+			if err := json.Unmarshal([]byte(config.LightestTrustedEpoch), ethConf.TrustedEpoch); err != nil {
+				return nil, fmt.Errorf("invalid trusted epoch: %v", err)
+			}
+		}
 		// Use an in memory DB for replica state
 		ethConf.Istanbul.ReplicaStateDBPath = ""
 		// Use an in memory DB for validatorEnode table
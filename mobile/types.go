@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/core/types"
@@ -176,14 +177,44 @@ func NewContractCreation(nonce int64, amount *BigInt, gasLimit int64, gasPrice *
 	return &Transaction{types.NewContractCreation(uint64(nonce), amount.bigint, uint64(gasLimit), gasPrice.bigint, nil, nil, nil, common.CopyBytes(data))}
 }
 
-// NewTransaction creates a new transaction with the given properties.
+// NewTransaction creates a new transaction with the given properties. The fees
+// are paid in CELO if feeCurrency is nil, and no gateway fee is paid if
+// gatewayFeeRecipient is nil.
 func NewTransaction(nonce int64, to *Address, amount *BigInt, gasLimit int64, gasPrice *BigInt, feeCurrency, gatewayFeeRecipient *Address, gatewayFee *BigInt, data []byte) *Transaction {
-	convertedFeeCurrency := common.BytesToAddress(feeCurrency.GetBytes())
-	convertedGatewayFeeRecipient := common.BytesToAddress(gatewayFeeRecipient.GetBytes())
+	convertedFeeCurrency := optionalAddress(feeCurrency)
+	convertedGatewayFeeRecipient := optionalAddress(gatewayFeeRecipient)
+	convertedGatewayFee := optionalBigInt(gatewayFee)
 	if to == nil {
-		return &Transaction{types.NewContractCreation(uint64(nonce), amount.bigint, uint64(gasLimit), gasPrice.bigint, &convertedFeeCurrency, &convertedGatewayFeeRecipient, gatewayFee.bigint, common.CopyBytes(data))}
+		return &Transaction{types.NewContractCreation(uint64(nonce), amount.bigint, uint64(gasLimit), gasPrice.bigint, convertedFeeCurrency, convertedGatewayFeeRecipient, convertedGatewayFee, common.CopyBytes(data))}
 	}
-	return &Transaction{types.NewTransaction(uint64(nonce), to.address, amount.bigint, uint64(gasLimit), gasPrice.bigint, &convertedFeeCurrency, &convertedGatewayFeeRecipient, gatewayFee.bigint, common.CopyBytes(data))}
+	return &Transaction{types.NewTransaction(uint64(nonce), to.address, amount.bigint, uint64(gasLimit), gasPrice.bigint, convertedFeeCurrency, convertedGatewayFeeRecipient, convertedGatewayFee, common.CopyBytes(data))}
+}
+
+// NewTransactionEthCompatible creates a new transaction with the given properties,
+// encoded as an Ethereum transaction without the Celo specific fields. It's a
+// contract creation if to is nil.
+func NewTransactionEthCompatible(nonce int64, to *Address, amount *BigInt, gasLimit int64, gasPrice *BigInt, data []byte) *Transaction {
+	if to == nil {
+		return &Transaction{types.NewContractCreationEthCompatible(uint64(nonce), amount.bigint, uint64(gasLimit), gasPrice.bigint, common.CopyBytes(data))}
+	}
+	return &Transaction{types.NewTransactionEthCompatible(uint64(nonce), to.address, amount.bigint, uint64(gasLimit), gasPrice.bigint, common.CopyBytes(data))}
+}
+
+// optionalAddress converts an optional address of the mobile API.
+func optionalAddress(address *Address) *common.Address {
+	if address == nil {
+		return nil
+	}
+	converted := address.address
+	return &converted
+}
+
+// optionalBigInt converts an optional integer of the mobile API.
+func optionalBigInt(i *BigInt) *big.Int {
+	if i == nil {
+		return nil
+	}
+	return i.bigint
 }
 
 // NewTransactionFromRLP parses a transaction from an RLP data dump.
@@ -228,6 +259,35 @@ func (tx *Transaction) GetNonce() int64      { return int64(tx.tx.Nonce()) }
 func (tx *Transaction) GetHash() *Hash   { return &Hash{tx.tx.Hash()} }
 func (tx *Transaction) GetCost() *BigInt { return &BigInt{tx.tx.Cost()} }
 
+// GetFeeCurrency returns the token the fees are paid in, or nil for CELO.
+func (tx *Transaction) GetFeeCurrency() *Address {
+	if feeCurrency := tx.tx.FeeCurrency(); feeCurrency != nil {
+		return &Address{*feeCurrency}
+	}
+	return nil
+}
+
+// GetGatewayFeeRecipient returns the recipient of the gateway fee, or nil if no
+// gateway fee is paid.
+func (tx *Transaction) GetGatewayFeeRecipient() *Address {
+	if recipient := tx.tx.GatewayFeeRecipient(); recipient != nil {
+		return &Address{*recipient}
+	}
+	return nil
+}
+
+// GetGatewayFee returns the gateway fee, in the fee currency.
+func (tx *Transaction) GetGatewayFee() *BigInt {
+	if fee := tx.tx.GatewayFee(); fee != nil {
+		return &BigInt{fee}
+	}
+	return NewBigInt(0)
+}
+
+// IsEthCompatible returns whether the transaction is encoded as an Ethereum
+// transaction, without the Celo specific fields.
+func (tx *Transaction) IsEthCompatible() bool { return tx.tx.EthCompatible() }
+
 // Deprecated: GetSigHash cannot know which signer to use.
 func (tx *Transaction) GetSigHash() *Hash { return &Hash{types.HomesteadSigner{}.Hash(tx.tx)} }
 
@@ -0,0 +1,92 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package geth
+
+import (
+	"testing"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+)
+
+func TestTransactionCeloFields(t *testing.T) {
+	to := &Address{common.HexToAddress("0x01")}
+	feeCurrency := &Address{common.HexToAddress("0x02")}
+	recipient := &Address{common.HexToAddress("0x03")}
+
+	tx := NewTransaction(1, to, NewBigInt(10), 21000, NewBigInt(1), feeCurrency, recipient, NewBigInt(5), nil)
+	data, err := tx.EncodeRLP()
+	if err != nil {
+		t.Fatalf("failed to encode transaction: %v", err)
+	}
+	if tx, err = NewTransactionFromRLP(data); err != nil {
+		t.Fatalf("failed to decode transaction: %v", err)
+	}
+	if got := tx.GetFeeCurrency(); got == nil || got.GetHex() != feeCurrency.GetHex() {
+		t.Errorf("fee currency mismatch: have %v, want %v", got, feeCurrency)
+	}
+	if got := tx.GetGatewayFeeRecipient(); got == nil || got.GetHex() != recipient.GetHex() {
+		t.Errorf("gateway fee recipient mismatch: have %v, want %v", got, recipient)
+	}
+	if got := tx.GetGatewayFee(); got.GetInt64() != 5 {
+		t.Errorf("gateway fee mismatch: have %v, want 5", got)
+	}
+	if tx.IsEthCompatible() {
+		t.Error("Celo transaction reported as Ethereum compatible")
+	}
+
+	// Unset Celo fields are left out of the transaction
+	tx = NewTransaction(1, to, NewBigInt(10), 21000, NewBigInt(1), nil, nil, nil, nil)
+	if got := tx.GetFeeCurrency(); got != nil {
+		t.Errorf("fee currency mismatch: have %v, want nil", got)
+	}
+	if got := tx.GetGatewayFeeRecipient(); got != nil {
+		t.Errorf("gateway fee recipient mismatch: have %v, want nil", got)
+	}
+	if got := tx.GetGatewayFee(); got.GetInt64() != 0 {
+		t.Errorf("gateway fee mismatch: have %v, want 0", got)
+	}
+
+	tx = NewTransactionEthCompatible(1, to, NewBigInt(10), 21000, NewBigInt(1), nil)
+	if !tx.IsEthCompatible() {
+		t.Error("Ethereum transaction not reported as Ethereum compatible")
+	}
+	if tx = NewTransactionEthCompatible(1, nil, NewBigInt(10), 21000, NewBigInt(1), nil); tx.GetTo() != nil {
+		t.Error("contract creation has a recipient")
+	}
+}
+
+func TestCallMsgCeloFields(t *testing.T) {
+	msg := NewCallMsg()
+	if msg.GetFeeCurrency() != nil || msg.GetGatewayFeeRecipient() != nil || msg.GetGatewayFee().GetInt64() != 0 {
+		t.Fatal("Celo fields set on empty call message")
+	}
+	feeCurrency := &Address{common.HexToAddress("0x02")}
+	msg.SetFeeCurrency(feeCurrency)
+	msg.SetGatewayFeeRecipient(&Address{common.HexToAddress("0x03")})
+	msg.SetGatewayFee(NewBigInt(5))
+	if got := msg.GetFeeCurrency(); got == nil || got.GetHex() != feeCurrency.GetHex() {
+		t.Errorf("fee currency mismatch: have %v, want %v", got, feeCurrency)
+	}
+	if got := msg.GetGatewayFee(); got.GetInt64() != 5 {
+		t.Errorf("gateway fee mismatch: have %v, want 5", got)
+	}
+
+	msg.SetFeeCurrency(nil)
+	if got := msg.GetFeeCurrency(); got != nil {
+		t.Errorf("fee currency mismatch: have %v, want nil", got)
+	}
+}
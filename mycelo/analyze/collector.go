@@ -0,0 +1,199 @@
+// Package analyze follows a chain while it's under load, and reports on the throughput it sustained
+package analyze
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/aaronwinter/celo-blockchain"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/core"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/ethclient"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/mycelo/contract"
+	"github.com/aaronwinter/celo-blockchain/mycelo/env"
+	"github.com/aaronwinter/celo-blockchain/params"
+	"github.com/aaronwinter/celo-blockchain/rpc"
+)
+
+// BlockStats holds what was observed for a block when it became the head of the chain
+type BlockStats struct {
+	Number       uint64    `json:"number"`
+	Time         uint64    `json:"time"`       // Block timestamp
+	ReceivedAt   time.Time `json:"receivedAt"` // When the node notified the new head
+	Txs          int       `json:"txs"`
+	Submitted    int       `json:"submitted"` // Transactions of the block that were submitted through the collector
+	GasUsed      uint64    `json:"gasUsed"`
+	GasLimit     uint64    `json:"gasLimit"`
+	Round        uint64    `json:"round"`        // Round in which the block was committed
+	DesiredRound uint64    `json:"desiredRound"` // Desired round of the next sequence, from istanbul_getCurrentRoundState
+	PendingTxs   uint64    `json:"pendingTxs"`   // Tx pool pending transactions
+	QueuedTxs    uint64    `json:"queuedTxs"`    // Tx pool queued transactions
+}
+
+// Collector follows the new heads of a node, and correlates their transactions with the ones
+// submitted to the chain
+type Collector struct {
+	rpc    *rpc.Client
+	client *ethclient.Client
+
+	mu        sync.Mutex
+	start     time.Time
+	submitted map[common.Hash]time.Time
+	latencies []time.Duration
+	sentAt    []time.Time
+	blocks    []BlockStats
+	head      uint64
+	headCh    chan struct{} // Closed and replaced on every processed head
+}
+
+// NewCollector creates a collector for the node behind rpcClient
+func NewCollector(rpcClient *rpc.Client) *Collector {
+	return &Collector{
+		rpc:       rpcClient,
+		client:    ethclient.NewClient(rpcClient),
+		start:     time.Now(),
+		submitted: make(map[common.Hash]time.Time),
+		headCh:    make(chan struct{}),
+	}
+}
+
+// Submitted records that a transaction was sent at the given time
+func (c *Collector) Submitted(hash common.Hash, at time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.submitted[hash] = at
+	c.sentAt = append(c.sentAt, at)
+}
+
+// Run follows the chain until ctx is cancelled
+func (c *Collector) Run(ctx context.Context) error {
+	heads := make(chan *types.Header, 16)
+	sub, err := c.client.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	current, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.head = current.Number.Uint64()
+	c.mu.Unlock()
+
+	for {
+		select {
+		case header := <-heads:
+			receivedAt := time.Now()
+			// Heads might be skipped when the node imports several blocks at once
+			for number := c.head + 1; number <= header.Number.Uint64(); number++ {
+				if err := c.processBlock(ctx, number, receivedAt); err != nil {
+					log.Warn("Failed to process block", "number", number, "err", err)
+				}
+			}
+		case err := <-sub.Err():
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Sync waits until the collector has processed the node's current head
+func (c *Collector) Sync(ctx context.Context) error {
+	current, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for {
+		c.mu.Lock()
+		head, headCh := c.head, c.headCh
+		c.mu.Unlock()
+		if head >= current.Number.Uint64() {
+			return nil
+		}
+		select {
+		case <-headCh:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (c *Collector) processBlock(ctx context.Context, number uint64, receivedAt time.Time) error {
+	blockNumber := new(big.Int).SetUint64(number)
+	block, err := c.client.HeaderAndTxnHashesByNumber(ctx, blockNumber)
+	if err != nil {
+		return err
+	}
+	stats := BlockStats{
+		Number:     number,
+		Time:       block.Time,
+		ReceivedAt: receivedAt,
+		Txs:        len(block.Transactions),
+		GasUsed:    block.GasUsed,
+		GasLimit:   c.blockGasLimit(ctx, blockNumber),
+	}
+	if extra, err := types.ExtractIstanbulExtra(&block.Header); err == nil && extra.AggregatedSeal.Round != nil {
+		stats.Round = extra.AggregatedSeal.Round.Uint64()
+	}
+	var roundState core.RoundStateSummary
+	if err := c.rpc.CallContext(ctx, &roundState, "istanbul_getCurrentRoundState"); err == nil && roundState.DesiredRound != nil {
+		stats.DesiredRound = roundState.DesiredRound.Uint64()
+	}
+	var poolStatus map[string]hexutil.Uint
+	if err := c.rpc.CallContext(ctx, &poolStatus, "txpool_status"); err == nil {
+		stats.PendingTxs = uint64(poolStatus["pending"])
+		stats.QueuedTxs = uint64(poolStatus["queued"])
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, hash := range block.Transactions {
+		if sentAt, ok := c.submitted[hash]; ok {
+			stats.Submitted++
+			c.latencies = append(c.latencies, receivedAt.Sub(sentAt))
+			delete(c.submitted, hash)
+		}
+	}
+	c.blocks = append(c.blocks, stats)
+	c.head = number
+	close(c.headCh)
+	c.headCh = make(chan struct{})
+	return nil
+}
+
+// blockGasLimit reads the gas limit from BlockchainParameters, with the same fallback the node
+// uses when the contract can't be called
+func (c *Collector) blockGasLimit(ctx context.Context, number *big.Int) uint64 {
+	abi := contract.AbiFor("BlockchainParameters")
+	input, err := abi.Pack("blockGasLimit")
+	if err != nil {
+		return params.DefaultGasLimit
+	}
+	address := env.MustProxyAddressFor("BlockchainParameters")
+	output, err := c.client.CallContract(ctx, ethereum.CallMsg{To: &address, Data: input}, number)
+	if err != nil {
+		return params.DefaultGasLimit
+	}
+	var gasLimit *big.Int
+	if err := abi.Unpack(&gasLimit, "blockGasLimit", output); err != nil || gasLimit == nil {
+		return params.DefaultGasLimit
+	}
+	return gasLimit.Uint64()
+}
+
+// Report summarizes what was observed so far, with the timeline divided in intervals
+func (c *Collector) Report(interval time.Duration) *Report {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	blocks := make([]BlockStats, len(c.blocks))
+	copy(blocks, c.blocks)
+	return newReport(c.start, time.Now(), interval, c.sentAt, c.latencies, len(c.submitted), blocks)
+}
@@ -0,0 +1,217 @@
+package analyze
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Interval is a slice of the run timeline
+type Interval struct {
+	Offset       time.Duration `json:"offset"` // Since the start of the run
+	Submitted    int           `json:"submitted"`
+	Included     int           `json:"included"` // All transactions of the blocks received during the interval
+	SubmittedTPS float64       `json:"submittedTps"`
+	IncludedTPS  float64       `json:"includedTps"`
+	GasUsed      uint64        `json:"gasUsed"`
+	GasLimit     uint64        `json:"gasLimit"`
+}
+
+// Latencies are the time-to-inclusion percentiles of the submitted transactions
+type Latencies struct {
+	P50 time.Duration `json:"p50"`
+	P90 time.Duration `json:"p90"`
+	P99 time.Duration `json:"p99"`
+	Max time.Duration `json:"max"`
+}
+
+// Report is the throughput analysis of a run
+type Report struct {
+	Duration     time.Duration `json:"duration"`
+	Submitted    int           `json:"submitted"`
+	Included     int           `json:"included"`    // Submitted transactions that were included
+	NotIncluded  int           `json:"notIncluded"` // Submitted transactions not seen on any block
+	Latencies    Latencies     `json:"latencies"`
+	RoundChanges uint64        `json:"roundChanges"` // Sum of the rounds in which blocks were committed
+	Timeline     []Interval    `json:"timeline"`
+	Blocks       []BlockStats  `json:"blocks"`
+}
+
+func newReport(start, end time.Time, interval time.Duration, sentAt []time.Time, latencies []time.Duration, notIncluded int, blocks []BlockStats) *Report {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	r := &Report{
+		Duration:    end.Sub(start),
+		Submitted:   len(sentAt),
+		Included:    len(latencies),
+		NotIncluded: notIncluded,
+		Latencies:   percentiles(latencies),
+		Blocks:      blocks,
+	}
+
+	r.Timeline = make([]Interval, int(r.Duration/interval)+1)
+	for i := range r.Timeline {
+		r.Timeline[i].Offset = time.Duration(i) * interval
+	}
+	bucket := func(t time.Time) *Interval {
+		idx := int(t.Sub(start) / interval)
+		if idx < 0 {
+			idx = 0
+		} else if idx >= len(r.Timeline) {
+			idx = len(r.Timeline) - 1
+		}
+		return &r.Timeline[idx]
+	}
+	for _, t := range sentAt {
+		bucket(t).Submitted++
+	}
+	for _, block := range blocks {
+		i := bucket(block.ReceivedAt)
+		i.Included += block.Txs
+		i.GasUsed += block.GasUsed
+		i.GasLimit += block.GasLimit
+		r.RoundChanges += block.Round
+	}
+	for i := range r.Timeline {
+		r.Timeline[i].SubmittedTPS = float64(r.Timeline[i].Submitted) / interval.Seconds()
+		r.Timeline[i].IncludedTPS = float64(r.Timeline[i].Included) / interval.Seconds()
+	}
+	return r
+}
+
+func percentiles(latencies []time.Duration) Latencies {
+	if len(latencies) == 0 {
+		return Latencies{}
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	// Nearest rank
+	at := func(p float64) time.Duration {
+		rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+		if rank < 1 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	}
+	return Latencies{P50: at(50), P90: at(90), P99: at(99), Max: sorted[len(sorted)-1]}
+}
+
+// Write prints a summary of the report
+func (r *Report) Write(w io.Writer) {
+	var txs int
+	var gasUsed, gasLimit uint64
+	for _, block := range r.Blocks {
+		txs += block.Txs
+		gasUsed += block.GasUsed
+		gasLimit += block.GasLimit
+	}
+	seconds := r.Duration.Seconds()
+	if seconds == 0 {
+		seconds = 1
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Duration:\t%v\n", r.Duration.Round(time.Second))
+	fmt.Fprintf(tw, "Blocks:\t%d\n", len(r.Blocks))
+	fmt.Fprintf(tw, "Round changes:\t%d\n", r.RoundChanges)
+	fmt.Fprintf(tw, "Block transactions:\t%d (%.2f tps)\n", txs, float64(txs)/seconds)
+	if gasLimit > 0 {
+		fmt.Fprintf(tw, "Gas used:\t%d (%.1f%% of the gas limit)\n", gasUsed, 100*float64(gasUsed)/float64(gasLimit))
+	}
+	if r.Submitted > 0 {
+		fmt.Fprintf(tw, "Submitted:\t%d (%.2f tps)\n", r.Submitted, float64(r.Submitted)/seconds)
+		fmt.Fprintf(tw, "Included:\t%d (%.1f%%)\n", r.Included, 100*float64(r.Included)/float64(r.Submitted))
+		fmt.Fprintf(tw, "Time to inclusion:\tp50 %v  p90 %v  p99 %v  max %v\n", r.Latencies.P50.Round(time.Millisecond),
+			r.Latencies.P90.Round(time.Millisecond), r.Latencies.P99.Round(time.Millisecond), r.Latencies.Max.Round(time.Millisecond))
+	}
+	tw.Flush()
+}
+
+// Export writes the report to a file. A .json file gets the whole report, while for a .csv file
+// the blocks are written to it and the timeline to a sibling file with a "-timeline" suffix.
+func (r *Report) Export(path string) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return writeFile(path, r.WriteJSON)
+	case ".csv":
+		if err := writeFile(path, r.WriteBlocksCSV); err != nil {
+			return err
+		}
+		timelinePath := strings.TrimSuffix(path, filepath.Ext(path)) + "-timeline" + filepath.Ext(path)
+		return writeFile(timelinePath, r.WriteTimelineCSV)
+	default:
+		return fmt.Errorf("unsupported report format %q, use .json or .csv", filepath.Ext(path))
+	}
+}
+
+func writeFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// WriteJSON writes the whole report as json
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteBlocksCSV writes a row per block
+func (r *Report) WriteBlocksCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"number", "time", "receivedAt", "txs", "submitted", "gasUsed", "gasLimit", "round", "desiredRound", "pendingTxs", "queuedTxs"})
+	for _, b := range r.Blocks {
+		cw.Write([]string{
+			strconv.FormatUint(b.Number, 10),
+			strconv.FormatUint(b.Time, 10),
+			b.ReceivedAt.Format(time.RFC3339Nano),
+			strconv.Itoa(b.Txs),
+			strconv.Itoa(b.Submitted),
+			strconv.FormatUint(b.GasUsed, 10),
+			strconv.FormatUint(b.GasLimit, 10),
+			strconv.FormatUint(b.Round, 10),
+			strconv.FormatUint(b.DesiredRound, 10),
+			strconv.FormatUint(b.PendingTxs, 10),
+			strconv.FormatUint(b.QueuedTxs, 10),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteTimelineCSV writes a row per interval of the timeline
+func (r *Report) WriteTimelineCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"offset", "submitted", "included", "submittedTps", "includedTps", "gasUsed", "gasLimit"})
+	for _, i := range r.Timeline {
+		cw.Write([]string{
+			strconv.FormatFloat(i.Offset.Seconds(), 'f', -1, 64),
+			strconv.Itoa(i.Submitted),
+			strconv.Itoa(i.Included),
+			strconv.FormatFloat(i.SubmittedTPS, 'f', 2, 64),
+			strconv.FormatFloat(i.IncludedTPS, 'f', 2, 64),
+			strconv.FormatUint(i.GasUsed, 10),
+			strconv.FormatUint(i.GasLimit, 10),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,65 @@
+package analyze
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewReport(t *testing.T) {
+	start := time.Unix(1000, 0)
+	sentAt := []time.Time{start, start.Add(500 * time.Millisecond), start.Add(1500 * time.Millisecond)}
+	latencies := []time.Duration{time.Second, 2 * time.Second}
+	blocks := []BlockStats{
+		{Number: 1, ReceivedAt: start.Add(1200 * time.Millisecond), Txs: 2, GasUsed: 100, GasLimit: 1000},
+		{Number: 2, ReceivedAt: start.Add(2500 * time.Millisecond), Txs: 4, GasUsed: 300, GasLimit: 1000, Round: 1},
+	}
+
+	r := newReport(start, start.Add(3*time.Second), time.Second, sentAt, latencies, 1, blocks)
+
+	if r.Submitted != 3 || r.Included != 2 || r.NotIncluded != 1 {
+		t.Errorf("unexpected totals: submitted %d included %d not included %d", r.Submitted, r.Included, r.NotIncluded)
+	}
+	if r.RoundChanges != 1 {
+		t.Errorf("round changes = %d, want 1", r.RoundChanges)
+	}
+	if r.Latencies.P50 != time.Second || r.Latencies.Max != 2*time.Second {
+		t.Errorf("unexpected latencies %+v", r.Latencies)
+	}
+	if len(r.Timeline) != 4 {
+		t.Fatalf("timeline has %d intervals, want 4", len(r.Timeline))
+	}
+	expected := []struct{ submitted, included int }{{2, 0}, {1, 2}, {0, 4}, {0, 0}}
+	for i, e := range expected {
+		if r.Timeline[i].Submitted != e.submitted || r.Timeline[i].Included != e.included {
+			t.Errorf("interval %d: submitted %d included %d, want %d %d", i, r.Timeline[i].Submitted, r.Timeline[i].Included, e.submitted, e.included)
+		}
+	}
+	if r.Timeline[2].IncludedTPS != 4 {
+		t.Errorf("interval 2 included tps = %v, want 4", r.Timeline[2].IncludedTPS)
+	}
+}
+
+func TestReportCSV(t *testing.T) {
+	start := time.Unix(1000, 0)
+	r := newReport(start, start.Add(time.Second), time.Second, nil, nil, 0, []BlockStats{{Number: 7, ReceivedAt: start, Txs: 3}})
+
+	var blocks bytes.Buffer
+	if err := r.WriteBlocksCSV(&blocks); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(blocks.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[1], "7,") {
+		t.Errorf("unexpected blocks csv:\n%s", blocks.String())
+	}
+
+	var timeline bytes.Buffer
+	if err := r.WriteTimelineCSV(&timeline); err != nil {
+		t.Fatal(err)
+	}
+	lines = strings.Split(strings.TrimSpace(timeline.String()), "\n")
+	if len(lines) != 3 || lines[1] != "0,0,3,0.00,3.00,0,0" {
+		t.Errorf("unexpected timeline csv:\n%s", timeline.String())
+	}
+}
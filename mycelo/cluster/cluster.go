@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
 	"github.com/aaronwinter/celo-blockchain/mycelo/env"
+	"github.com/aaronwinter/celo-blockchain/mycelo/fork"
 	"github.com/aaronwinter/celo-blockchain/mycelo/internal/console"
+	"github.com/aaronwinter/celo-blockchain/params"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -36,30 +39,65 @@ func New(env *env.Environment, cfg Config) *Cluster {
 // This implies running `geth init` but also
 // configuring static nodes and node accounts
 func (cl *Cluster) Init() error {
-	var err error
-
 	nodes := cl.ensureNodes()
-	enodeUrls := make([]string, len(nodes))
 	console.Info("Initializing validator nodes")
 	for i, node := range nodes {
 		console.Infof("validator-%d> geth init", i)
 		if err := node.Init(cl.env.GenesisPath()); err != nil {
 			return err
 		}
+	}
 
-		enodeUrls[i], err = node.EnodeURL()
+	return cl.connectNodes()
+}
+
+// InitFork will initialize the nodes on top of a copy of the chain database located at chaindata,
+// replacing the chain's validators by the environment's ones from the fork block onwards.
+// It returns the config of the forked chain.
+func (cl *Cluster) InitFork(chaindata string, forkConfig fork.Config) (*params.ChainConfig, error) {
+	nodes := cl.ensureNodes()
+
+	forkConfig.Validators = make([]istanbul.ValidatorData, len(nodes))
+	for i, node := range nodes {
+		blsPublicKey, err := node.Account.BLSPublicKey()
 		if err != nil {
+			return nil, err
+		}
+		forkConfig.Validators[i] = istanbul.ValidatorData{
+			Address:      node.Account.Address,
+			BLSPublicKey: blsPublicKey,
+		}
+	}
+
+	console.Infof("Forking chain at block %d", forkConfig.Block)
+	var chainConfig *params.ChainConfig
+	for i, node := range nodes {
+		console.Infof("validator-%d> copying chain database", i)
+		var err error
+		if chainConfig, err = node.InitFork(chaindata, &forkConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	return chainConfig, cl.connectNodes()
+}
+
+// connectNodes connects each validator to each other
+func (cl *Cluster) connectNodes() error {
+	nodes := cl.ensureNodes()
+	enodeUrls := make([]string, len(nodes))
+	for i, node := range nodes {
+		var err error
+		if enodeUrls[i], err = node.EnodeURL(); err != nil {
 			return err
 		}
 	}
 
-	// Connect each validator to each other
 	for i, node := range nodes {
 		var urls []string
 		urls = append(urls, enodeUrls[:i]...)
 		urls = append(urls, enodeUrls[i+1:]...)
-		err = node.SetStaticNodes(urls...)
-		if err != nil {
+		if err := node.SetStaticNodes(urls...); err != nil {
 			return err
 		}
 	}
@@ -14,10 +14,13 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
 	"github.com/aaronwinter/celo-blockchain/crypto"
 	"github.com/aaronwinter/celo-blockchain/internal/fileutils"
 	"github.com/aaronwinter/celo-blockchain/mycelo/env"
+	"github.com/aaronwinter/celo-blockchain/mycelo/fork"
 	"github.com/aaronwinter/celo-blockchain/p2p/enode"
+	"github.com/aaronwinter/celo-blockchain/params"
 
 	"github.com/aaronwinter/celo-blockchain/accounts/keystore"
 	"github.com/aaronwinter/celo-blockchain/common"
@@ -97,13 +100,7 @@ func (n *Node) AccountAddresses() []common.Address {
 // Init will run `geth init` on the node along other initialization procedures
 // that need to happen before we run the node
 func (n *Node) Init(GenesisJSON string) error {
-	if fileutils.FileExists(n.Datadir) {
-		os.RemoveAll(n.Datadir)
-	}
-	os.MkdirAll(n.Datadir, os.ModePerm)
-
-	// Write password file
-	if err := ioutil.WriteFile(n.pwdFile(), []byte{}, os.ModePerm); err != nil {
+	if err := n.resetDatadir(); err != nil {
 		return err
 	}
 
@@ -113,6 +110,47 @@ func (n *Node) Init(GenesisJSON string) error {
 		return err
 	}
 
+	return n.setupKeys()
+}
+
+// InitFork will initialize the node with a copy of the chain database located at chaindata,
+// turned into a local fork as specified by forkConfig
+func (n *Node) InitFork(chaindata string, forkConfig *fork.Config) (*params.ChainConfig, error) {
+	if err := n.resetDatadir(); err != nil {
+		return nil, err
+	}
+
+	if err := fileutils.CopyDir(chaindata, n.chaindataDir()); err != nil {
+		return nil, fmt.Errorf("Can't copy chain database: %w", err)
+	}
+	db, err := rawdb.NewLevelDBDatabaseWithFreezer(n.chaindataDir(), 256, 256, path.Join(n.chaindataDir(), "ancient"), "")
+	if err != nil {
+		return nil, err
+	}
+	chainConfig, err := fork.Apply(db, forkConfig)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := db.Close(); err != nil {
+		return nil, err
+	}
+
+	return chainConfig, n.setupKeys()
+}
+
+func (n *Node) resetDatadir() error {
+	if fileutils.FileExists(n.Datadir) {
+		os.RemoveAll(n.Datadir)
+	}
+	os.MkdirAll(n.Datadir, os.ModePerm)
+
+	// Write password file
+	return ioutil.WriteFile(n.pwdFile(), []byte{}, os.ModePerm)
+}
+
+// setupKeys generates the node key and adds the node accounts to its keystore
+func (n *Node) setupKeys() error {
 	// Generate nodekey file (enode private key)
 	if err := n.generateNodeKey(); err != nil {
 		return err
@@ -224,6 +262,7 @@ func (n *Node) Run(ctx context.Context) error {
 func (n *Node) pwdFile() string         { return path.Join(n.Datadir, "password") }
 func (n *Node) logFile() string         { return path.Join(n.Datadir, "geth.log") }
 func (n *Node) keyFile() string         { return path.Join(n.Datadir, "celo/nodekey") }
+func (n *Node) chaindataDir() string    { return path.Join(n.Datadir, "celo/chaindata") }
 func (n *Node) staticNodesFile() string { return path.Join(n.Datadir, "/celo/static-nodes.json") }
 
 func (n *Node) runSync(args ...string) ([]byte, error) {
@@ -115,3 +115,24 @@ func (ecb *EVMBackend) Query(returnValue interface{}, method string, args ...int
 
 	return gasLeft, err
 }
+
+// QueryValues makes an evm call and returns the unpacked return values
+func (ecb *EVMBackend) QueryValues(method string, args ...interface{}) ([]interface{}, error) {
+	calldata, err := ecb.abi.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeCfg := *ecb.runtimeConfigTemplate
+	ret, _, err := runtime.Call(ecb.Address, calldata, &runtimeCfg)
+	if err != nil {
+		// try unpacking the revert (if it is one)
+		revertReason, err2 := abi.UnpackRevert(ret)
+		if err2 == nil {
+			return nil, fmt.Errorf("Revert: %s", revertReason)
+		}
+		return nil, err
+	}
+
+	return ecb.abi.Methods[method].Outputs.UnpackValues(ret)
+}
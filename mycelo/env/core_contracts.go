@@ -2,6 +2,8 @@ package env
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aaronwinter/celo-blockchain/common"
 )
@@ -92,6 +94,18 @@ var libraries = []string{
 	"Signatures",
 }
 
+// CoreContracts returns the names of all proxied core contracts, sorted
+func CoreContracts() []string {
+	var names []string
+	for name := range genesisAddresses {
+		if strings.HasSuffix(name, "Proxy") {
+			names = append(names, strings.TrimSuffix(name, "Proxy"))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Libraries returns all celo-blockchain library names
 func Libraries() []string { return libraries }
 
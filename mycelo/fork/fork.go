@@ -0,0 +1,96 @@
+package fork
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/state"
+	"github.com/aaronwinter/celo-blockchain/ethdb"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/params"
+)
+
+var (
+	errNoGenesis       = errors.New("chain database has no genesis block")
+	errNotIstanbulDB   = errors.New("chain database does not belong to an istanbul chain")
+	errMissingForkBase = errors.New("fork block not found in chain database")
+)
+
+// Config represents the parameters of a local fork
+type Config struct {
+	Block         uint64                   // Block of the original chain on top of which local blocks are produced
+	Validators    []istanbul.ValidatorData // Local validators that replace the original validator set
+	Impersonation bool                     // Whether eth_sendTransaction can be used on behalf of any account
+}
+
+// Apply turns the chain stored in db into a local fork:
+//   - the chain is rewound to cfg.Block
+//   - the istanbul snapshot used to verify the blocks after cfg.Block is replaced by cfg.Validators
+//   - the chain config is marked as a local fork, so the engine keeps the local validators on
+//     epoch changes and accepts the original validators' parent seal on the first local block
+//
+// The state of cfg.Block must be present on the database, which usually means forking from
+// a recent block or from an archive node's database.
+func Apply(db ethdb.Database, cfg *Config) (*params.ChainConfig, error) {
+	genesisHash := rawdb.ReadCanonicalHash(db, 0)
+	if genesisHash == (common.Hash{}) {
+		return nil, errNoGenesis
+	}
+	chainConfig := rawdb.ReadChainConfig(db, genesisHash)
+	if chainConfig == nil || chainConfig.Istanbul == nil {
+		return nil, errNotIstanbulDB
+	}
+	if len(cfg.Validators) == 0 {
+		return nil, errors.New("a local fork needs at least one validator")
+	}
+
+	hash := rawdb.ReadCanonicalHash(db, cfg.Block)
+	header := rawdb.ReadHeader(db, hash, cfg.Block)
+	if header == nil {
+		return nil, errMissingForkBase
+	}
+	if _, err := state.New(header.Root, state.NewDatabase(db), nil); err != nil {
+		return nil, fmt.Errorf("state for block %d is not available: %w", cfg.Block, err)
+	}
+
+	if err := rewind(db, cfg.Block, hash); err != nil {
+		return nil, err
+	}
+	if err := backend.WriteLocalForkSnapshot(db, chainConfig.Istanbul.Epoch, cfg.Block, cfg.Validators); err != nil {
+		return nil, err
+	}
+
+	chainConfig.LocalFork = &params.LocalForkConfig{
+		Block:         new(big.Int).SetUint64(cfg.Block + 1),
+		Impersonation: cfg.Impersonation,
+	}
+	rawdb.WriteChainConfig(db, genesisHash, chainConfig)
+
+	log.Info("Forked chain", "number", cfg.Block, "hash", hash, "validators", len(cfg.Validators))
+	return chainConfig, nil
+}
+
+// rewind makes the block (number, hash) the head of the chain, dropping every
+// canonical block after it.
+func rewind(db ethdb.Database, number uint64, hash common.Hash) error {
+	if frozen, err := db.Ancients(); err == nil && frozen > number+1 {
+		if err := db.TruncateAncients(number + 1); err != nil {
+			return err
+		}
+	}
+	for n := number + 1; ; n++ {
+		if rawdb.ReadCanonicalHash(db, n) == (common.Hash{}) {
+			break
+		}
+		rawdb.DeleteCanonicalHash(db, n)
+	}
+	rawdb.WriteHeadHeaderHash(db, hash)
+	rawdb.WriteHeadBlockHash(db, hash)
+	rawdb.WriteHeadFastBlockHash(db, hash)
+	return nil
+}
@@ -0,0 +1,120 @@
+package fork
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	mockEngine "github.com/aaronwinter/celo-blockchain/consensus/consensustest"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/backend"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul/validator"
+	"github.com/aaronwinter/celo-blockchain/core"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/core/vm"
+	"github.com/aaronwinter/celo-blockchain/crypto"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
+	"github.com/aaronwinter/celo-blockchain/params"
+)
+
+func newValidator(t *testing.T) istanbul.ValidatorData {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	blsPrivateKey, err := blscrypto.ECDSAToBLS(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blsPublicKey, err := blscrypto.PrivateToPublic(blsPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return istanbul.ValidatorData{Address: crypto.PubkeyToAddress(key.PublicKey), BLSPublicKey: blsPublicKey}
+}
+
+func TestApply(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	genesis := (&core.Genesis{Config: params.IstanbulTestChainConfig}).MustCommit(db)
+	blocks, _ := core.GenerateChain(params.IstanbulTestChainConfig, genesis, mockEngine.NewFaker(), db, 10, nil)
+
+	chain, err := core.NewBlockChain(db, nil, params.IstanbulTestChainConfig, mockEngine.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatal(err)
+	}
+	chain.Stop()
+
+	forkBase := blocks[5]
+	validators := []istanbul.ValidatorData{newValidator(t), newValidator(t)}
+	chainConfig, err := Apply(db, &Config{
+		Block:         forkBase.NumberU64(),
+		Validators:    validators,
+		Impersonation: true,
+	})
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	if head := rawdb.ReadHeadBlockHash(db); head != forkBase.Hash() {
+		t.Errorf("head block hash: have %x, want %x", head, forkBase.Hash())
+	}
+	if hash := rawdb.ReadCanonicalHash(db, forkBase.NumberU64()+1); hash != (common.Hash{}) {
+		t.Errorf("canonical hash after fork base not removed: %x", hash)
+	}
+
+	// The local fork starts right after the fork base, with impersonation enabled
+	want := &params.LocalForkConfig{Block: blocks[6].Number(), Impersonation: true}
+	if !reflect.DeepEqual(chainConfig.LocalFork, want) {
+		t.Errorf("local fork config: have %+v, want %+v", chainConfig.LocalFork, want)
+	}
+	stored := rawdb.ReadChainConfig(db, genesis.Hash())
+	if !reflect.DeepEqual(stored.LocalFork, want) {
+		t.Errorf("stored local fork config: have %+v, want %+v", stored.LocalFork, want)
+	}
+	if !chainConfig.IsLocalForkBlock(blocks[6].Number()) {
+		t.Errorf("block %d should be the local fork block", blocks[6].NumberU64())
+	}
+	// Transactions of the local blocks are recovered with the impersonation signer
+	if _, ok := types.MakeSigner(chainConfig, blocks[6].Number()).(types.ImpersonationSigner); !ok {
+		t.Errorf("local blocks not signed with the impersonation signer")
+	}
+	if _, ok := types.MakeSigner(chainConfig, forkBase.Number()).(types.ImpersonationSigner); ok {
+		t.Errorf("original blocks signed with the impersonation signer")
+	}
+	if _, ok := types.LatestSigner(chainConfig).(types.ImpersonationSigner); !ok {
+		t.Errorf("pending transactions not signed with the impersonation signer")
+	}
+
+	// The validator set is rewritten at the last epoch block before the fork, which is genesis
+	// for the test chain config.
+	blob, err := db.Get(append([]byte("istanbul-snapshot"), genesis.Hash().Bytes()...))
+	if err != nil {
+		t.Fatalf("istanbul snapshot was not written: %v", err)
+	}
+	var snap backend.Snapshot
+	if err := json.Unmarshal(blob, &snap); err != nil {
+		t.Fatalf("failed to decode the istanbul snapshot: %v", err)
+	}
+	if snap.Number != 0 || snap.Hash != genesis.Hash() || snap.Epoch != params.IstanbulTestChainConfig.Istanbul.Epoch {
+		t.Errorf("snapshot position: have number %d hash %x epoch %d, want number 0 hash %x epoch %d",
+			snap.Number, snap.Hash, snap.Epoch, genesis.Hash(), params.IstanbulTestChainConfig.Istanbul.Epoch)
+	}
+	if have := validator.MapValidatorsToData(snap.ValSet.List()); !reflect.DeepEqual(have, validators) {
+		t.Errorf("snapshot validators: have %v, want %v", have, validators)
+	}
+}
+
+func TestApplyMissingBlock(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	(&core.Genesis{Config: params.IstanbulTestChainConfig}).MustCommit(db)
+
+	_, err := Apply(db, &Config{Block: 100, Validators: []istanbul.ValidatorData{newValidator(t)}})
+	if err != errMissingForkBase {
+		t.Errorf("error mismatch: have %v, want %v", err, errMissingForkBase)
+	}
+}
@@ -0,0 +1,42 @@
+package genesis
+
+import (
+	"math/big"
+
+	"github.com/aaronwinter/celo-blockchain/core"
+	"github.com/aaronwinter/celo-blockchain/mycelo/env"
+	"github.com/aaronwinter/celo-blockchain/params"
+)
+
+// DevMnemonic is the mnemonic from which the accounts of a developer chain are derived
+const DevMnemonic = "test test test test test test test test test test test junk"
+
+// DevAccounts returns the accounts config for a developer chain: a single validator, which
+// is also the admin account, plus pre-funded developer accounts
+func DevAccounts() *env.AccountsConfig {
+	return &env.AccountsConfig{
+		Mnemonic:             DevMnemonic,
+		NumValidators:        1,
+		ValidatorsPerGroup:   1,
+		NumDeveloperAccounts: 10,
+		UseValidatorAsAdmin:  true,
+	}
+}
+
+// DevGenesis generates the genesis of a single validator developer chain, with all the core
+// contracts deployed from contractsBuildPath. A blockPeriod of 0 lets the validator seal
+// blocks as soon as transactions arrive.
+func DevGenesis(chainID *big.Int, blockPeriod uint64, contractsBuildPath string) (*core.Genesis, error) {
+	accounts := DevAccounts()
+
+	genesisConfig := CreateCommonGenesisConfig(chainID, accounts.AdminAccount().Address, params.IstanbulConfig{
+		Epoch:          100,
+		ProposerPolicy: 2,
+		LookbackWindow: 3,
+		BlockPeriod:    blockPeriod,
+		RequestTimeout: 3000,
+	})
+	FundAccounts(genesisConfig, accounts.DeveloperAccounts())
+
+	return GenerateGenesis(accounts, genesisConfig, contractsBuildPath)
+}
@@ -0,0 +1,361 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/core"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/state"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/core/vm/runtime"
+	"github.com/aaronwinter/celo-blockchain/mycelo/contract"
+	"github.com/aaronwinter/celo-blockchain/mycelo/env"
+	"github.com/aaronwinter/celo-blockchain/mycelo/internal/utils"
+)
+
+// inspectedParameters lists, for each core contract, the view methods that expose its parameters
+var inspectedParameters = []struct {
+	contract string
+	methods  []string
+}{
+	{"Attestations", []string{"attestationExpiryBlocks", "selectIssuersWaitBlocks", "maxAttestations"}},
+	{"BlockchainParameters", []string{"getMinimumClientVersion", "blockGasLimit", "intrinsicGasForAlternativeFeeCurrency", "getUptimeLookbackWindow"}},
+	{"DoubleSigningSlasher", []string{"slashingIncentives"}},
+	{"DowntimeSlasher", []string{"slashingIncentives", "slashableDowntime"}},
+	{"Election", []string{"getElectableValidators", "getElectabilityThreshold", "maxNumGroupsVotedFor", "getTotalVotes"}},
+	{"EpochRewards", []string{"getTargetVotingYieldParameters", "getRewardsMultiplierParameters", "getTargetVotingGoldFraction", "targetValidatorEpochPayment", "getCommunityRewardFraction", "carbonOffsettingPartner", "getCarbonOffsettingFraction"}},
+	{"Exchange", []string{"stable", "spread", "reserveFraction", "updateFrequency", "minimumReports"}},
+	{"ExchangeEUR", []string{"stable", "spread", "reserveFraction", "updateFrequency", "minimumReports"}},
+	{"FeeCurrencyWhitelist", []string{"getWhitelist"}},
+	{"GasPriceMinimum", []string{"gasPriceMinimumFloor", "targetDensity", "adjustmentSpeed"}},
+	{"GoldToken", []string{"name", "symbol", "decimals", "totalSupply"}},
+	{"Governance", []string{"approver", "concurrentProposals", "dequeueFrequency", "minDeposit", "queueExpiry", "stageDurations", "getParticipationParameters"}},
+	{"GovernanceApproverMultiSig", []string{"getOwners", "required", "internalRequired"}},
+	{"LockedGold", []string{"unlockingPeriod", "getTotalLockedGold"}},
+	{"Random", []string{"randomnessBlockRetentionWindow"}},
+	{"Reserve", []string{"tobinTaxStalenessThreshold", "getDailySpendingRatio", "getAssetAllocationSymbols", "getAssetAllocationWeights", "tobinTax", "tobinTaxReserveRatio", "getExchangeSpenders", "getOtherReserveAddresses", "getTokens", "frozenReserveGoldStartBalance", "frozenReserveGoldDays"}},
+	{"ReserveSpenderMultiSig", []string{"getOwners", "required", "internalRequired"}},
+	{"SortedOracles", []string{"reportExpirySeconds"}},
+	{"StableToken", []string{"name", "symbol", "decimals", "totalSupply", "getInflationParameters", "getExchangeRegistryId"}},
+	{"StableTokenEUR", []string{"name", "symbol", "decimals", "totalSupply", "getInflationParameters", "getExchangeRegistryId"}},
+	{"Validators", []string{"getGroupLockedGoldRequirements", "getValidatorLockedGoldRequirements", "getValidatorScoreParameters", "membershipHistoryLength", "slashingMultiplierResetPeriod", "getMaxGroupSize", "commissionUpdateDelay", "downtimeGracePeriod"}},
+}
+
+// unregisteredContracts are the proxied core contracts that are not added to the registry
+var unregisteredContracts = map[string]bool{
+	"GovernanceApproverMultiSig": true,
+	"ReserveSpenderMultiSig":     true,
+}
+
+// Report is a decoded view of the core contracts deployed on a genesis alloc
+type Report struct {
+	Config          map[string]string            `json:"config"`          // Flattened chain config
+	Validators      []common.Address             `json:"validators"`      // Initial validators, from the genesis extra data
+	Registry        map[string]common.Address    `json:"registry"`        // Registry entries of the core contracts
+	Parameters      map[string]map[string]string `json:"parameters"`      // Parameters of each core contract
+	ValidatorGroups []GroupReport                `json:"validatorGroups"` // Registered validator groups
+	Problems        []string                     `json:"problems"`        // Failed sanity checks
+}
+
+// GroupReport describes a registered validator group
+type GroupReport struct {
+	Address    common.Address   `json:"address"`
+	Name       string           `json:"name"`
+	Commission *big.Int         `json:"commission"`
+	Votes      *big.Int         `json:"votes"`
+	Members    []common.Address `json:"members"`
+}
+
+// Difference is a parameter that changed between two genesis or genesis configs
+type Difference struct {
+	Path string
+	A    string
+	B    string
+}
+
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: %s -> %s", d.Path, d.A, d.B)
+}
+
+// LoadGenesis will read a genesis from a json file
+func LoadGenesis(filepath string) (*core.Genesis, error) {
+	var genesis core.Genesis
+	if err := utils.ReadJson(&genesis, filepath); err != nil {
+		return nil, err
+	}
+	return &genesis, nil
+}
+
+// Inspect decodes the core contracts deployed on the genesis alloc, and checks that the
+// registry and proxies are consistent with them
+func Inspect(genesis *core.Genesis) (*Report, error) {
+	if genesis.Config == nil {
+		return nil, fmt.Errorf("genesis has no chain config")
+	}
+	config, err := flattenJSON(genesis.Config)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{
+		Config:     config,
+		Registry:   make(map[string]common.Address),
+		Parameters: make(map[string]map[string]string),
+	}
+
+	db := rawdb.NewMemoryDatabase()
+	block := genesis.ToBlock(db)
+	statedb, err := state.New(block.Root(), state.NewDatabase(db), nil)
+	if err != nil {
+		return nil, err
+	}
+	runtimeConfig := &runtime.Config{
+		ChainConfig: genesis.Config,
+		State:       statedb,
+		GasLimit:    1000000000000000,
+		GasPrice:    big.NewInt(0),
+		Value:       big.NewInt(0),
+		Time:        newBigInt(genesis.Timestamp),
+		BlockNumber: newBigInt(0),
+	}
+
+	extra, err := types.ExtractIstanbulExtra(block.Header())
+	if err != nil {
+		report.problem("genesis extra data: %v", err)
+	} else {
+		report.Validators = extra.AddedValidators
+	}
+
+	if statedb.GetCodeSize(env.MustProxyAddressFor("Registry")) == 0 {
+		report.problem("no registry deployed at %s", env.MustProxyAddressFor("Registry").Hex())
+		return report, nil
+	}
+	registry := contract.CoreContract(runtimeConfig, "Registry", env.MustProxyAddressFor("Registry"))
+
+	// Registry and proxies
+	for _, name := range env.CoreContracts() {
+		proxyAddress := env.MustProxyAddressFor(name)
+		if statedb.GetCodeSize(proxyAddress) == 0 {
+			report.problem("%s proxy is not deployed at %s", name, proxyAddress.Hex())
+			continue
+		}
+		if implementation, err := contract.ProxyContract(runtimeConfig, name, proxyAddress).QueryValues("_getImplementation"); err != nil {
+			report.problem("%s proxy implementation: %v", name, err)
+		} else if impl := implementation[0].(common.Address); statedb.GetCodeSize(impl) == 0 {
+			report.problem("%s proxy points to %s, which has no code", name, impl.Hex())
+		}
+		if unregisteredContracts[name] {
+			continue
+		}
+		registered, err := registry.QueryValues("getAddressForString", name)
+		if err != nil {
+			report.problem("registry lookup of %s: %v", name, err)
+			continue
+		}
+		report.Registry[name] = registered[0].(common.Address)
+		if report.Registry[name] != proxyAddress {
+			report.problem("registry points %s to %s instead of its proxy %s", name, report.Registry[name].Hex(), proxyAddress.Hex())
+		}
+	}
+	if registered, err := registry.QueryValues("getAddressForString", "TransferWhitelist"); err == nil && registered[0].(common.Address) != common.ZeroAddress {
+		report.Registry["TransferWhitelist"] = registered[0].(common.Address)
+	}
+
+	// Contract parameters
+	for _, entry := range inspectedParameters {
+		address := env.MustProxyAddressFor(entry.contract)
+		if statedb.GetCodeSize(address) == 0 {
+			continue
+		}
+		backend := contract.CoreContract(runtimeConfig, entry.contract, address)
+		params := make(map[string]string)
+		for _, method := range entry.methods {
+			values, err := backend.QueryValues(method)
+			if err != nil {
+				report.problem("%s.%s: %v", entry.contract, method, err)
+				continue
+			}
+			params[method] = formatValues(values)
+		}
+		report.Parameters[entry.contract] = params
+	}
+
+	// Validator groups and members
+	validators := contract.CoreContract(runtimeConfig, "Validators", env.MustProxyAddressFor("Validators"))
+	accounts := contract.CoreContract(runtimeConfig, "Accounts", env.MustProxyAddressFor("Accounts"))
+	election := contract.CoreContract(runtimeConfig, "Election", env.MustProxyAddressFor("Election"))
+	var groups []common.Address
+	if _, err := validators.Query(&groups, "getRegisteredValidatorGroups"); err != nil {
+		report.problem("Validators.getRegisteredValidatorGroups: %v", err)
+	}
+	for _, address := range groups {
+		group := GroupReport{Address: address}
+		if values, err := validators.QueryValues("getValidatorGroup", address); err != nil {
+			report.problem("Validators.getValidatorGroup(%s): %v", address.Hex(), err)
+		} else {
+			group.Members = values[0].([]common.Address)
+			group.Commission = values[1].(*big.Int)
+		}
+		if _, err := accounts.Query(&group.Name, "getName", address); err != nil {
+			report.problem("Accounts.getName(%s): %v", address.Hex(), err)
+		}
+		if _, err := election.Query(&group.Votes, "getTotalVotesForGroup", address); err != nil {
+			report.problem("Election.getTotalVotesForGroup(%s): %v", address.Hex(), err)
+		}
+		report.ValidatorGroups = append(report.ValidatorGroups, group)
+	}
+
+	// Initial validators must be registered ones
+	var registeredValidators []common.Address
+	if _, err := validators.Query(&registeredValidators, "getRegisteredValidators"); err != nil {
+		report.problem("Validators.getRegisteredValidators: %v", err)
+	}
+	registeredSet := make(map[common.Address]bool)
+	for _, address := range registeredValidators {
+		registeredSet[address] = true
+	}
+	for _, address := range report.Validators {
+		if !registeredSet[address] {
+			report.problem("initial validator %s is not registered", address.Hex())
+		}
+	}
+
+	return report, nil
+}
+
+func (r *Report) problem(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+// Flatten returns the report as a path -> value map, to be compared with Diff
+func (r *Report) Flatten() map[string]string {
+	flat := make(map[string]string)
+	for path, value := range r.Config {
+		flat["config."+path] = value
+	}
+	flat["validators"] = formatValue(r.Validators)
+	for name, address := range r.Registry {
+		flat["registry."+name] = address.Hex()
+	}
+	for name, params := range r.Parameters {
+		for method, value := range params {
+			flat[name+"."+method] = value
+		}
+	}
+	for _, group := range r.ValidatorGroups {
+		prefix := "validatorGroups." + group.Address.Hex() + "."
+		flat[prefix+"name"] = group.Name
+		flat[prefix+"commission"] = formatValue(group.Commission)
+		flat[prefix+"votes"] = formatValue(group.Votes)
+		flat[prefix+"members"] = formatValue(group.Members)
+	}
+	return flat
+}
+
+// FlattenConfig returns the genesis config as a path -> value map, to be compared with Diff
+func FlattenConfig(cfg *Config) (map[string]string, error) {
+	return flattenJSON(cfg)
+}
+
+// Diff returns the differences between two flattened reports or configs, sorted by path
+func Diff(a, b map[string]string) []Difference {
+	var diffs []Difference
+	for path, valueA := range a {
+		if valueB, ok := b[path]; !ok {
+			diffs = append(diffs, Difference{Path: path, A: valueA, B: "<missing>"})
+		} else if valueA != valueB {
+			diffs = append(diffs, Difference{Path: path, A: valueA, B: valueB})
+		}
+	}
+	for path, valueB := range b {
+		if _, ok := a[path]; !ok {
+			diffs = append(diffs, Difference{Path: path, A: "<missing>", B: valueB})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+	return diffs
+}
+
+// flattenJSON marshals v to json and returns its leaves keyed by their dotted path
+func flattenJSON(v interface{}) (map[string]string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	// Keep numbers as they are written, big integers would lose precision as floats
+	var tree interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
+	flat := make(map[string]string)
+	var walk func(prefix string, node interface{})
+	walk = func(prefix string, node interface{}) {
+		switch node := node.(type) {
+		case map[string]interface{}:
+			for key, child := range node {
+				if prefix == "" {
+					walk(key, child)
+				} else {
+					walk(prefix+"."+key, child)
+				}
+			}
+		case []interface{}:
+			for i, child := range node {
+				walk(fmt.Sprintf("%s[%d]", prefix, i), child)
+			}
+		case nil:
+			flat[prefix] = "null"
+		case json.Number:
+			flat[prefix] = node.String()
+		default:
+			flat[prefix] = fmt.Sprint(node)
+		}
+	}
+	walk("", tree)
+	return flat, nil
+}
+
+func formatValues(values []interface{}) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = formatValue(value)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func formatValue(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case *big.Int:
+		if value == nil {
+			return "null"
+		}
+		return value.String()
+	case common.Address:
+		return value.Hex()
+	case [32]byte:
+		return common.Hash(value).Hex()
+	case [][32]byte:
+		formatted := make([]string, len(value))
+		for i, v := range value {
+			formatted[i] = common.Hash(v).Hex()
+		}
+		return "[" + strings.Join(formatted, " ") + "]"
+	case []common.Address:
+		formatted := make([]string, len(value))
+		for i, v := range value {
+			formatted[i] = v.Hex()
+		}
+		return "[" + strings.Join(formatted, " ") + "]"
+	default:
+		return fmt.Sprint(value)
+	}
+}
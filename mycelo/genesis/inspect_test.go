@@ -0,0 +1,74 @@
+package genesis
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aaronwinter/celo-blockchain/core"
+)
+
+func TestDiffConfigs(t *testing.T) {
+	a, err := FlattenConfig(BaseConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diffs := Diff(a, a); len(diffs) != 0 {
+		t.Errorf("expected no differences, got %v", diffs)
+	}
+
+	modified := BaseConfig()
+	modified.Istanbul.Epoch = 1000
+	modified.Validators.MaxGroupSize = 7
+	b, err := FlattenConfig(modified)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diffs := Diff(a, b)
+	if len(diffs) != 2 {
+		t.Fatalf("expected 2 differences, got %v", diffs)
+	}
+	if diffs[0].Path != "Validators.maxGroupSize" || diffs[0].A != "5" || diffs[0].B != "7" {
+		t.Errorf("unexpected difference %v", diffs[0])
+	}
+	if diffs[1].Path != "istanbul.epoch" || diffs[1].B != "1000" {
+		t.Errorf("unexpected difference %v", diffs[1])
+	}
+}
+
+func TestDiffMissingPaths(t *testing.T) {
+	diffs := Diff(map[string]string{"a": "1", "b": "2"}, map[string]string{"b": "2", "c": "3"})
+	expected := []Difference{{"a", "1", "<missing>"}, {"c", "<missing>", "3"}}
+	if len(diffs) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, diffs)
+	}
+	for i := range expected {
+		if diffs[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected[i], diffs[i])
+		}
+	}
+}
+
+func TestFlattenBigNumbers(t *testing.T) {
+	balance, _ := new(big.Int).SetString("100000000000000000001", 10)
+	flat, err := flattenJSON(map[string]interface{}{"balance": balance, "count": 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flat["balance"] != "100000000000000000001" {
+		t.Errorf("balance mismatch: have %s, want %v", flat["balance"], balance)
+	}
+	if flat["count"] != "3" {
+		t.Errorf("count mismatch: have %s, want 3", flat["count"])
+	}
+}
+
+func TestInspectWithoutCoreContracts(t *testing.T) {
+	report, err := Inspect(&core.Genesis{Config: BaseConfig().ChainConfig()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Problems) == 0 {
+		t.Error("expected a missing registry to be reported")
+	}
+}
@@ -3,17 +3,17 @@ package loadbot
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
-	"math/rand"
 	"sync"
 	"time"
 
 	bind "github.com/aaronwinter/celo-blockchain/accounts/abi/bind_v2"
 	"github.com/aaronwinter/celo-blockchain/common"
-
+	"github.com/aaronwinter/celo-blockchain/core/types"
 	"github.com/aaronwinter/celo-blockchain/ethclient"
-	"github.com/aaronwinter/celo-blockchain/mycelo/contract"
+	"github.com/aaronwinter/celo-blockchain/mycelo/analyze"
 	"github.com/aaronwinter/celo-blockchain/mycelo/env"
 	"golang.org/x/sync/errgroup"
 )
@@ -21,50 +21,100 @@ import (
 // 110k gas for stable token transfer is pretty reasonable. It's just under 100k in practice
 const GasForTransferWithComment = 110000
 
-// LoadGenerator keeps track of in-flight transactions
-type LoadGenerator struct {
-	MaxPending uint64
-	Pending    uint64
-	PendingMu  sync.Mutex
-}
+// How long to wait for in flight transactions once the scenario is over
+const drainTimeout = 30 * time.Second
 
-// TxConfig contains the options for a transaction
-type txConfig struct {
-	Acc               env.Account
-	Nonce             uint64
-	Recipient         common.Address
-	Value             *big.Int
+// How often to poll for the receipt of in flight transactions
+const receiptPollInterval = 250 * time.Millisecond
+
+// Config represent the load bot run configuration
+type Config struct {
+	ChainID           *big.Int
+	Accounts          []env.Account
+	Clients           []*ethclient.Client
+	Scenario          *Scenario
 	Verbose           bool
 	SkipGasEstimation bool
-	MixFeeCurrency    bool
+	Collector         *analyze.Collector // Optional, is told about every sent transaction
 }
 
-// Config represent the load bot run configuration
-type Config struct {
-	ChainID               *big.Int
-	Accounts              []env.Account
-	Amount                *big.Int
-	TransactionsPerSecond int
-	Clients               []*ethclient.Client
-	Verbose               bool
-	MaxPending            uint64
-	SkipGasEstimation     bool
-	MixFeeCurrency        bool
+// loadGenerator keeps track of nonces and in-flight transactions
+type loadGenerator struct {
+	cfg      *Config
+	scenario *Scenario
+	nonces   *nonceManager
+	recorder *recorder
+
+	storageContract common.Address // Contract used by the storage workload
+
+	pending   uint64
+	pendingMu sync.Mutex
+}
+
+// nonceManager hands out account nonces. We have to manage nonces because calling PendingNonceAt
+// is racy and often results in using the same nonce more than once when applying heavy load.
+type nonceManager struct {
+	client *ethclient.Client
+	nonces map[common.Address]uint64
+	mu     sync.Mutex
 }
 
-// Start will start loads bots
-func Start(ctx context.Context, cfg *Config) error {
-	// Set up nonces, we have to manage nonces because calling PendingNonceAt
-	// is racy and often results in using the same nonce more than once when
-	// applying heavy load.
-	nonces := make([]uint64, len(cfg.Accounts))
-	for i, a := range cfg.Accounts {
-		nonce, err := cfg.Clients[0].PendingNonceAt(ctx, a.Address)
-		if err != nil {
-			return fmt.Errorf("failed to retrieve pending nonce for account %s: %v", a.Address.String(), err)
+// next returns the nonce for the next transaction of an account
+func (nm *nonceManager) next(ctx context.Context, account common.Address) (uint64, error) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+	nonce, ok := nm.nonces[account]
+	if !ok {
+		var err error
+		if nonce, err = nm.client.PendingNonceAt(ctx, account); err != nil {
+			return 0, fmt.Errorf("failed to retrieve pending nonce for account %s: %v", account.String(), err)
 		}
-		nonces[i] = nonce
 	}
+	nm.nonces[account] = nonce + 1
+	return nonce, nil
+}
+
+// reset forces the nonce of an account to be fetched again, after one of its transactions
+// couldn't be sent
+func (nm *nonceManager) reset(account common.Address) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+	delete(nm.nonces, account)
+}
+
+// Start will run the scenario until it's over or ctx is cancelled, and report on the
+// transactions sent
+func Start(ctx context.Context, cfg *Config) (*Report, error) {
+	if err := cfg.Scenario.Validate(); err != nil {
+		return nil, err
+	}
+	lg := &loadGenerator{
+		cfg:      cfg,
+		scenario: cfg.Scenario,
+		nonces:   &nonceManager{client: cfg.Clients[0], nonces: make(map[common.Address]uint64)},
+	}
+
+	// Prepare the accounts and contracts the workloads need
+	if lg.scenario.uses(StorageWorkload) {
+		if err := lg.deployStorageContract(ctx, cfg.Accounts[0], cfg.Clients[0]); err != nil {
+			return nil, fmt.Errorf("deploying storage contract: %w", err)
+		}
+	}
+	setup, setupCtx := errgroup.WithContext(ctx)
+	for i, acc := range cfg.Accounts {
+		acc, client := acc, cfg.Clients[i%len(cfg.Clients)]
+		setup.Go(func() error { return lg.setupAccount(setupCtx, acc, client) })
+	}
+	if err := setup.Wait(); err != nil {
+		return nil, fmt.Errorf("setting up accounts: %w", err)
+	}
+
+	lg.recorder = newRecorder(lg.scenario)
+
+	// Receipts are awaited until the run is interrupted, or for a while after the scenario is over
+	waitCtx, cancelWait := context.WithCancel(context.Background())
+	defer cancelWait()
+	var wg sync.WaitGroup
 
 	// Offset the receiver from the sender so that they are different
 	recvIdx := len(cfg.Accounts) / 2
@@ -72,22 +122,24 @@ func Start(ctx context.Context, cfg *Config) error {
 	clientIdx := 0
 
 	// Fire off transactions
-	period := 1 * time.Second / time.Duration(cfg.TransactionsPerSecond)
-	ticker := time.NewTicker(period)
-	group, ctx := errgroup.WithContext(ctx)
-	lg := &LoadGenerator{
-		MaxPending: cfg.MaxPending,
-	}
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+loop:
 	for {
 		select {
-		case <-ticker.C:
-			lg.PendingMu.Lock()
-			if lg.MaxPending != 0 && lg.Pending > lg.MaxPending {
-				lg.PendingMu.Unlock()
+		case <-timer.C:
+			tps, done := lg.scenario.rate(time.Since(lg.recorder.start))
+			if done {
+				break loop
+			}
+			if tps <= 0 {
+				timer.Reset(100 * time.Millisecond)
+				continue
+			}
+			timer.Reset(time.Duration(float64(time.Second) / tps))
+
+			if !lg.acquire() {
 				continue
-			} else {
-				lg.Pending++
-				lg.PendingMu.Unlock()
 			}
 			// We use round robin selectors that rollover
 			recvIdx++
@@ -95,79 +147,127 @@ func Start(ctx context.Context, cfg *Config) error {
 
 			sendIdx++
 			sender := cfg.Accounts[sendIdx%len(cfg.Accounts)]
-			nonce := nonces[sendIdx%len(cfg.Accounts)]
-			nonces[sendIdx%len(cfg.Accounts)]++
 
 			clientIdx++
 			client := cfg.Clients[clientIdx%len(cfg.Clients)]
-			group.Go(func() error {
-				txCfg := txConfig{
-					Acc:               sender,
-					Nonce:             nonce,
-					Recipient:         recipient,
-					Value:             cfg.Amount,
-					Verbose:           cfg.Verbose,
-					SkipGasEstimation: cfg.SkipGasEstimation,
-					MixFeeCurrency:    cfg.MixFeeCurrency,
-				}
-				return runTransaction(ctx, client, cfg.ChainID, lg, txCfg)
-			})
+
+			workload := lg.scenario.pick()
+			opts, err := lg.transactor(ctx, sender)
+			if err != nil {
+				lg.recorder.sent(workload.Name)
+				lg.recorder.failed(workload.Name, err.Error())
+				lg.release()
+				continue
+			}
+			req := &txRequest{
+				workload:  workload,
+				client:    client,
+				opts:      opts,
+				recipient: recipient,
+			}
+			req.setFees(cfg.SkipGasEstimation)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer lg.release()
+				lg.runTransaction(ctx, waitCtx, sender, req)
+			}()
 		case <-ctx.Done():
-			return group.Wait()
+			cancelWait()
+			break loop
 		}
 	}
-}
 
-func runTransaction(ctx context.Context, client *ethclient.Client, chainID *big.Int, lg *LoadGenerator, txCfg txConfig) error {
-	defer func() {
-		lg.PendingMu.Lock()
-		if lg.MaxPending != 0 {
-			lg.Pending--
+	go func() {
+		select {
+		case <-time.After(drainTimeout):
+		case <-ctx.Done():
 		}
-		lg.PendingMu.Unlock()
+		cancelWait()
 	}()
+	wg.Wait()
+	return lg.recorder.report(), nil
+}
 
-	abi := contract.AbiFor("StableToken")
-	stableToken := bind.NewBoundContract(env.MustProxyAddressFor("StableToken"), *abi, client)
-
-	transactor := bind.NewKeyedTransactor(txCfg.Acc.PrivateKey)
-	transactor.Context = ctx
-	transactor.ChainID = chainID
-	transactor.Nonce = new(big.Int).SetUint64(txCfg.Nonce)
-
-	stableTokenAddress := env.MustProxyAddressFor("StableToken")
+// acquire reserves a slot for an in flight transaction, if under the scenario's limit
+func (lg *loadGenerator) acquire() bool {
+	lg.pendingMu.Lock()
+	defer lg.pendingMu.Unlock()
+	if lg.scenario.MaxPending != 0 && lg.pending >= lg.scenario.MaxPending {
+		return false
+	}
+	lg.pending++
+	return true
+}
 
-	if n := rand.Intn(2); txCfg.MixFeeCurrency && n == 0 {
-		transactor.FeeCurrency = nil
+func (lg *loadGenerator) release() {
+	lg.pendingMu.Lock()
+	defer lg.pendingMu.Unlock()
+	lg.pending--
+}
 
-	} else {
-		transactor.FeeCurrency = &stableTokenAddress
-	}
-	if txCfg.SkipGasEstimation {
-		transactor.GasLimit = GasForTransferWithComment
+// transactor returns the options to send the next transaction of an account
+func (lg *loadGenerator) transactor(ctx context.Context, acc env.Account) (*bind.TransactOpts, error) {
+	nonce, err := lg.nonces.next(ctx, acc.Address)
+	if err != nil {
+		return nil, err
 	}
+	transactor := bind.NewKeyedTransactor(acc.PrivateKey)
+	transactor.Context = ctx
+	transactor.ChainID = lg.cfg.ChainID
+	transactor.Nonce = new(big.Int).SetUint64(nonce)
+	return transactor, nil
+}
+
+func (lg *loadGenerator) runTransaction(ctx, waitCtx context.Context, sender env.Account, req *txRequest) {
+	name := req.workload.Name
+	sentAt := time.Now()
 
-	tx, err := stableToken.TxObj(transactor, "transferWithComment", txCfg.Recipient, txCfg.Value, "need to proivde some long comment to make it similar to an encrypted comment").Send()
+	tx, err := lg.send(ctx, req)
 	if err != nil {
-		if err != context.Canceled {
-			fmt.Printf("Error sending transaction: %v\n", err)
+		lg.nonces.reset(sender.Address)
+		// Transactions interrupted by the end of the run are not accounted
+		if !errors.Is(err, context.Canceled) {
+			lg.recorder.sent(name)
+			lg.recorder.failed(name, err.Error())
+			if lg.cfg.Verbose {
+				fmt.Printf("Error sending %s transaction: %v\n", name, err)
+			}
 		}
-		return fmt.Errorf("Error sending transaction: %w", err)
+		return
+	}
+	lg.recorder.sent(name)
+	if lg.cfg.Collector != nil {
+		lg.cfg.Collector.Submitted(tx.Hash(), sentAt)
 	}
-	if txCfg.Verbose {
-		fmt.Printf("cusd transfer generated: from: %s to: %s amount: %s\ttxhash: %s\n", txCfg.Acc.Address.Hex(), txCfg.Recipient.Hex(), txCfg.Value.String(), tx.Transaction.Hash().Hex())
+	if lg.cfg.Verbose {
+		fmt.Printf("%s transaction sent: from: %s txhash: %s\n", name, sender.Address.Hex(), tx.Hash().Hex())
 		printJSON(tx)
 	}
 
-	_, err = tx.WaitMined(ctx)
-
+	receipt, err := waitMined(waitCtx, req.client, tx)
 	if err != nil {
-		if err != context.Canceled {
-			fmt.Printf("Error waiting for tx: %v\n", err)
+		lg.recorder.pending(name)
+		return
+	}
+	lg.recorder.included(name, time.Since(sentAt), receipt.Status != types.ReceiptStatusSuccessful)
+}
+
+// waitMined polls for the receipt of tx more often than bind.WaitMined, so latencies are accurate
+func waitMined(ctx context.Context, client *ethclient.Client, tx *types.Transaction) (*types.Receipt, error) {
+	ticker := time.NewTicker(receiptPollInterval)
+	defer ticker.Stop()
+	for {
+		if receipt, _ := client.TransactionReceipt(ctx, tx.Hash()); receipt != nil {
+			return receipt, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
 		}
-		return fmt.Errorf("Error waiting for tx: %w", err)
 	}
-	return err
 }
 
 func printJSON(obj interface{}) {
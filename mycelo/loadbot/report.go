@@ -0,0 +1,142 @@
+package loadbot
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Report summarizes a load bot run
+type Report struct {
+	Duration  time.Duration
+	Workloads []*WorkloadReport
+}
+
+// WorkloadReport summarizes the transactions sent by a workload
+type WorkloadReport struct {
+	Name      string
+	Sent      uint64            // Transactions sent, or attempted to
+	Included  uint64            // Transactions included on a block, including reverted ones
+	Reverted  uint64            // Transactions included with a failed status
+	Pending   uint64            // Transactions not included when the run was over
+	Failures  map[string]uint64 // Count of failures by reason
+	Latencies []time.Duration   // Time from sending to seeing the receipt of included transactions
+}
+
+// InclusionRate returns the fraction of sent transactions that were included
+func (wr *WorkloadReport) InclusionRate() float64 {
+	if wr.Sent == 0 {
+		return 0
+	}
+	return float64(wr.Included) / float64(wr.Sent)
+}
+
+// Percentile returns the p-th percentile (0-100) of the inclusion latency
+func (wr *WorkloadReport) Percentile(p float64) time.Duration {
+	if len(wr.Latencies) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(wr.Latencies))
+	copy(sorted, wr.Latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	// Nearest rank
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	} else if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+// Write prints the report as a table, followed by the failure reasons
+func (r *Report) Write(w io.Writer) {
+	fmt.Fprintf(w, "Run duration: %v\n\n", r.Duration.Round(time.Second))
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "WORKLOAD\tSENT\tINCLUDED\tREVERTED\tPENDING\tINCLUSION\tP50\tP90\tP99")
+	for _, wr := range r.Workloads {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%.1f%%\t%v\t%v\t%v\n", wr.Name, wr.Sent, wr.Included, wr.Reverted, wr.Pending, 100*wr.InclusionRate(),
+			wr.Percentile(50).Round(time.Millisecond), wr.Percentile(90).Round(time.Millisecond), wr.Percentile(99).Round(time.Millisecond))
+	}
+	tw.Flush()
+
+	for _, wr := range r.Workloads {
+		if len(wr.Failures) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n%s failures:\n", wr.Name)
+		reasons := make([]string, 0, len(wr.Failures))
+		for reason := range wr.Failures {
+			reasons = append(reasons, reason)
+		}
+		sort.Slice(reasons, func(i, j int) bool { return wr.Failures[reasons[i]] > wr.Failures[reasons[j]] })
+		for _, reason := range reasons {
+			fmt.Fprintf(w, "  %6d  %s\n", wr.Failures[reason], reason)
+		}
+	}
+}
+
+// recorder collects the outcome of transactions while the load bot runs
+type recorder struct {
+	mu        sync.Mutex
+	start     time.Time
+	workloads map[string]*WorkloadReport
+	order     []string
+}
+
+func newRecorder(scenario *Scenario) *recorder {
+	r := &recorder{
+		start:     time.Now(),
+		workloads: make(map[string]*WorkloadReport),
+	}
+	for _, w := range scenario.Workloads {
+		r.workloads[w.Name] = &WorkloadReport{Name: w.Name, Failures: make(map[string]uint64)}
+		r.order = append(r.order, w.Name)
+	}
+	return r
+}
+
+func (r *recorder) sent(workload string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.workloads[workload].Sent++
+}
+
+func (r *recorder) failed(workload string, reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.workloads[workload].Failures[reason]++
+}
+
+func (r *recorder) included(workload string, latency time.Duration, reverted bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	wr := r.workloads[workload]
+	wr.Included++
+	wr.Latencies = append(wr.Latencies, latency)
+	if reverted {
+		wr.Reverted++
+		wr.Failures["reverted"]++
+	}
+}
+
+func (r *recorder) pending(workload string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.workloads[workload].Pending++
+}
+
+func (r *recorder) report() *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	report := &Report{Duration: time.Since(r.start)}
+	for _, name := range r.order {
+		report.Workloads = append(report.Workloads, r.workloads[name])
+	}
+	return report
+}
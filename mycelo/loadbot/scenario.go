@@ -0,0 +1,194 @@
+package loadbot
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"time"
+
+	"github.com/aaronwinter/celo-blockchain/mycelo/internal/utils"
+)
+
+// Workload types supported by the load bot
+const (
+	CeloTransferWorkload   = "celo-transfer"   // GoldToken transfer as the tx value
+	StableTransferWorkload = "stable-transfer" // StableToken transferWithComment
+	DeployWorkload         = "deploy"          // Deploys a small contract
+	ExchangeWorkload       = "exchange"        // Sells CELO on the Exchange
+	LockGoldWorkload       = "lock-gold"       // Locks CELO on LockedGold
+	VoteWorkload           = "vote"            // Votes for an eligible validator group
+	StorageWorkload        = "storage"         // Writes new storage slots on a contract
+	GatewayFeeWorkload     = "gateway-fee"     // CELO transfer paying a gateway fee
+)
+
+var workloadTypes = map[string]bool{
+	CeloTransferWorkload:   true,
+	StableTransferWorkload: true,
+	DeployWorkload:         true,
+	ExchangeWorkload:       true,
+	LockGoldWorkload:       true,
+	VoteWorkload:           true,
+	StorageWorkload:        true,
+	GatewayFeeWorkload:     true,
+}
+
+// Stage is a period of the run where the rate of transactions goes linearly from FromTPS to ToTPS.
+// A Duration of 0 keeps the stage running at FromTPS until the load bot is stopped, so it can
+// only be the last stage.
+type Stage struct {
+	Duration uint64  `json:"duration"` // Seconds
+	FromTPS  float64 `json:"fromTps"`
+	ToTPS    float64 `json:"toTps"`
+}
+
+// Workload is a type of transaction sent by the load bot, and its share of the load
+type Workload struct {
+	Name        string   `json:"name,omitempty"`        // Defaults to Type
+	Type        string   `json:"type"`                  // One of the workload types
+	Weight      uint     `json:"weight"`                // Share of the load, relative to other workloads
+	Currency    string   `json:"currency,omitempty"`    // Stable token for stable-transfer and exchange: cusd (default) or ceur
+	FeeCurrency string   `json:"feeCurrency,omitempty"` // celo (default), cusd, ceur or mixed (celo or cusd randomly)
+	Amount      *big.Int `json:"amount,omitempty"`      // Value transferred, sold, locked or voted
+	GasLimit    uint64   `json:"gasLimit,omitempty"`    // 0 estimates gas, unless gas estimation is skipped
+	Slots       uint64   `json:"slots,omitempty"`       // Storage slots written by each storage tx
+	GatewayFee  *big.Int `json:"gatewayFee,omitempty"`  // Gateway fee paid by gateway-fee txs
+	LockedGold  *big.Int `json:"lockedGold,omitempty"`  // CELO locked by each account before voting
+}
+
+// Scenario describes a load bot run
+type Scenario struct {
+	Stages     []Stage    `json:"stages"`
+	Workloads  []Workload `json:"workloads"`
+	MaxPending uint64     `json:"maxPending"` // Maximum number of in flight txs. 0 to disable
+}
+
+// DefaultScenario is the original load bot: stable token transfers at a fixed rate, until stopped
+func DefaultScenario(tps int, maxPending uint64, mixFeeCurrency bool) *Scenario {
+	feeCurrency := "cusd"
+	if mixFeeCurrency {
+		feeCurrency = "mixed"
+	}
+	return &Scenario{
+		Stages: []Stage{{FromTPS: float64(tps), ToTPS: float64(tps)}},
+		Workloads: []Workload{{
+			Type:        StableTransferWorkload,
+			Weight:      1,
+			FeeCurrency: feeCurrency,
+			Amount:      big.NewInt(10000000),
+		}},
+		MaxPending: maxPending,
+	}
+}
+
+// LoadScenario reads and validates a scenario json file
+func LoadScenario(filepath string) (*Scenario, error) {
+	var scenario Scenario
+	if err := utils.ReadJson(&scenario, filepath); err != nil {
+		return nil, err
+	}
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
+	return &scenario, nil
+}
+
+// Validate checks the scenario and fills in the workloads defaults
+func (s *Scenario) Validate() error {
+	if len(s.Stages) == 0 {
+		return fmt.Errorf("scenario has no stages")
+	}
+	for i, stage := range s.Stages {
+		if stage.Duration == 0 && i != len(s.Stages)-1 {
+			return fmt.Errorf("stage %d: only the last stage can run until stopped", i)
+		}
+		if stage.FromTPS < 0 || stage.ToTPS < 0 {
+			return fmt.Errorf("stage %d: negative tps", i)
+		}
+	}
+
+	if len(s.Workloads) == 0 {
+		return fmt.Errorf("scenario has no workloads")
+	}
+	names := make(map[string]bool)
+	var totalWeight uint
+	for i := range s.Workloads {
+		w := &s.Workloads[i]
+		if !workloadTypes[w.Type] {
+			return fmt.Errorf("workload %d: unknown type %q", i, w.Type)
+		}
+		if w.Name == "" {
+			w.Name = w.Type
+		}
+		if names[w.Name] {
+			return fmt.Errorf("workload %d: duplicated name %q", i, w.Name)
+		}
+		names[w.Name] = true
+		if _, err := stableTokenFor(w.Currency); err != nil {
+			return fmt.Errorf("workload %s: %v", w.Name, err)
+		}
+		if _, ok := feeCurrencies[w.FeeCurrency]; !ok && w.FeeCurrency != "mixed" {
+			return fmt.Errorf("workload %s: unknown fee currency %q", w.Name, w.FeeCurrency)
+		}
+		if w.Amount == nil {
+			w.Amount = big.NewInt(10000000)
+		}
+		if w.Type == StorageWorkload && w.Slots == 0 {
+			w.Slots = 20
+		}
+		if w.Type == GatewayFeeWorkload && w.GatewayFee == nil {
+			w.GatewayFee = big.NewInt(10000)
+		}
+		if w.Type == VoteWorkload && w.LockedGold == nil {
+			w.LockedGold = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+		}
+		totalWeight += w.Weight
+	}
+	if totalWeight == 0 {
+		return fmt.Errorf("all workloads have weight 0")
+	}
+	return nil
+}
+
+// rate returns the target tps after elapsed time, and whether the scenario is over
+func (s *Scenario) rate(elapsed time.Duration) (float64, bool) {
+	for _, stage := range s.Stages {
+		duration := time.Duration(stage.Duration) * time.Second
+		if stage.Duration == 0 {
+			return stage.FromTPS, false
+		}
+		if elapsed < duration {
+			progress := float64(elapsed) / float64(duration)
+			return stage.FromTPS + (stage.ToTPS-stage.FromTPS)*progress, false
+		}
+		elapsed -= duration
+	}
+	return 0, true
+}
+
+// pick returns a random workload, according to their weights
+func (s *Scenario) pick() *Workload {
+	var totalWeight uint
+	for _, w := range s.Workloads {
+		totalWeight += w.Weight
+	}
+	n := uint(rand.Intn(int(totalWeight)))
+	for i := range s.Workloads {
+		if n < s.Workloads[i].Weight {
+			return &s.Workloads[i]
+		}
+		n -= s.Workloads[i].Weight
+	}
+	return &s.Workloads[len(s.Workloads)-1]
+}
+
+// uses returns whether the scenario has workloads of any of the given types
+func (s *Scenario) uses(types ...string) bool {
+	for _, w := range s.Workloads {
+		for _, t := range types {
+			if w.Type == t && w.Weight > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package loadbot
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/core/rawdb"
+	"github.com/aaronwinter/celo-blockchain/core/state"
+	"github.com/aaronwinter/celo-blockchain/core/vm/runtime"
+)
+
+func TestScenarioValidate(t *testing.T) {
+	scenario := &Scenario{
+		Stages:    []Stage{{Duration: 10, FromTPS: 1, ToTPS: 5}},
+		Workloads: []Workload{{Type: StorageWorkload, Weight: 1}, {Type: VoteWorkload, Weight: 2}},
+	}
+	if err := scenario.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if scenario.Workloads[0].Name != StorageWorkload || scenario.Workloads[0].Slots == 0 {
+		t.Errorf("storage workload defaults not set: %+v", scenario.Workloads[0])
+	}
+	if scenario.Workloads[1].LockedGold == nil || scenario.Workloads[1].Amount == nil {
+		t.Errorf("vote workload defaults not set: %+v", scenario.Workloads[1])
+	}
+
+	invalid := []*Scenario{
+		{Workloads: []Workload{{Type: CeloTransferWorkload, Weight: 1}}},
+		{Stages: []Stage{{Duration: 0}, {Duration: 10}}, Workloads: []Workload{{Type: CeloTransferWorkload, Weight: 1}}},
+		{Stages: []Stage{{Duration: 10}}, Workloads: []Workload{{Type: "unknown", Weight: 1}}},
+		{Stages: []Stage{{Duration: 10}}, Workloads: []Workload{{Type: CeloTransferWorkload, Weight: 1}, {Type: CeloTransferWorkload, Weight: 1}}},
+		{Stages: []Stage{{Duration: 10}}, Workloads: []Workload{{Type: CeloTransferWorkload, Weight: 1, FeeCurrency: "btc"}}},
+		{Stages: []Stage{{Duration: 10}}, Workloads: []Workload{{Type: CeloTransferWorkload}}},
+	}
+	for i, scenario := range invalid {
+		if err := scenario.Validate(); err == nil {
+			t.Errorf("scenario %d: expected validation error", i)
+		}
+	}
+}
+
+func TestScenarioRate(t *testing.T) {
+	scenario := &Scenario{Stages: []Stage{
+		{Duration: 10, FromTPS: 0, ToTPS: 10},
+		{Duration: 10, FromTPS: 10, ToTPS: 10},
+	}}
+	tests := []struct {
+		elapsed time.Duration
+		tps     float64
+		done    bool
+	}{
+		{0, 0, false},
+		{5 * time.Second, 5, false},
+		{15 * time.Second, 10, false},
+		{20 * time.Second, 0, true},
+	}
+	for _, tt := range tests {
+		tps, done := scenario.rate(tt.elapsed)
+		if tps != tt.tps || done != tt.done {
+			t.Errorf("rate(%v) = %v, %v; want %v, %v", tt.elapsed, tps, done, tt.tps, tt.done)
+		}
+	}
+
+	forever := &Scenario{Stages: []Stage{{FromTPS: 3}}}
+	if tps, done := forever.rate(time.Hour); tps != 3 || done {
+		t.Errorf("rate = %v, %v; want 3, false", tps, done)
+	}
+}
+
+func TestVoteNeighbours(t *testing.T) {
+	a, b, c := common.HexToAddress("0xa"), common.HexToAddress("0xb"), common.HexToAddress("0xc")
+	groups := []common.Address{a, b, c}
+	votes := []*big.Int{big.NewInt(30), big.NewInt(20), big.NewInt(10)}
+
+	if lesser, greater := voteNeighbours(groups, votes, 2, big.NewInt(5)); lesser != (common.Address{}) || greater != b {
+		t.Errorf("got lesser %s greater %s", lesser.Hex(), greater.Hex())
+	}
+	if lesser, greater := voteNeighbours(groups, votes, 2, big.NewInt(15)); lesser != b || greater != a {
+		t.Errorf("got lesser %s greater %s", lesser.Hex(), greater.Hex())
+	}
+	if lesser, greater := voteNeighbours(groups, votes, 1, big.NewInt(100)); lesser != a || greater != (common.Address{}) {
+		t.Errorf("got lesser %s greater %s", lesser.Hex(), greater.Hex())
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	wr := &WorkloadReport{}
+	for i := 10; i > 0; i-- {
+		wr.Latencies = append(wr.Latencies, time.Duration(i)*time.Second)
+	}
+	if p := wr.Percentile(50); p != 5*time.Second {
+		t.Errorf("p50 = %v", p)
+	}
+	if p := wr.Percentile(99); p != 10*time.Second {
+		t.Errorf("p99 = %v", p)
+	}
+	if p := (&WorkloadReport{}).Percentile(50); p != 0 {
+		t.Errorf("empty p50 = %v", p)
+	}
+}
+
+func TestStorageContract(t *testing.T) {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	cfg := &runtime.Config{State: statedb, BlockNumber: big.NewInt(7)}
+
+	_, address, _, err := runtime.Create(storageContractCode, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := runtime.Call(address, common.BigToHash(big.NewInt(3)).Bytes(), cfg); err != nil {
+		t.Fatal(err)
+	}
+	for i := int64(0); i < 4; i++ {
+		key := common.BigToHash(new(big.Int).Add(new(big.Int).Lsh(big.NewInt(7), 64), big.NewInt(i)))
+		expected := common.Hash{}
+		if i < 3 {
+			expected = common.BigToHash(common.Big1)
+		}
+		if value := statedb.GetState(address, key); value != expected {
+			t.Errorf("slot %d = %s, want %s", i, value.Hex(), expected.Hex())
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package loadbot
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
+
+	"github.com/aaronwinter/celo-blockchain/accounts/abi"
+	bind "github.com/aaronwinter/celo-blockchain/accounts/abi/bind_v2"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/common/math"
+	"github.com/aaronwinter/celo-blockchain/core/types"
+	"github.com/aaronwinter/celo-blockchain/ethclient"
+	"github.com/aaronwinter/celo-blockchain/mycelo/contract"
+	"github.com/aaronwinter/celo-blockchain/mycelo/env"
+)
+
+// storageContractCode deploys a contract that, when called with a uint256 n, writes n storage slots
+// keyed by the block number, so each block writes new slots
+var storageContractCode = hexutil.MustDecode("0x601e80600b6000396000f3" +
+	"60003560005b81811015601c576001814360401b01556001016005565b00")
+
+// feeCurrencies maps the fee currencies of a workload to the core contract used to pay fees
+var feeCurrencies = map[string]string{
+	"":     "",
+	"celo": "",
+	"cusd": "StableToken",
+	"ceur": "StableTokenEUR",
+}
+
+// gasLimits are used when gas estimation is skipped, and the workload doesn't set its own limit
+var gasLimits = map[string]uint64{
+	CeloTransferWorkload:   21000,
+	StableTransferWorkload: GasForTransferWithComment,
+	DeployWorkload:         100000,
+	ExchangeWorkload:       500000,
+	LockGoldWorkload:       150000,
+	VoteWorkload:           500000,
+	GatewayFeeWorkload:     21000,
+}
+
+// Extra gas for paying fees in a currency other than CELO
+const gasForAlternativeFeeCurrency = 50000
+
+// stableTokenFor returns the StableToken contract name for a currency
+func stableTokenFor(currency string) (string, error) {
+	switch currency {
+	case "", "cusd":
+		return "StableToken", nil
+	case "ceur":
+		return "StableTokenEUR", nil
+	default:
+		return "", fmt.Errorf("unknown currency %q", currency)
+	}
+}
+
+// exchangeFor returns the Exchange contract name for a currency
+func exchangeFor(currency string) string {
+	if currency == "ceur" {
+		return "ExchangeEUR"
+	}
+	return "Exchange"
+}
+
+// txRequest is a transaction to be sent by a workload
+type txRequest struct {
+	workload  *Workload
+	client    *ethclient.Client
+	opts      *bind.TransactOpts
+	recipient common.Address
+}
+
+// setFees sets the fee currency and gas limit of the request
+func (req *txRequest) setFees(skipGasEstimation bool) {
+	name := feeCurrencies[req.workload.FeeCurrency]
+	if req.workload.FeeCurrency == "mixed" && rand.Intn(2) == 1 {
+		name = "StableToken"
+	}
+	if name != "" {
+		feeCurrency := env.MustProxyAddressFor(name)
+		req.opts.FeeCurrency = &feeCurrency
+	}
+
+	req.opts.GasLimit = req.workload.GasLimit
+	if req.opts.GasLimit == 0 && skipGasEstimation {
+		if req.workload.Type == StorageWorkload {
+			req.opts.GasLimit = 30000 + 25000*req.workload.Slots
+		} else {
+			req.opts.GasLimit = gasLimits[req.workload.Type]
+		}
+		if req.opts.FeeCurrency != nil {
+			req.opts.GasLimit += gasForAlternativeFeeCurrency
+		}
+	}
+}
+
+func coreContract(name string, client *ethclient.Client) *bind.BoundContract {
+	return bind.NewBoundContract(env.MustProxyAddressFor(name), *contract.AbiFor(name), client)
+}
+
+// send sends the transaction for the request's workload
+func (lg *loadGenerator) send(ctx context.Context, req *txRequest) (*types.Transaction, error) {
+	w := req.workload
+	switch w.Type {
+	case CeloTransferWorkload:
+		req.opts.Value = w.Amount
+		return bind.NewBoundContract(req.recipient, abi.ABI{}, req.client).Transfer(req.opts)
+
+	case GatewayFeeWorkload:
+		req.opts.Value = w.Amount
+		req.opts.GatewayFee = w.GatewayFee
+		req.opts.GatewayFeeRecipient = &req.recipient
+		return bind.NewBoundContract(req.recipient, abi.ABI{}, req.client).Transfer(req.opts)
+
+	case StableTransferWorkload:
+		name, _ := stableTokenFor(w.Currency)
+		return coreContract(name, req.client).Transact(req.opts, "transferWithComment", req.recipient, w.Amount, "need to proivde some long comment to make it similar to an encrypted comment")
+
+	case DeployWorkload:
+		_, tx, _, err := bind.DeployContract(req.opts, abi.ABI{}, storageContractCode, req.client)
+		return tx, err
+
+	case StorageWorkload:
+		calldata := common.BigToHash(new(big.Int).SetUint64(w.Slots)).Bytes()
+		return bind.NewBoundContract(lg.storageContract, abi.ABI{}, req.client).RawTransact(req.opts, calldata)
+
+	case ExchangeWorkload:
+		return coreContract(exchangeFor(w.Currency), req.client).Transact(req.opts, "sell", w.Amount, common.Big0, true)
+
+	case LockGoldWorkload:
+		req.opts.Value = w.Amount
+		return coreContract("LockedGold", req.client).Transact(req.opts, "lock")
+
+	case VoteWorkload:
+		group, lesser, greater, err := pickGroup(ctx, req.client, w.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return coreContract("Election", req.client).Transact(req.opts, "vote", group, w.Amount, lesser, greater)
+	}
+	return nil, fmt.Errorf("unknown workload type %q", w.Type)
+}
+
+// pickGroup returns a random eligible validator group, and its neighbours on the sorted list of
+// groups once value is voted for it
+func pickGroup(ctx context.Context, client *ethclient.Client, value *big.Int) (group, lesser, greater common.Address, err error) {
+	var eligible struct {
+		Groups []common.Address
+		Values []*big.Int
+	}
+	if err = coreContract("Election", client).Call(&bind.CallOpts{Context: ctx}, &eligible, "getTotalVotesForEligibleValidatorGroups"); err != nil {
+		return
+	}
+	if len(eligible.Groups) == 0 {
+		err = fmt.Errorf("no eligible validator groups")
+		return
+	}
+	idx := rand.Intn(len(eligible.Groups))
+	group = eligible.Groups[idx]
+	lesser, greater = voteNeighbours(eligible.Groups, eligible.Values, idx, value)
+	return
+}
+
+// voteNeighbours returns the groups that will be right below and above groups[idx], when value is
+// added to its votes. groups must be sorted by descending votes.
+func voteNeighbours(groups []common.Address, votes []*big.Int, idx int, value *big.Int) (lesser, greater common.Address) {
+	total := new(big.Int).Add(votes[idx], value)
+	for i := range groups {
+		if i == idx {
+			continue
+		}
+		if votes[i].Cmp(total) >= 0 {
+			greater = groups[i]
+		} else {
+			lesser = groups[i]
+			break
+		}
+	}
+	return
+}
+
+// setupAccount sends the transactions an account needs before running the scenario workloads
+func (lg *loadGenerator) setupAccount(ctx context.Context, acc env.Account, client *ethclient.Client) error {
+	approved := make(map[string]bool)
+	for _, w := range lg.scenario.Workloads {
+		if w.Type != ExchangeWorkload || approved[w.Currency] {
+			continue
+		}
+		approved[w.Currency] = true
+		exchange := env.MustProxyAddressFor(exchangeFor(w.Currency))
+		if err := lg.setupTx(ctx, acc, client, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return coreContract("GoldToken", client).Transact(opts, "approve", exchange, math.MaxBig256)
+		}); err != nil {
+			return fmt.Errorf("approving %s: %w", exchangeFor(w.Currency), err)
+		}
+	}
+
+	if !lg.scenario.uses(LockGoldWorkload, VoteWorkload) {
+		return nil
+	}
+	var isAccount bool
+	if err := coreContract("Accounts", client).Call(&bind.CallOpts{Context: ctx}, &isAccount, "isAccount", acc.Address); err != nil {
+		return err
+	}
+	if !isAccount {
+		if err := lg.setupTx(ctx, acc, client, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return coreContract("Accounts", client).Transact(opts, "createAccount")
+		}); err != nil {
+			return fmt.Errorf("creating account: %w", err)
+		}
+	}
+	for _, w := range lg.scenario.Workloads {
+		if w.Type != VoteWorkload {
+			continue
+		}
+		lockedGold := w.LockedGold
+		if err := lg.setupTx(ctx, acc, client, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = lockedGold
+			return coreContract("LockedGold", client).Transact(opts, "lock")
+		}); err != nil {
+			return fmt.Errorf("locking gold: %w", err)
+		}
+	}
+	return nil
+}
+
+// deployStorageContract deploys the contract used by the storage workload
+func (lg *loadGenerator) deployStorageContract(ctx context.Context, acc env.Account, client *ethclient.Client) error {
+	return lg.setupTx(ctx, acc, client, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		address, tx, _, err := bind.DeployContract(opts, abi.ABI{}, storageContractCode, client)
+		lg.storageContract = address
+		return tx, err
+	})
+}
+
+// setupTx sends a transaction and waits for it to succeed
+func (lg *loadGenerator) setupTx(ctx context.Context, acc env.Account, client *ethclient.Client, send func(*bind.TransactOpts) (*types.Transaction, error)) error {
+	opts, err := lg.transactor(ctx, acc)
+	if err != nil {
+		return err
+	}
+	tx, err := send(opts)
+	if err != nil {
+		lg.nonces.reset(acc.Address)
+		return err
+	}
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s reverted", tx.Hash().Hex())
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"math/big"
 
 	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/hexutil"
 	"github.com/aaronwinter/celo-blockchain/crypto"
 )
 
@@ -48,6 +49,10 @@ var TrustedCheckpoints = map[common.Hash]*TrustedCheckpoint{}
 // the chain it belongs to.
 var CheckpointOracles = map[common.Hash]*CheckpointOracleConfig{}
 
+// TrustedEpochs associates each known trusted epoch with the genesis hash of
+// the chain it belongs to.
+var TrustedEpochs = map[common.Hash]*TrustedEpoch{}
+
 var (
 	// MainnetChainConfig is the chain parameters to run a node on the main network.
 	MainnetChainConfig = &ChainConfig{
@@ -129,19 +134,19 @@ var (
 		ProposerPolicy: 0,
 		RequestTimeout: 1000,
 		BlockPeriod:    1,
-	}, true, false}
+	}, true, false, nil}
 
 	IstanbulTestChainConfig = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), nil, nil, &IstanbulConfig{
 		Epoch:          300,
 		ProposerPolicy: 0,
 		RequestTimeout: 1000,
 		BlockPeriod:    1,
-	}, true, false}
+	}, true, false, nil}
 
 	TestChainConfig = &ChainConfig{big.NewInt(1), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), nil, nil, &IstanbulConfig{
 		Epoch:          30000,
 		ProposerPolicy: 0,
-	}, true, true}
+	}, true, true, nil}
 	TestRules = TestChainConfig.Rules(new(big.Int))
 )
 
@@ -187,6 +192,22 @@ type CheckpointOracleConfig struct {
 	Threshold uint64           `json:"threshold"`
 }
 
+// TrustedEpoch represents the last block of an epoch along with the validator set
+// elected in it. It is used to start lightest syncing from this epoch, instead of
+// verifying the validator set transitions of every epoch since genesis.
+type TrustedEpoch struct {
+	Number     uint64             `json:"number"`
+	Hash       common.Hash        `json:"hash"`
+	Header     hexutil.Bytes      `json:"header"` // RLP encoded header of the block
+	Validators []TrustedValidator `json:"validators"`
+}
+
+// TrustedValidator is a member of the validator set of a trusted epoch.
+type TrustedValidator struct {
+	Address      common.Address `json:"address"`
+	BLSPublicKey hexutil.Bytes  `json:"blsPublicKey"`
+}
+
 // ChainConfig is the core config which determines the blockchain settings.
 //
 // ChainConfig is stored in the database on a per block basis. This means
@@ -226,6 +247,21 @@ type ChainConfig struct {
 
 	// Requests mock engine if true
 	Faker bool `json:"faker,omitempty"`
+
+	// LocalFork is set on chains that were forked off an existing network to be
+	// continued locally (see `mycelo fork`). It is never set on a live network.
+	LocalFork *LocalForkConfig `json:"localFork,omitempty"`
+}
+
+// LocalForkConfig describes where a locally forked chain departs from the network
+// it was copied from.
+type LocalForkConfig struct {
+	// First block produced locally. Its parent is the last block of the original chain
+	// and was sealed by the original validator set.
+	Block *big.Int `json:"block"`
+
+	// Whether transactions can be sent on behalf of any account without its private key
+	Impersonation bool `json:"impersonation,omitempty"`
 }
 
 // IstanbulConfig is the consensus engine configs for Istanbul based sealing.
@@ -345,6 +381,21 @@ func (c *ChainConfig) IsEHardfork(num *big.Int) bool {
 	return isForked(c.EBlock, num)
 }
 
+// IsLocalFork returns whether num is a block produced locally on top of a forked chain
+func (c *ChainConfig) IsLocalFork(num *big.Int) bool {
+	return c.LocalFork != nil && isForked(c.LocalFork.Block, num)
+}
+
+// IsLocalForkBlock returns whether num is the first block produced locally on top of a forked chain
+func (c *ChainConfig) IsLocalForkBlock(num *big.Int) bool {
+	return c.LocalFork != nil && configNumEqual(c.LocalFork.Block, num)
+}
+
+// IsImpersonationEnabled returns whether transactions can impersonate any sender at block num
+func (c *ChainConfig) IsImpersonationEnabled(num *big.Int) bool {
+	return c.IsLocalFork(num) && c.LocalFork.Impersonation
+}
+
 // CheckCompatible checks whether scheduled fork transitions have been imported
 // with a mismatching chain configuration.
 func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *ConfigCompatError {
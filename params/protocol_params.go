@@ -18,7 +18,6 @@ package params
 
 import (
 	"github.com/aaronwinter/celo-blockchain/common"
-	"github.com/aaronwinter/celo-blockchain/common/hexutil"
 	"github.com/aaronwinter/celo-blockchain/common/math"
 	"github.com/aaronwinter/celo-blockchain/crypto"
 )
@@ -188,10 +187,13 @@ var (
 
 	// Celo registered contract IDs.
 	// The names are taken from celo-monorepo/packages/protocol/lib/registry-utils.ts
+	AccountsRegistryId             = makeRegistryId("Accounts")
 	AttestationsRegistryId         = makeRegistryId("Attestations")
 	BlockchainParametersRegistryId = makeRegistryId("BlockchainParameters")
 	ElectionRegistryId             = makeRegistryId("Election")
 	EpochRewardsRegistryId         = makeRegistryId("EpochRewards")
+	ExchangeRegistryId             = makeRegistryId("Exchange")
+	ExchangeEURRegistryId          = makeRegistryId("ExchangeEUR")
 	FeeCurrencyWhitelistRegistryId = makeRegistryId("FeeCurrencyWhitelist")
 	FreezerRegistryId              = makeRegistryId("Freezer")
 	GasPriceMinimumRegistryId      = makeRegistryId("GasPriceMinimum")
@@ -202,16 +204,10 @@ var (
 	ReserveRegistryId              = makeRegistryId("Reserve")
 	SortedOraclesRegistryId        = makeRegistryId("SortedOracles")
 	StableTokenRegistryId          = makeRegistryId("StableToken")
+	StableTokenEURRegistryId       = makeRegistryId("StableTokenEUR")
 	TransferWhitelistRegistryId    = makeRegistryId("TransferWhitelist")
 	ValidatorsRegistryId           = makeRegistryId("Validators")
 
-	// Function is "getOrComputeTobinTax()"
-	// selector is first 4 bytes of keccak256 of "getOrComputeTobinTax()"
-	// Source:
-	// pip3 install pyethereum
-	// python3 -c 'from ethereum.utils import sha3; print(sha3("getOrComputeTobinTax()")[0:4].hex())'
-	TobinTaxFunctionSelector = hexutil.MustDecode("0x17f9a6f7")
-
 	// Scale factor for the solidity fixidity library
 	Fixidity1 = math.BigPow(10, 24)
 )
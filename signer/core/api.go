@@ -29,10 +29,12 @@ import (
 	"github.com/aaronwinter/celo-blockchain/accounts/usbwallet"
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
 	"github.com/aaronwinter/celo-blockchain/internal/ethapi"
 	"github.com/aaronwinter/celo-blockchain/log"
 	"github.com/aaronwinter/celo-blockchain/rlp"
 	"github.com/aaronwinter/celo-blockchain/shared/signer"
+	"github.com/aaronwinter/celo-blockchain/signer/slashing"
 	"github.com/aaronwinter/celo-blockchain/signer/storage"
 )
 
@@ -40,9 +42,9 @@ const (
 	// numberOfAccountsToDerive For hardware wallets, the number of accounts to derive
 	numberOfAccountsToDerive = 10
 	// ExternalAPIVersion -- see extapi_changelog.md
-	ExternalAPIVersion = "6.0.0"
+	ExternalAPIVersion = "6.5.0"
 	// InternalAPIVersion -- see intapi_changelog.md
-	InternalAPIVersion = "7.0.1"
+	InternalAPIVersion = "7.2.0"
 )
 
 // ExternalAPI defines the external API through which signing requests are made.
@@ -59,6 +61,16 @@ type ExternalAPI interface {
 	SignTypedData(ctx context.Context, addr common.MixedcaseAddress, data signer.TypedData) (hexutil.Bytes, error)
 	// EcRecover - recover public key from given message and signature
 	EcRecover(ctx context.Context, data hexutil.Bytes, sig hexutil.Bytes) (common.Address, error)
+	// SignBLS - request to sign a consensus message with the account's BLS key
+	SignBLS(ctx context.Context, addr common.MixedcaseAddress, msg, extraData hexutil.Bytes, useComposite, cip22 bool, publicKey *blscrypto.SerializedPublicKey) (hexutil.Bytes, error)
+	// Decrypt - request to decrypt an ECIES ciphertext with the account's key
+	Decrypt(ctx context.Context, addr common.MixedcaseAddress, ciphertext, s1, s2 hexutil.Bytes) (hexutil.Bytes, error)
+	// GenerateProofOfPossession - request to prove possession of the account's ECDSA key for an address
+	GenerateProofOfPossession(ctx context.Context, addr common.MixedcaseAddress, signee common.Address) (*ProofOfPossessionResult, error)
+	// GenerateProofOfPossessionBLS - request to prove possession of the account's BLS key for an address
+	GenerateProofOfPossessionBLS(ctx context.Context, addr common.MixedcaseAddress, signee common.Address) (*ProofOfPossessionResult, error)
+	// PublicKey - request the ECDSA public key of the account
+	PublicKey(ctx context.Context, addr common.MixedcaseAddress) (hexutil.Bytes, error)
 	// Version info about the APIs
 	Version(ctx context.Context) (string, error)
 }
@@ -75,6 +87,12 @@ type UIClientAPI interface {
 	ApproveListing(request *ListRequest) (ListResponse, error)
 	// ApproveNewAccount prompt the user for confirmation to create new Account, and reveal to caller
 	ApproveNewAccount(request *NewAccountRequest) (NewAccountResponse, error)
+	// ApproveSignBLS prompt the user for confirmation to request to sign a consensus message with a BLS key
+	ApproveSignBLS(request *SignBLSRequest) (SignBLSResponse, error)
+	// ApproveDecrypt prompt the user for confirmation to request to decrypt an ECIES ciphertext
+	ApproveDecrypt(request *DecryptRequest) (DecryptResponse, error)
+	// ApproveProofOfPossession prompt the user for confirmation to request to generate a proof-of-possession
+	ApproveProofOfPossession(request *ProofOfPossessionRequest) (ProofOfPossessionResponse, error)
 	// ShowError displays error message to user
 	ShowError(message string)
 	// ShowInfo displays info message to user
@@ -113,6 +131,7 @@ type SignerAPI struct {
 	validator   Validator
 	rejectMode  bool
 	credentials storage.Storage
+	protection  *slashing.Database
 }
 
 // Metadata about a request
@@ -226,6 +245,40 @@ type (
 	NewAccountResponse struct {
 		Approved bool `json:"approved"`
 	}
+	// SignBLSRequest contains info about a consensus message to sign with a BLS key
+	SignBLSRequest struct {
+		Address      common.MixedcaseAddress        `json:"address"`
+		PublicKey    *blscrypto.SerializedPublicKey `json:"public_key,omitempty"`
+		Message      hexutil.Bytes                  `json:"message"`
+		ExtraData    hexutil.Bytes                  `json:"extra_data"`
+		UseComposite bool                           `json:"use_composite"`
+		CIP22        bool                           `json:"cip22"`
+		Meta         Metadata                       `json:"meta"`
+	}
+	SignBLSResponse struct {
+		Approved bool `json:"approved"`
+	}
+	// DecryptRequest contains info about an ECIES ciphertext to decrypt, e.g. an
+	// encrypted enode URL from an istanbul announce message
+	DecryptRequest struct {
+		Address    common.MixedcaseAddress `json:"address"`
+		Ciphertext hexutil.Bytes           `json:"ciphertext"`
+		Meta       Metadata                `json:"meta"`
+	}
+	DecryptResponse struct {
+		Approved bool `json:"approved"`
+	}
+	// ProofOfPossessionRequest contains info about a proof-of-possession to generate
+	// for the account's ECDSA or BLS key
+	ProofOfPossessionRequest struct {
+		Address common.MixedcaseAddress `json:"address"`
+		Signee  common.Address          `json:"signee"`
+		BLS     bool                    `json:"bls"`
+		Meta    Metadata                `json:"meta"`
+	}
+	ProofOfPossessionResponse struct {
+		Approved bool `json:"approved"`
+	}
 	ListRequest struct {
 		Accounts []accounts.Account `json:"accounts"`
 		Meta     Metadata           `json:"meta"`
@@ -256,11 +309,13 @@ var ErrRequestDenied = errors.New("request denied")
 // key that is generated when a new Account is created.
 // noUSB disables USB support that is required to support hardware devices such as
 // ledger and trezor.
-func NewSignerAPI(am *accounts.Manager, chainID int64, noUSB bool, ui UIClientAPI, validator Validator, advancedMode bool, credentials storage.Storage) *SignerAPI {
+// protection, if set, guards the consensus messages signed by validator keys
+// against double signing.
+func NewSignerAPI(am *accounts.Manager, chainID int64, noUSB bool, ui UIClientAPI, validator Validator, advancedMode bool, credentials storage.Storage, protection *slashing.Database) *SignerAPI {
 	if advancedMode {
 		log.Info("Clef is in advanced mode: will warn instead of reject")
 	}
-	signer := &SignerAPI{big.NewInt(chainID), am, ui, validator, !advancedMode, credentials}
+	signer := &SignerAPI{big.NewInt(chainID), am, ui, validator, !advancedMode, credentials, protection}
 	if !noUSB {
 		signer.startUSBListener()
 	}
@@ -461,6 +516,22 @@ func logDiff(original *SignTxRequest, new *SignTxResponse) bool {
 		modified = true
 		log.Info("Nonce changed by UI", "was", n0, "is", n1)
 	}
+	if c0, c1 := original.Transaction.FeeCurrency, new.Transaction.FeeCurrency; !reflect.DeepEqual(c0, c1) {
+		modified = true
+		log.Info("FeeCurrency changed by UI", "was", c0, "is", c1)
+	}
+	if r0, r1 := original.Transaction.GatewayFeeRecipient, new.Transaction.GatewayFeeRecipient; !reflect.DeepEqual(r0, r1) {
+		modified = true
+		log.Info("GatewayFeeRecipient changed by UI", "was", r0, "is", r1)
+	}
+	if g0, g1 := big.Int(original.Transaction.GatewayFee), big.Int(new.Transaction.GatewayFee); g0.Cmp(&g1) != 0 {
+		modified = true
+		log.Info("GatewayFee changed by UI", "was", g0, "is", g1)
+	}
+	if e0, e1 := original.Transaction.EthCompatible, new.Transaction.EthCompatible; e0 != e1 {
+		modified = true
+		log.Info("EthCompatible changed by UI", "was", e0, "is", e1)
+	}
 	return modified
 }
 
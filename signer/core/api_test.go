@@ -75,6 +75,21 @@ func (ui *headlessUi) ApproveSignData(request *core.SignDataRequest) (core.SignD
 	return core.SignDataResponse{approved}, nil
 }
 
+func (ui *headlessUi) ApproveSignBLS(request *core.SignBLSRequest) (core.SignBLSResponse, error) {
+	approved := (<-ui.approveCh == "Y")
+	return core.SignBLSResponse{approved}, nil
+}
+
+func (ui *headlessUi) ApproveDecrypt(request *core.DecryptRequest) (core.DecryptResponse, error) {
+	approved := (<-ui.approveCh == "Y")
+	return core.DecryptResponse{approved}, nil
+}
+
+func (ui *headlessUi) ApproveProofOfPossession(request *core.ProofOfPossessionRequest) (core.ProofOfPossessionResponse, error) {
+	approved := (<-ui.approveCh == "Y")
+	return core.ProofOfPossessionResponse{approved}, nil
+}
+
 func (ui *headlessUi) ApproveListing(request *core.ListRequest) (core.ListResponse, error) {
 	approval := <-ui.approveCh
 	//fmt.Printf("approval %s\n", approval)
@@ -126,7 +141,7 @@ func setup(t *testing.T) (*core.SignerAPI, *headlessUi) {
 	}
 	ui := &headlessUi{make(chan string, 20), make(chan string, 20)}
 	am := core.StartClefAccountManager(tmpDirName(t), true, true)
-	api := core.NewSignerAPI(am, 1337, true, ui, db, true, &storage.NoStorage{})
+	api := core.NewSignerAPI(am, 1337, true, ui, db, true, &storage.NoStorage{}, nil)
 	return api, ui
 
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
 	"github.com/aaronwinter/celo-blockchain/internal/ethapi"
 	"github.com/aaronwinter/celo-blockchain/log"
 	"github.com/aaronwinter/celo-blockchain/shared/signer"
@@ -50,6 +51,7 @@ func (l *AuditLogger) SignTransaction(ctx context.Context, args SendTxArgs, meth
 	}
 	l.log.Info("SignTransaction", "type", "request", "metadata", MetadataFromContext(ctx).String(),
 		"tx", args.String(),
+		"feeCurrency", args.FeeCurrency, "gatewayFeeRecipient", args.GatewayFeeRecipient, "gatewayFee", args.GatewayFee.ToInt(),
 		"methodSelector", sel)
 
 	res, e := l.api.SignTransaction(ctx, args, methodSelector)
@@ -85,6 +87,46 @@ func (l *AuditLogger) EcRecover(ctx context.Context, data hexutil.Bytes, sig hex
 	return b, e
 }
 
+func (l *AuditLogger) SignBLS(ctx context.Context, addr common.MixedcaseAddress, msg, extraData hexutil.Bytes, useComposite, cip22 bool, publicKey *blscrypto.SerializedPublicKey) (hexutil.Bytes, error) {
+	l.log.Info("SignBLS", "type", "request", "metadata", MetadataFromContext(ctx).String(),
+		"addr", addr.String(), "publicKey", publicKey, "msg", msg, "extraData", extraData, "composite", useComposite, "cip22", cip22)
+	b, e := l.api.SignBLS(ctx, addr, msg, extraData, useComposite, cip22, publicKey)
+	l.log.Info("SignBLS", "type", "response", "data", common.Bytes2Hex(b), "error", e)
+	return b, e
+}
+
+func (l *AuditLogger) Decrypt(ctx context.Context, addr common.MixedcaseAddress, ciphertext, s1, s2 hexutil.Bytes) (hexutil.Bytes, error) {
+	l.log.Info("Decrypt", "type", "request", "metadata", MetadataFromContext(ctx).String(),
+		"addr", addr.String(), "ciphertext", ciphertext)
+	b, e := l.api.Decrypt(ctx, addr, ciphertext, s1, s2)
+	// The plaintext is not logged
+	l.log.Info("Decrypt", "type", "response", "len", len(b), "error", e)
+	return b, e
+}
+
+func (l *AuditLogger) GenerateProofOfPossession(ctx context.Context, addr common.MixedcaseAddress, signee common.Address) (*ProofOfPossessionResult, error) {
+	l.log.Info("GenerateProofOfPossession", "type", "request", "metadata", MetadataFromContext(ctx).String(),
+		"addr", addr.String(), "signee", signee)
+	res, e := l.api.GenerateProofOfPossession(ctx, addr, signee)
+	l.log.Info("GenerateProofOfPossession", "type", "response", "data", res, "error", e)
+	return res, e
+}
+
+func (l *AuditLogger) GenerateProofOfPossessionBLS(ctx context.Context, addr common.MixedcaseAddress, signee common.Address) (*ProofOfPossessionResult, error) {
+	l.log.Info("GenerateProofOfPossessionBLS", "type", "request", "metadata", MetadataFromContext(ctx).String(),
+		"addr", addr.String(), "signee", signee)
+	res, e := l.api.GenerateProofOfPossessionBLS(ctx, addr, signee)
+	l.log.Info("GenerateProofOfPossessionBLS", "type", "response", "data", res, "error", e)
+	return res, e
+}
+
+func (l *AuditLogger) PublicKey(ctx context.Context, addr common.MixedcaseAddress) (hexutil.Bytes, error) {
+	l.log.Info("PublicKey", "type", "request", "metadata", MetadataFromContext(ctx).String(), "addr", addr.String())
+	b, e := l.api.PublicKey(ctx, addr)
+	l.log.Info("PublicKey", "type", "response", "data", common.Bytes2Hex(b), "error", e)
+	return b, e
+}
+
 func (l *AuditLogger) Version(ctx context.Context) (string, error) {
 	l.log.Info("Version", "type", "request", "metadata", MetadataFromContext(ctx).String())
 	data, err := l.api.Version(ctx)
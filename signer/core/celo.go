@@ -0,0 +1,243 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aaronwinter/celo-blockchain/accounts"
+	"github.com/aaronwinter/celo-blockchain/accounts/keystore"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/crypto"
+	blscrypto "github.com/aaronwinter/celo-blockchain/crypto/bls"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/rlp"
+	"github.com/aaronwinter/celo-blockchain/shared/signer"
+)
+
+var errPublicKeyUnavailable = errors.New("public key unavailable")
+
+// ProofOfPossessionResult is the public key of an account along with its
+// signature proving possession of the key for a given address.
+type ProofOfPossessionResult struct {
+	PublicKey hexutil.Bytes `json:"publicKey"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// unlockKeystoreAccount looks up the password based account for addr and its
+// password. Validator key operations are only supported on keystore accounts.
+func (api *SignerAPI) unlockKeystoreAccount(addr common.Address, title, prompt string) (*keystore.KeyStore, accounts.Account, string, error) {
+	be := api.am.Backends(keystore.KeyStoreType)
+	if len(be) == 0 {
+		return nil, accounts.Account{}, "", errors.New("password based accounts not supported")
+	}
+	ks := be[0].(*keystore.KeyStore)
+	account, err := ks.Find(accounts.Account{Address: addr})
+	if err != nil {
+		return nil, accounts.Account{}, "", err
+	}
+	pw, err := api.lookupOrQueryPassword(addr, title, fmt.Sprintf(prompt, addr.Hex()))
+	if err != nil {
+		return nil, accounts.Account{}, "", err
+	}
+	return ks, account, pw, nil
+}
+
+// SignBLS signs a consensus message with the BLS key of the account, using the
+// direct or composite (optionally CIP-22) hasher. The optional public key selects
+// one of the account's BLS keys, the key derived from its ECDSA key being used
+// by default.
+func (api *SignerAPI) SignBLS(ctx context.Context, addr common.MixedcaseAddress, msg, extraData hexutil.Bytes, useComposite, cip22 bool, publicKey *blscrypto.SerializedPublicKey) (hexutil.Bytes, error) {
+	req := &SignBLSRequest{
+		Address:      addr,
+		PublicKey:    publicKey,
+		Message:      msg,
+		ExtraData:    extraData,
+		UseComposite: useComposite,
+		CIP22:        cip22,
+		Meta:         MetadataFromContext(ctx),
+	}
+	// We make the request prior to looking up if we actually have the account, to prevent
+	// account-enumeration via the API
+	res, err := api.UI.ApproveSignBLS(req)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Approved {
+		return nil, ErrRequestDenied
+	}
+	ks, account, pw, err := api.unlockKeystoreAccount(addr.Address(), "Password for BLS signing",
+		"Please enter password for signing a consensus message with the BLS key of account %s")
+	if err != nil {
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	// Make sure consensus seals can't get the validator slashed
+	if api.protection != nil {
+		if err := api.protection.CheckBLS(account.Address, msg, useComposite); err != nil {
+			err = fmt.Errorf("slashing protection: %v", err)
+			api.UI.ShowError(err.Error())
+			return nil, err
+		}
+	}
+	signature, err := ks.SignBLSWithPassphrase(account, pw, req.blsPublicKey(), msg, extraData, useComposite, cip22)
+	if err != nil {
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	return signature[:], nil
+}
+
+// blsPublicKey returns the public key of the BLS key to sign the request with,
+// the empty key selecting the one derived from the account's ECDSA key.
+func (req *SignBLSRequest) blsPublicKey() blscrypto.SerializedPublicKey {
+	if req.PublicKey == nil {
+		return blscrypto.SerializedPublicKey{}
+	}
+	return *req.PublicKey
+}
+
+// Decrypt decrypts an ECIES ciphertext with the ECDSA key of the account.
+func (api *SignerAPI) Decrypt(ctx context.Context, addr common.MixedcaseAddress, ciphertext, s1, s2 hexutil.Bytes) (hexutil.Bytes, error) {
+	req := &DecryptRequest{
+		Address:    addr,
+		Ciphertext: ciphertext,
+		Meta:       MetadataFromContext(ctx),
+	}
+	res, err := api.UI.ApproveDecrypt(req)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Approved {
+		return nil, ErrRequestDenied
+	}
+	ks, account, pw, err := api.unlockKeystoreAccount(addr.Address(), "Password for decryption",
+		"Please enter password for decrypting data with account %s")
+	if err != nil {
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	plaintext, err := ks.DecryptWithPassphrase(account, pw, ciphertext, s1, s2)
+	if err != nil {
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	return plaintext, nil
+}
+
+// GenerateProofOfPossession signs the signee address with the ECDSA key of the
+// account, proving possession of the key.
+func (api *SignerAPI) GenerateProofOfPossession(ctx context.Context, addr common.MixedcaseAddress, signee common.Address) (*ProofOfPossessionResult, error) {
+	return api.generateProofOfPossession(ctx, addr, signee, false)
+}
+
+// GenerateProofOfPossessionBLS signs the signee address with the BLS key of the
+// account, as expected by Validators.registerValidator and updateBlsPublicKey.
+func (api *SignerAPI) GenerateProofOfPossessionBLS(ctx context.Context, addr common.MixedcaseAddress, signee common.Address) (*ProofOfPossessionResult, error) {
+	return api.generateProofOfPossession(ctx, addr, signee, true)
+}
+
+func (api *SignerAPI) generateProofOfPossession(ctx context.Context, addr common.MixedcaseAddress, signee common.Address, bls bool) (*ProofOfPossessionResult, error) {
+	req := &ProofOfPossessionRequest{
+		Address: addr,
+		Signee:  signee,
+		BLS:     bls,
+		Meta:    MetadataFromContext(ctx),
+	}
+	res, err := api.UI.ApproveProofOfPossession(req)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Approved {
+		return nil, ErrRequestDenied
+	}
+	ks, account, pw, err := api.unlockKeystoreAccount(addr.Address(), "Password for proof-of-possession",
+		"Please enter password for generating a proof-of-possession with account %s")
+	if err != nil {
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	generate := ks.GenerateProofOfPossessionWithPassphrase
+	if bls {
+		generate = ks.GenerateProofOfPossessionBLSWithPassphrase
+	}
+	publicKey, signature, err := generate(account, pw, signee)
+	if err != nil {
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	return &ProofOfPossessionResult{PublicKey: publicKey, Signature: signature}, nil
+}
+
+// PublicKey returns the uncompressed ECDSA public key of the account. Public keys
+// aren't secret, but the key file has to be decrypted to get it, so the account's
+// password is required. Failures are reported uniformly to prevent account
+// enumeration via the API.
+func (api *SignerAPI) PublicKey(ctx context.Context, addr common.MixedcaseAddress) (hexutil.Bytes, error) {
+	ks, account, pw, err := api.unlockKeystoreAccount(addr.Address(), "Password for public key",
+		"Please enter password for retrieving the public key of account %s")
+	if err != nil {
+		log.Debug("Public key unavailable", "address", addr.Address(), "err", err)
+		return nil, errPublicKeyUnavailable
+	}
+	publicKey, err := ks.GetPublicKeyWithPassphrase(account, pw)
+	if err != nil {
+		log.Debug("Public key unavailable", "address", addr.Address(), "err", err)
+		return nil, errPublicKeyUnavailable
+	}
+	return crypto.FromECDSAPub(publicKey), nil
+}
+
+// istanbulMessageFields describes an istanbul message payload for the UI,
+// detailing the view of consensus messages.
+func istanbulMessageFields(payload []byte) []*signer.NameValueType {
+	fields := []*signer.NameValueType{
+		{Name: "Istanbul message", Typ: "hexdata", Value: hexutil.Encode(payload)},
+	}
+	var msg istanbul.Message
+	if err := rlp.DecodeBytes(payload, &msg); err != nil {
+		return fields
+	}
+	var (
+		kind    string
+		subject *istanbul.Subject
+		view    *istanbul.View
+	)
+	switch {
+	case msg.Code == istanbul.MsgPrepare && msg.Prepare() != nil:
+		kind, subject = "PREPARE", msg.Prepare()
+	case msg.Code == istanbul.MsgCommit && msg.Commit() != nil:
+		kind, subject = "COMMIT", msg.Commit().Subject
+	case msg.Code == istanbul.MsgRoundChange && msg.RoundChange() != nil:
+		kind, view = "ROUND CHANGE", msg.RoundChange().View
+	default:
+		return append(fields, &signer.NameValueType{Name: "Code", Typ: "uint64", Value: fmt.Sprintf("%d", msg.Code)})
+	}
+	fields = append(fields, &signer.NameValueType{Name: "Type", Typ: "string", Value: kind})
+	if subject != nil {
+		view = subject.View
+		fields = append(fields, &signer.NameValueType{Name: "Digest", Typ: "hash", Value: subject.Digest.Hex()})
+	}
+	if view != nil {
+		fields = append(fields, &signer.NameValueType{Name: "View", Typ: "string", Value: view.String()})
+	}
+	return fields
+}
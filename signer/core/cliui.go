@@ -116,7 +116,21 @@ func (ui *CommandlineUI) ApproveTx(request *SignTxRequest) (SignTxResponse, erro
 	fmt.Printf("from:     %v\n", request.Transaction.From.String())
 	fmt.Printf("value:    %v wei\n", weival)
 	fmt.Printf("gas:      %v (%v)\n", request.Transaction.Gas, uint64(request.Transaction.Gas))
-	fmt.Printf("gasprice: %v wei\n", request.Transaction.GasPrice.ToInt())
+	if currency := request.Transaction.FeeCurrency; currency != nil {
+		fmt.Printf("gasprice: %v (in wei of fee currency %v)\n", request.Transaction.GasPrice.ToInt(), currency.Original())
+		if !currency.ValidChecksum() {
+			fmt.Printf("\nWARNING: Invalid checksum on fee currency address!\n\n")
+		}
+	} else {
+		fmt.Printf("gasprice: %v wei\n", request.Transaction.GasPrice.ToInt())
+	}
+	if recipient := request.Transaction.GatewayFeeRecipient; recipient != nil {
+		fmt.Printf("gateway:  %v\n", recipient.Original())
+		fmt.Printf("gatewayfee: %v\n", request.Transaction.GatewayFee.ToInt())
+	}
+	if request.Transaction.EthCompatible {
+		fmt.Printf("ethcompatible: true\n")
+	}
 	fmt.Printf("nonce:    %v (%v)\n", request.Transaction.Nonce, uint64(request.Transaction.Nonce))
 	if request.Transaction.Data != nil {
 		d := *request.Transaction.Data
@@ -162,6 +176,64 @@ func (ui *CommandlineUI) ApproveSignData(request *SignDataRequest) (SignDataResp
 	return SignDataResponse{true}, nil
 }
 
+// ApproveSignBLS prompt the user for confirmation to request to sign a consensus message with a BLS key
+func (ui *CommandlineUI) ApproveSignBLS(request *SignBLSRequest) (SignBLSResponse, error) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+
+	fmt.Printf("-------- BLS sign request--------------\n")
+	fmt.Printf("Account:    %s\n", request.Address.String())
+	if request.PublicKey != nil {
+		fmt.Printf("BLS key:    %x\n", request.PublicKey[:])
+	}
+	fmt.Printf("message:    %v\n", request.Message)
+	fmt.Printf("extra data: %v\n", request.ExtraData)
+	fmt.Printf("composite:  %v (cip22: %v)\n", request.UseComposite, request.CIP22)
+	fmt.Printf("-------------------------------------------\n")
+	showMetadata(request.Meta)
+	if !ui.confirm() {
+		return SignBLSResponse{false}, nil
+	}
+	return SignBLSResponse{true}, nil
+}
+
+// ApproveDecrypt prompt the user for confirmation to request to decrypt an ECIES ciphertext
+func (ui *CommandlineUI) ApproveDecrypt(request *DecryptRequest) (DecryptResponse, error) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+
+	fmt.Printf("-------- Decrypt request--------------\n")
+	fmt.Printf("Account:    %s\n", request.Address.String())
+	fmt.Printf("ciphertext: %v\n", request.Ciphertext)
+	fmt.Printf("-------------------------------------------\n")
+	showMetadata(request.Meta)
+	if !ui.confirm() {
+		return DecryptResponse{false}, nil
+	}
+	return DecryptResponse{true}, nil
+}
+
+// ApproveProofOfPossession prompt the user for confirmation to request to generate a proof-of-possession
+func (ui *CommandlineUI) ApproveProofOfPossession(request *ProofOfPossessionRequest) (ProofOfPossessionResponse, error) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+
+	key := "ECDSA"
+	if request.BLS {
+		key = "BLS"
+	}
+	fmt.Printf("-------- Proof-of-possession request--------------\n")
+	fmt.Printf("Account:  %s\n", request.Address.String())
+	fmt.Printf("key:      %s\n", key)
+	fmt.Printf("signee:   %s\n", request.Signee.Hex())
+	fmt.Printf("-------------------------------------------\n")
+	showMetadata(request.Meta)
+	if !ui.confirm() {
+		return ProofOfPossessionResponse{false}, nil
+	}
+	return ProofOfPossessionResponse{true}, nil
+}
+
 // ApproveListing prompt the user for confirmation to list accounts
 // the list of accounts to list can be modified by the UI
 func (ui *CommandlineUI) ApproveListing(request *ListRequest) (ListResponse, error) {
@@ -17,6 +17,7 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -25,6 +26,7 @@ import (
 	"github.com/aaronwinter/celo-blockchain/accounts"
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
 	"github.com/aaronwinter/celo-blockchain/crypto"
 	"github.com/aaronwinter/celo-blockchain/shared/signer"
 )
@@ -75,6 +77,12 @@ func (api *SignerAPI) sign(addr common.MixedcaseAddress, req *SignDataRequest, l
 	if err != nil {
 		return nil, err
 	}
+	// Make sure consensus messages can't get the validator slashed
+	if req.ContentType == accounts.MimetypeIstanbul && api.protection != nil {
+		if err := api.protection.CheckMessage(account.Address, req.Rawdata); err != nil {
+			return nil, fmt.Errorf("slashing protection: %v", err)
+		}
+	}
 	// Sign the data with the wallet
 	signature, err := wallet.SignDataWithPassphrase(account, pw, req.ContentType, req.Rawdata)
 	if err != nil {
@@ -150,6 +158,41 @@ func (api *SignerAPI) determineSignatureFormat(ctx context.Context, contentType
 			},
 		}
 		req = &SignDataRequest{ContentType: mediaType, Rawdata: []byte(msg), Messages: messages, Hash: sighash}
+	case accounts.MimetypeIstanbul:
+		// Istanbul consensus messages are signed as is, with V = 0 or 1
+		useEthereumV = false
+		stringData, ok := data.(string)
+		if !ok {
+			return nil, useEthereumV, fmt.Errorf("input for %s must be an hex-encoded string", accounts.MimetypeIstanbul)
+		}
+		payload, err := hexutil.Decode(stringData)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		req = &SignDataRequest{ContentType: mediaType, Rawdata: payload, Messages: istanbulMessageFields(payload), Hash: crypto.Keccak256(payload)}
+	case accounts.MimetypeIstanbulRandomSeed:
+		// The randomness seed hash is signed as is, with V = 0 or 1. No other hash is
+		// accepted, as it could be the hash of a transaction.
+		useEthereumV = false
+		stringData, ok := data.(string)
+		if !ok {
+			return nil, useEthereumV, fmt.Errorf("input for %s must be an hex-encoded string", accounts.MimetypeIstanbulRandomSeed)
+		}
+		payload, err := hexutil.Decode(stringData)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		if !bytes.Equal(payload, istanbul.RandomSeedHash[:]) {
+			return nil, useEthereumV, fmt.Errorf("only the istanbul randomness seed hash %s can be signed as %s", istanbul.RandomSeedHash.Hex(), accounts.MimetypeIstanbulRandomSeed)
+		}
+		messages := []*signer.NameValueType{
+			{
+				Name:  "This is a request to sign the seed of the randomness committed to by the validator when proposing blocks",
+				Typ:   "description",
+				Value: "",
+			},
+		}
+		req = &SignDataRequest{ContentType: mediaType, Rawdata: payload, Messages: messages, Hash: payload}
 	default: // also case TextPlain.Mime:
 		// Calculates an Ethereum ECDSA signature for:
 		// hash = keccak256("\x19${byteVersion}Ethereum Signed Message:\n${message length}${message}")
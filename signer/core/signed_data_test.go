@@ -18,12 +18,18 @@ package core_test
 
 import (
 	"context"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/aaronwinter/celo-blockchain/accounts"
+	"github.com/aaronwinter/celo-blockchain/accounts/external"
 	"github.com/aaronwinter/celo-blockchain/accounts/keystore"
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
 	"github.com/aaronwinter/celo-blockchain/common/math"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/crypto"
+	"github.com/aaronwinter/celo-blockchain/rpc"
 	"github.com/aaronwinter/celo-blockchain/shared/signer"
 	"github.com/aaronwinter/celo-blockchain/signer/core"
 )
@@ -152,3 +158,49 @@ func TestSignData(t *testing.T) {
 		t.Errorf("Expected 65 byte signature (got %d bytes)", len(signature))
 	}
 }
+
+// Tests that a validator using clef through the external signer can sign the seed of
+// the randomness it commits to when proposing, and nothing else with the same request.
+func TestSignRandomSeedWithExternalSigner(t *testing.T) {
+	api, control := setup(t)
+	createAccount(control, api, t)
+	control.approveCh <- "A"
+	list, err := api.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := accounts.Account{Address: list[0]}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("account", api); err != nil {
+		t.Fatal(err)
+	}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	wallet, err := external.NewExternalSigner(httpServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The istanbul backend signs the seed through the SignHash of the validator wallet
+	control.approveCh <- "Y"
+	control.inputCh <- "a_long_password"
+	seed, err := wallet.SignHash(account, istanbul.RandomSeedHash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seed) != 65 || seed[64] > 1 {
+		t.Fatalf("Expected 65 byte signature with V = 0 or 1, got %x", seed)
+	}
+	pubkey, err := crypto.SigToPub(istanbul.RandomSeedHash[:], seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubkey); signer != account.Address {
+		t.Errorf("Seed signed by %x, expected %x", signer, account.Address)
+	}
+
+	if _, err := wallet.SignHash(account, crypto.Keccak256([]byte("EHLO world"))); err == nil {
+		t.Error("Expected signing an arbitrary hash to fail")
+	}
+}
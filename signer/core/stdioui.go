@@ -84,6 +84,24 @@ func (ui *StdIOUI) ApproveNewAccount(request *NewAccountRequest) (NewAccountResp
 	return result, err
 }
 
+func (ui *StdIOUI) ApproveSignBLS(request *SignBLSRequest) (SignBLSResponse, error) {
+	var result SignBLSResponse
+	err := ui.dispatch("ui_approveSignBLS", request, &result)
+	return result, err
+}
+
+func (ui *StdIOUI) ApproveDecrypt(request *DecryptRequest) (DecryptResponse, error) {
+	var result DecryptResponse
+	err := ui.dispatch("ui_approveDecrypt", request, &result)
+	return result, err
+}
+
+func (ui *StdIOUI) ApproveProofOfPossession(request *ProofOfPossessionRequest) (ProofOfPossessionResponse, error) {
+	var result ProofOfPossessionResponse
+	err := ui.dispatch("ui_approveProofOfPossession", request, &result)
+	return result, err
+}
+
 func (ui *StdIOUI) ShowError(message string) {
 	err := ui.notify("ui_showError", &Message{message})
 	if err != nil {
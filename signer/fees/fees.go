@@ -0,0 +1,203 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package fees implements the policy on the Celo fee fields of the transactions
+// the signer is asked to sign.
+package fees
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/aaronwinter/celo-blockchain"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/params"
+	"github.com/aaronwinter/celo-blockchain/signer/core"
+)
+
+const (
+	whitelistCacheTTL   = 5 * time.Minute  // How long the fee currency whitelist is trusted
+	whitelistFailureTTL = 30 * time.Second // How long a failure to look it up is remembered
+	whitelistTimeout    = 2 * time.Second  // Timeout of looking up the whitelist
+)
+
+// DefaultMaxGatewayFee is the highest gateway fee transactions may pay unless
+// configured otherwise: 0.01 of the fee currency.
+var DefaultMaxGatewayFee = big.NewInt(1e16)
+
+// Policy restricts the fee currencies and the gateway fees of transactions.
+// Fees may be paid in the native currency, and in the currencies configured
+// explicitly or, failing that, whitelisted on chain.
+type Policy struct {
+	currencies    map[common.Address]struct{} // Fee currencies configured explicitly, overriding the whitelist
+	maxGatewayFee *big.Int                    // Highest gateway fee transactions may pay
+	caller        ethereum.ContractCaller     // Node to look up the FeeCurrencyWhitelist on, if any
+
+	whitelist map[common.Address]struct{}
+	updated   time.Time
+	err       error     // Last failure to look up the whitelist, returned until it expires
+	failed    time.Time // Time of the last failure
+	lock      sync.Mutex
+}
+
+// NewPolicy creates a policy only allowing fees in the native currency, and
+// gateway fees up to DefaultMaxGatewayFee.
+func NewPolicy() *Policy {
+	return &Policy{maxGatewayFee: new(big.Int).Set(DefaultMaxGatewayFee)}
+}
+
+// SetFeeCurrencies sets the currencies, besides the native one, transactions
+// may pay their fees in, regardless of the on-chain whitelist. An empty list
+// only allows the native currency.
+func (p *Policy) SetFeeCurrencies(currencies []common.Address) {
+	p.currencies = make(map[common.Address]struct{}, len(currencies))
+	for _, currency := range currencies {
+		p.currencies[currency] = struct{}{}
+	}
+}
+
+// SetMaxGatewayFee sets the highest gateway fee transactions may pay.
+func (p *Policy) SetMaxGatewayFee(fee *big.Int) {
+	p.maxGatewayFee = new(big.Int).Set(fee)
+}
+
+// SetWhitelist configures the node used to look up the FeeCurrencyWhitelist,
+// allowing fees in the currencies whitelisted on chain unless the currencies
+// were set explicitly.
+func (p *Policy) SetWhitelist(caller ethereum.ContractCaller) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.caller = caller
+	p.whitelist, p.err = nil, nil
+}
+
+// Validate checks the Celo specific fee fields of the transaction: its fee
+// currency must be allowed, and the gateway fee must not exceed the limit.
+func (p *Policy) Validate(tx *core.SendTxArgs, messages *core.ValidationMessages) error {
+	if currency := tx.FeeCurrency; currency != nil {
+		if err := p.checkCurrency(currency.Address()); err != nil {
+			return err
+		}
+		if !currency.ValidChecksum() {
+			messages.Warn("Invalid checksum on fee currency address")
+		}
+		messages.Info(fmt.Sprintf("Transaction fees are paid in %s, the gas price is denominated in that currency", currency.Address().Hex()))
+	}
+	gatewayFee := tx.GatewayFee.ToInt()
+	if tx.GatewayFeeRecipient == nil {
+		// The gateway fee isn't charged without a recipient
+		if gatewayFee.Sign() > 0 {
+			messages.Warn("Transaction sets a gateway fee without a recipient, the fee will not be paid")
+		}
+		return nil
+	}
+	if gatewayFee.Cmp(p.maxGatewayFee) > 0 {
+		return fmt.Errorf("gateway fee %v exceeds the maximum of %v", gatewayFee, p.maxGatewayFee)
+	}
+	if !tx.GatewayFeeRecipient.ValidChecksum() {
+		messages.Warn("Invalid checksum on gateway fee recipient address")
+	}
+	if tx.GatewayFeeRecipient.Address() == (common.Address{}) {
+		messages.Crit("Gateway fee recipient is the zero address")
+	}
+	return nil
+}
+
+// checkCurrency returns an error unless fees may be paid in the currency.
+func (p *Policy) checkCurrency(currency common.Address) error {
+	allowed := p.currencies
+	if allowed == nil {
+		whitelist, err := p.lookupWhitelist()
+		if err != nil {
+			return fmt.Errorf("fee currency %s could not be verified: %v", currency.Hex(), err)
+		}
+		allowed = whitelist
+	}
+	if _, ok := allowed[currency]; !ok {
+		return fmt.Errorf("fee currency %s is not whitelisted", currency.Hex())
+	}
+	return nil
+}
+
+// lookupWhitelist returns the fee currencies whitelisted on chain, refreshing
+// them once stale. Without a node to look them up, none are whitelisted.
+// Failures are remembered for a while, so that an unreachable node doesn't
+// hold up every request.
+func (p *Policy) lookupWhitelist() (map[common.Address]struct{}, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.caller == nil {
+		return nil, nil
+	}
+	if p.whitelist != nil && time.Since(p.updated) < whitelistCacheTTL {
+		return p.whitelist, nil
+	}
+	if p.err != nil && time.Since(p.failed) < whitelistFailureTTL {
+		return nil, p.err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), whitelistTimeout)
+	defer cancel()
+
+	currencies, err := p.fetchWhitelist(ctx)
+	if err != nil {
+		p.err, p.failed = fmt.Errorf("failed to look up the fee currency whitelist: %v", err), time.Now()
+		return nil, p.err
+	}
+	whitelist := make(map[common.Address]struct{}, len(currencies))
+	for _, currency := range currencies {
+		whitelist[currency] = struct{}{}
+	}
+	p.whitelist, p.updated, p.err = whitelist, time.Now(), nil
+	return whitelist, nil
+}
+
+// fetchWhitelist reads the whitelist from the FeeCurrencyWhitelist contract
+// registered in the Registry.
+func (p *Policy) fetchWhitelist(ctx context.Context) ([]common.Address, error) {
+	input, err := abis.Registry.Pack("getAddressFor", params.FeeCurrencyWhitelistRegistryId)
+	if err != nil {
+		return nil, err
+	}
+	output, err := p.caller.CallContract(ctx, ethereum.CallMsg{To: &params.RegistrySmartContractAddress, Data: input}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var addr common.Address
+	if err := abis.Registry.Unpack(&addr, "getAddressFor", output); err != nil {
+		return nil, err
+	}
+	// Without a FeeCurrencyWhitelist deployed, no currency is whitelisted
+	if addr == (common.Address{}) {
+		return nil, nil
+	}
+	if input, err = abis.FeeCurrency.Pack("getWhitelist"); err != nil {
+		return nil, err
+	}
+	if output, err = p.caller.CallContract(ctx, ethereum.CallMsg{To: &addr, Data: input}, nil); err != nil {
+		return nil, err
+	}
+	var currencies []common.Address
+	if err := abis.FeeCurrency.Unpack(&currencies, "getWhitelist", output); err != nil {
+		return nil, err
+	}
+	return currencies, nil
+}
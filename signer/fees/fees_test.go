@@ -0,0 +1,204 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package fees
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/aaronwinter/celo-blockchain"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/params"
+	"github.com/aaronwinter/celo-blockchain/signer/core"
+)
+
+var (
+	cusd  = common.HexToAddress("0x765DE816845861e75A25fCA122bb6898B8B1282a")
+	ceur  = common.HexToAddress("0xD8763CBa276a3738E6DE85b4b3bF5FDed6D6cA73")
+	other = common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+
+	whitelistAddress = common.HexToAddress("0xBB024E9cdCB2f9E34d893630D19611B8A5381b3c")
+)
+
+// testNode is a node answering the Registry and FeeCurrencyWhitelist calls,
+// counting them.
+type testNode struct {
+	whitelist []common.Address
+	calls     int
+	err       error
+}
+
+func (n *testNode) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	n.calls++
+	if n.err != nil {
+		return nil, n.err
+	}
+	switch *call.To {
+	case params.RegistrySmartContractAddress:
+		method, err := abis.Registry.MethodById(call.Data)
+		if err != nil {
+			return nil, err
+		}
+		args, err := method.Inputs.UnpackValues(call.Data[4:])
+		if err != nil {
+			return nil, err
+		}
+		if args[0].([32]byte) != params.FeeCurrencyWhitelistRegistryId {
+			return method.Outputs.Pack(common.Address{})
+		}
+		return method.Outputs.Pack(whitelistAddress)
+	case whitelistAddress:
+		method, err := abis.FeeCurrency.MethodById(call.Data)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(n.whitelist)
+	}
+	return nil, errors.New("unexpected call")
+}
+
+func feeTx(currency *common.Address, recipient *common.Address, gatewayFee int64) *core.SendTxArgs {
+	tx := &core.SendTxArgs{GatewayFee: hexutil.Big(*big.NewInt(gatewayFee))}
+	if currency != nil {
+		addr := common.NewMixedcaseAddress(*currency)
+		tx.FeeCurrency = &addr
+	}
+	if recipient != nil {
+		addr := common.NewMixedcaseAddress(*recipient)
+		tx.GatewayFeeRecipient = &addr
+	}
+	return tx
+}
+
+func TestFeeValidation(t *testing.T) {
+	policy := NewPolicy()
+	policy.SetFeeCurrencies([]common.Address{cusd})
+	policy.SetMaxGatewayFee(big.NewInt(1000))
+
+	testcases := []struct {
+		feeCurrency, gatewayFeeRecipient *common.Address
+		gatewayFee                       int64
+		expectErr                        bool
+		numMessages                      int
+	}{
+		// Native fees
+		{numMessages: 0},
+		// Whitelisted fee currency
+		{feeCurrency: &cusd, numMessages: 1},
+		// Fee currency not whitelisted
+		{feeCurrency: &other, expectErr: true},
+		// Gateway fee within the limit
+		{gatewayFeeRecipient: &other, gatewayFee: 1000, numMessages: 0},
+		// Gateway fee over the limit
+		{gatewayFeeRecipient: &other, gatewayFee: 1001, expectErr: true},
+		// Gateway fee without recipient isn't charged
+		{gatewayFee: 5000, numMessages: 1},
+	}
+	for i, test := range testcases {
+		messages := new(core.ValidationMessages)
+		err := policy.Validate(feeTx(test.feeCurrency, test.gatewayFeeRecipient, test.gatewayFee), messages)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("Test %d, expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test %d, unexpected error: %v", i, err)
+			continue
+		}
+		if got := len(messages.Messages); got != test.numMessages {
+			for _, msg := range messages.Messages {
+				t.Logf("* %s: %s", msg.Typ, msg.Message)
+			}
+			t.Errorf("Test %d, expected %d messages, got %d", i, test.numMessages, got)
+		}
+	}
+}
+
+// Tests that without any configuration, only native fees and gateway fees up to
+// the default limit are accepted.
+func TestDefaultFeePolicy(t *testing.T) {
+	policy := NewPolicy()
+
+	if err := policy.Validate(feeTx(nil, nil, 0), new(core.ValidationMessages)); err != nil {
+		t.Errorf("native fees rejected: %v", err)
+	}
+	if err := policy.Validate(feeTx(&cusd, nil, 0), new(core.ValidationMessages)); err == nil {
+		t.Errorf("fee currency accepted without a whitelist")
+	}
+	if err := policy.Validate(feeTx(nil, &other, DefaultMaxGatewayFee.Int64()), new(core.ValidationMessages)); err != nil {
+		t.Errorf("default maximum gateway fee rejected: %v", err)
+	}
+	if err := policy.Validate(feeTx(nil, &other, DefaultMaxGatewayFee.Int64()+1), new(core.ValidationMessages)); err == nil {
+		t.Errorf("gateway fee over the default maximum accepted")
+	}
+}
+
+// Tests that the fee currencies whitelisted on chain are accepted, unless
+// overridden by the configured ones.
+func TestFeeCurrencyWhitelist(t *testing.T) {
+	node := &testNode{whitelist: []common.Address{cusd, ceur}}
+	policy := NewPolicy()
+	policy.SetWhitelist(node)
+
+	for _, currency := range []common.Address{cusd, ceur} {
+		if err := policy.Validate(feeTx(&currency, nil, 0), new(core.ValidationMessages)); err != nil {
+			t.Errorf("whitelisted fee currency %x rejected: %v", currency, err)
+		}
+	}
+	if err := policy.Validate(feeTx(&other, nil, 0), new(core.ValidationMessages)); err == nil {
+		t.Errorf("fee currency accepted while not on the whitelist")
+	}
+	// The whitelist is looked up once, and then served from the cache
+	if node.calls != 2 {
+		t.Errorf("whitelist looked up with %d calls, want 2", node.calls)
+	}
+	// Configured fee currencies override the whitelist
+	policy.SetFeeCurrencies([]common.Address{ceur})
+	if err := policy.Validate(feeTx(&cusd, nil, 0), new(core.ValidationMessages)); err == nil {
+		t.Errorf("whitelisted fee currency accepted while not configured")
+	}
+	if err := policy.Validate(feeTx(&ceur, nil, 0), new(core.ValidationMessages)); err != nil {
+		t.Errorf("configured fee currency rejected: %v", err)
+	}
+}
+
+// Tests that fee currencies are rejected if the whitelist can't be looked up,
+// and that the failure is not retried by every request.
+func TestFeeCurrencyWhitelistFailure(t *testing.T) {
+	node := &testNode{err: errors.New("connection refused")}
+	policy := NewPolicy()
+	policy.SetWhitelist(node)
+
+	for i := 0; i < 3; i++ {
+		if err := policy.Validate(feeTx(&cusd, nil, 0), new(core.ValidationMessages)); err == nil {
+			t.Errorf("attempt %d: fee currency accepted without a whitelist", i)
+		}
+	}
+	if node.calls != 1 {
+		t.Errorf("node called %d times, want 1", node.calls)
+	}
+	// Native fees don't need the whitelist
+	if err := policy.Validate(feeTx(nil, nil, 0), new(core.ValidationMessages)); err != nil {
+		t.Errorf("native fees rejected: %v", err)
+	}
+}
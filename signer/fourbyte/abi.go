@@ -51,6 +51,9 @@ func (arg decodedArgument) String() string {
 	default:
 		value = fmt.Sprintf("%v", val)
 	}
+	if arg.soltype.Name != "" {
+		return fmt.Sprintf("%v %v: %v", arg.soltype.Type.String(), arg.soltype.Name, value)
+	}
 	return fmt.Sprintf("%v: %v", arg.soltype.Type.String(), value)
 }
 
@@ -116,6 +119,16 @@ func parseSelector(unescapedSelector string) ([]byte, error) {
 // parseCallData matches the provided call data against the ABI definition and
 // returns a struct containing the actual go-typed values.
 func parseCallData(calldata []byte, unescapedAbidata string) (*decodedCallData, error) {
+	abispec, err := abi.JSON(strings.NewReader(unescapedAbidata))
+	if err != nil {
+		return nil, fmt.Errorf("invalid method signature (%q): %v", unescapedAbidata, err)
+	}
+	return decodeCallData(calldata, &abispec)
+}
+
+// decodeCallData matches the provided call data against a parsed ABI and returns
+// a struct containing the actual go-typed values.
+func decodeCallData(calldata []byte, abispec *abi.ABI) (*decodedCallData, error) {
 	// Validate the call data that it has the 4byte prefix and the rest divisible by 32 bytes
 	if len(calldata) < 4 {
 		return nil, fmt.Errorf("invalid call data, incomplete method signature (%d bytes < 4)", len(calldata))
@@ -127,10 +140,6 @@ func parseCallData(calldata []byte, unescapedAbidata string) (*decodedCallData,
 		return nil, fmt.Errorf("invalid call data; length should be a multiple of 32 bytes (was %d)", len(argdata))
 	}
 	// Validate the called method and upack the call data accordingly
-	abispec, err := abi.JSON(strings.NewReader(unescapedAbidata))
-	if err != nil {
-		return nil, fmt.Errorf("invalid method signature (%q): %v", unescapedAbidata, err)
-	}
 	method, err := abispec.MethodById(sigdata)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,221 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package fourbyte
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/aaronwinter/celo-blockchain"
+	"github.com/aaronwinter/celo-blockchain/accounts/abi"
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/params"
+	"github.com/aaronwinter/celo-blockchain/signer/core"
+)
+
+const (
+	registryCacheTTL   = 5 * time.Minute  // How long resolved core contract addresses are trusted
+	registryFailureTTL = 30 * time.Second // How long a failure to resolve them is remembered
+	registryTimeout    = 2 * time.Second  // Timeout of resolving all the core contracts
+)
+
+// coreContract is a Celo core contract whose calls are decoded with its ABI.
+type coreContract struct {
+	name string
+	id   common.Hash
+	abi  *abi.ABI
+}
+
+// coreContracts are the core contracts users commonly interact with.
+var coreContracts = []*coreContract{
+	{"Accounts", params.AccountsRegistryId, abis.Accounts},
+	{"Election", params.ElectionRegistryId, abis.Elections},
+	{"Exchange", params.ExchangeRegistryId, abis.Exchange},
+	{"ExchangeEUR", params.ExchangeEURRegistryId, abis.Exchange},
+	{"GoldToken", params.GoldTokenRegistryId, abis.GoldToken},
+	{"Governance", params.GovernanceRegistryId, abis.Governance},
+	{"LockedGold", params.LockedGoldRegistryId, abis.LockedGold},
+	{"StableToken", params.StableTokenRegistryId, abis.StableToken},
+	{"StableTokenEUR", params.StableTokenEURRegistryId, abis.StableToken},
+	{"Validators", params.ValidatorsRegistryId, abis.Validators},
+}
+
+// registry resolves the addresses of the core contracts through the Registry
+// contract of a node, caching them for a while.
+type registry struct {
+	caller ethereum.ContractCaller
+
+	contracts map[common.Address]*coreContract
+	updated   time.Time
+	err       error     // Last failure to resolve the contracts, returned until it expires
+	failed    time.Time // Time of the last failure
+	lock      sync.Mutex
+}
+
+// SetRegistry configures the node used to look up the core contracts in the
+// Registry. Without one, calls are matched against the core contract methods
+// regardless of their target.
+func (db *Database) SetRegistry(caller ethereum.ContractCaller) {
+	if caller == nil {
+		db.registry = nil
+		return
+	}
+	db.registry = &registry{caller: caller}
+}
+
+// resolve returns the core contracts keyed by their address, refreshing them
+// from the Registry once stale. Failures are remembered for a while, so that an
+// unreachable node doesn't hold up every request.
+func (r *registry) resolve() (map[common.Address]*coreContract, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.contracts != nil && time.Since(r.updated) < registryCacheTTL {
+		return r.contracts, nil
+	}
+	if r.err != nil && time.Since(r.failed) < registryFailureTTL {
+		return nil, r.err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), registryTimeout)
+	defer cancel()
+
+	contracts := make(map[common.Address]*coreContract)
+	for _, contract := range coreContracts {
+		addr, err := r.addressFor(ctx, contract.id)
+		if err != nil {
+			r.err, r.failed = fmt.Errorf("failed to resolve %s: %v", contract.name, err), time.Now()
+			return nil, r.err
+		}
+		// Contracts not deployed on the network are registered to the zero address
+		if addr != (common.Address{}) {
+			contracts[addr] = contract
+		}
+	}
+	r.contracts, r.updated, r.err = contracts, time.Now(), nil
+	return contracts, nil
+}
+
+// addressFor looks up the address registered for a contract in the Registry.
+func (r *registry) addressFor(ctx context.Context, id common.Hash) (common.Address, error) {
+	input, err := abis.Registry.Pack("getAddressFor", id)
+	if err != nil {
+		return common.Address{}, err
+	}
+	output, err := r.caller.CallContract(ctx, ethereum.CallMsg{To: &params.RegistrySmartContractAddress, Data: input}, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	var addr common.Address
+	if err := abis.Registry.Unpack(&addr, "getAddressFor", output); err != nil {
+		return common.Address{}, err
+	}
+	return addr, nil
+}
+
+// validateCoreContractCall decodes calls to the core contracts with their ABI,
+// warning about the riskier methods. It returns whether the call data has been
+// handled, the 4byte database being consulted otherwise.
+func (db *Database) validateCoreContractCall(to common.Address, data []byte, messages *core.ValidationMessages) bool {
+	if len(data) < 4 {
+		return false
+	}
+	if db.registry != nil {
+		contracts, err := db.registry.resolve()
+		if err == nil {
+			contract, ok := contracts[to]
+			if !ok {
+				return false
+			}
+			if _, err := contract.abi.MethodById(data[:4]); err != nil {
+				messages.Info(fmt.Sprintf("Transaction calls the %s core contract, with a method not known to the signer", contract.name))
+				return false
+			}
+			decoded, err := decodeCallData(data, contract.abi)
+			if err != nil {
+				messages.Warn(fmt.Sprintf("Transaction calls the %s core contract, but the call data could not be decoded: %v", contract.name, err))
+				return true
+			}
+			messages.Info(fmt.Sprintf("Transaction invokes the following %s method: %q", contract.name, decoded.String()))
+			validateCoreMethod(contract.abi, decoded, messages)
+			return true
+		}
+		log.Warn("Failed to resolve core contracts", "err", err)
+	}
+	// The target is unknown, match the call data against all core contract methods
+	var (
+		decoded *decodedCallData
+		abispec *abi.ABI
+		names   []string
+	)
+	for _, contract := range coreContracts {
+		if decoded != nil && contract.abi == abispec {
+			continue
+		}
+		if _, err := contract.abi.MethodById(data[:4]); err != nil {
+			continue
+		}
+		if call, err := decodeCallData(data, contract.abi); err == nil {
+			if decoded == nil {
+				decoded, abispec = call, contract.abi
+			}
+			names = append(names, contract.name)
+		}
+	}
+	if decoded == nil {
+		return false
+	}
+	messages.Info(fmt.Sprintf("Transaction invokes the following method: %q, as defined by %s (the target could not be verified against the Registry)", decoded.String(), strings.Join(names, ", ")))
+	validateCoreMethod(abispec, decoded, messages)
+	return true
+}
+
+// validateCoreMethod warns about core contract methods transferring control over
+// an account, or funds without a safety margin.
+func validateCoreMethod(contract *abi.ABI, call *decodedCallData, messages *core.ValidationMessages) {
+	switch {
+	case contract == abis.Accounts && strings.HasPrefix(call.name, "authorize"):
+		messages.Warn(fmt.Sprintf("Transaction authorizes %v to act on behalf of the account (%s)", call.argument("signer"), call.name))
+	case contract == abis.Accounts && call.name == "setWalletAddress":
+		messages.Warn(fmt.Sprintf("Transaction redirects the payments of the account to %v", call.argument("walletAddress")))
+	case contract == abis.Governance && call.name == "propose":
+		messages.Warn("Transaction submits a governance proposal, executing arbitrary calls if passed")
+	case contract == abis.Exchange && (call.name == "sell" || call.name == "exchange"):
+		if amount, ok := call.argument("minBuyAmount").(*big.Int); ok && amount.Sign() == 0 {
+			messages.Warn("Transaction exchanges tokens without a minimum amount to receive")
+		}
+	case contract == abis.Validators && call.name == "deregisterValidator":
+		messages.Warn("Transaction deregisters the validator")
+	case contract == abis.Validators && call.name == "updateBlsPublicKey":
+		messages.Warn("Transaction replaces the BLS key used by the validator for consensus")
+	}
+}
+
+// argument returns the value of the named argument of the call, nil if missing.
+func (cd *decodedCallData) argument(name string) interface{} {
+	for _, arg := range cd.inputs {
+		if arg.soltype.Name == name {
+			return arg.value
+		}
+	}
+	return nil
+}
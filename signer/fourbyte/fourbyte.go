@@ -27,6 +27,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+
+	"github.com/aaronwinter/celo-blockchain/signer/fees"
 )
 
 // Database is a 4byte database with the possibility of maintaining an immutable
@@ -35,6 +37,9 @@ type Database struct {
 	embedded   map[string]string
 	custom     map[string]string
 	customPath string
+
+	fees     *fees.Policy // Policy on the fee currencies and gateway fees of transactions
+	registry *registry    // Resolver of the core contract addresses, if any
 }
 
 // newEmpty exists for testing purposes.
@@ -42,6 +47,7 @@ func newEmpty() *Database {
 	return &Database{
 		embedded: make(map[string]string),
 		custom:   make(map[string]string),
+		fees:     fees.NewPolicy(),
 	}
 }
 
@@ -74,7 +80,7 @@ func NewFromFile(path string) (*Database, error) {
 // file) as well as a custom database. The latter will be used to write new
 // values into if they are submitted via the API.
 func NewWithFile(path string) (*Database, error) {
-	db := &Database{make(map[string]string), make(map[string]string), path}
+	db := newEmpty()
 	db.customPath = path
 
 	blob, err := Asset("4byte.json")
@@ -142,3 +148,9 @@ func (db *Database) AddSelector(selector string, data []byte) error {
 	}
 	return ioutil.WriteFile(db.customPath, blob, 0600)
 }
+
+// SetFeePolicy sets the policy transactions are validated against for their
+// fee currency and gateway fee.
+func (db *Database) SetFeePolicy(policy *fees.Policy) {
+	db.fees = policy
+}
@@ -36,6 +36,10 @@ func (db *Database) ValidateTransaction(selector *string, tx *core.SendTxArgs) (
 	if err := tx.CheckEthCompatibility(); err != nil {
 		return nil, err
 	}
+	// Reject fees paid in unexpected currencies or to gateways beyond the limit
+	if err := db.fees.Validate(tx, messages); err != nil {
+		return nil, err
+	}
 	// Prevent accidental erroneous usage of both 'input' and 'data' (show stopper)
 	if tx.Data != nil && tx.Input != nil && !bytes.Equal(*tx.Data, *tx.Input) {
 		return nil, errors.New(`ambiguous request: both "data" and "input" are set and are not identical`)
@@ -78,6 +82,9 @@ func (db *Database) ValidateTransaction(selector *string, tx *core.SendTxArgs) (
 		messages.Crit("Transaction recipient is the zero address")
 	}
 	// Semantic fields validated, try to make heads or tails of the call data
+	if db.validateCoreContractCall(tx.To.Address(), data, messages) {
+		return messages, nil
+	}
 	db.ValidateCallData(selector, data, messages)
 	return messages, nil
 }
@@ -17,11 +17,18 @@
 package fourbyte
 
 import (
+	"context"
+	"errors"
 	"math/big"
+	"strings"
 	"testing"
 
+	ethereum "github.com/aaronwinter/celo-blockchain"
+	"github.com/aaronwinter/celo-blockchain/accounts/abi"
 	"github.com/aaronwinter/celo-blockchain/common"
 	"github.com/aaronwinter/celo-blockchain/common/hexutil"
+	"github.com/aaronwinter/celo-blockchain/contracts/abis"
+	"github.com/aaronwinter/celo-blockchain/params"
 	"github.com/aaronwinter/celo-blockchain/signer/core"
 )
 
@@ -135,3 +142,134 @@ func TestTransactionValidation(t *testing.T) {
 		}
 	}
 }
+
+// testRegistry is a Registry contract answering getAddressFor from a map.
+type testRegistry map[common.Hash]common.Address
+
+func (r testRegistry) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	method, err := abis.Registry.MethodById(call.Data)
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.UnpackValues(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(r[args[0].([32]byte)])
+}
+
+func TestCoreContractValidation(t *testing.T) {
+	var (
+		accounts    = common.HexToAddress("0x7d21685C17607338b313a7174bAb6620baD0aaB7")
+		exchange    = common.HexToAddress("0x67316300f17f063085Ca8bCa4bd3f7a5a3C66275")
+		stableToken = common.HexToAddress("0x765DE816845861e75A25fCA122bb6898B8B1282a")
+		other       = common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	)
+	registry := testRegistry{
+		params.AccountsRegistryId:    accounts,
+		params.ExchangeRegistryId:    exchange,
+		params.StableTokenRegistryId: stableToken,
+	}
+	pack := func(contract *abi.ABI, method string, args ...interface{}) []byte {
+		data, err := contract.Pack(method, args...)
+		if err != nil {
+			t.Fatalf("failed to pack %s: %v", method, err)
+		}
+		return data
+	}
+	testcases := []struct {
+		registry ethereum.ContractCaller
+		to       common.Address
+		data     []byte
+		info     string
+		warn     bool
+	}{
+		// Resolved core contract, decoded with named arguments
+		{registry, stableToken, pack(abis.StableToken, "transferWithComment", other, big.NewInt(1), "rent"),
+			"StableToken method: \"transferWithComment(address to: 0x000000000000000000000000000000000000dEaD,uint256 value: 1,string comment: rent)\"", false},
+		// Signer authorizations are risky
+		{registry, accounts, pack(abis.Accounts, "authorizeValidatorSigner", other, uint8(27), [32]byte{}, [32]byte{}),
+			"Accounts method", true},
+		// Trades without slippage protection are risky
+		{registry, exchange, pack(abis.Exchange, "sell", big.NewInt(100), big.NewInt(0), true), "Exchange method", true},
+		{registry, exchange, pack(abis.Exchange, "sell", big.NewInt(100), big.NewInt(99), true), "Exchange method", false},
+		// Other targets are left to the 4byte database
+		{registry, other, pack(abis.StableToken, "transfer", other, big.NewInt(1)), "", true},
+		// Without a registry, the target can't be verified
+		{nil, other, pack(abis.Elections, "vote", other, big.NewInt(1), other, other), "could not be verified", false},
+	}
+	for i, test := range testcases {
+		db := newEmpty()
+		db.SetRegistry(test.registry)
+
+		to := common.NewMixedcaseAddress(test.to)
+		data := hexutil.Bytes(test.data)
+		tx := &core.SendTxArgs{To: &to, Data: &data, Value: hexutil.Big(*big.NewInt(0))}
+
+		msgs, err := db.ValidateTransaction(nil, tx)
+		if err != nil {
+			t.Errorf("Test %d, unexpected error: %v", i, err)
+			continue
+		}
+		var (
+			info string
+			warn bool
+		)
+		for _, msg := range msgs.Messages {
+			switch msg.Typ {
+			case core.INFO:
+				info = msg.Message
+			case core.WARN, core.CRIT:
+				warn = true
+			}
+		}
+		if !strings.Contains(info, test.info) {
+			t.Errorf("Test %d, expected info containing %q, got %q", i, test.info, info)
+		}
+		if warn != test.warn {
+			t.Errorf("Test %d, expected warnings %v, got %v: %v", i, test.warn, warn, msgs.Messages)
+		}
+	}
+}
+
+// unreachableRegistry is a node failing all calls, counting them.
+type unreachableRegistry struct {
+	calls int
+}
+
+func (r *unreachableRegistry) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	r.calls++
+	return nil, errors.New("connection refused")
+}
+
+// Tests that a failure to reach the node is not retried by every request.
+func TestCoreContractResolutionFailure(t *testing.T) {
+	caller := new(unreachableRegistry)
+	db := newEmpty()
+	db.SetRegistry(caller)
+
+	other := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	data, err := abis.Elections.Pack("vote", other, big.NewInt(1), other, other)
+	if err != nil {
+		t.Fatalf("failed to pack vote: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		to := common.NewMixedcaseAddress(other)
+		input := hexutil.Bytes(data)
+		tx := &core.SendTxArgs{To: &to, Data: &input, Value: hexutil.Big(*big.NewInt(0))}
+		msgs, err := db.ValidateTransaction(nil, tx)
+		if err != nil {
+			t.Fatalf("Request %d, unexpected error: %v", i, err)
+		}
+		var verified bool
+		for _, msg := range msgs.Messages {
+			verified = verified || (msg.Typ == core.INFO && !strings.Contains(msg.Message, "could not be verified"))
+		}
+		if verified {
+			t.Errorf("Request %d, target reported as verified: %v", i, msgs.Messages)
+		}
+	}
+	if caller.calls != 1 {
+		t.Errorf("Node called %d times, want 1", caller.calls)
+	}
+}
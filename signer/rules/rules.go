@@ -183,6 +183,45 @@ func (r *rulesetUI) ApproveSignData(request *core.SignDataRequest) (core.SignDat
 	return core.SignDataResponse{Approved: false}, err
 }
 
+func (r *rulesetUI) ApproveSignBLS(request *core.SignBLSRequest) (core.SignBLSResponse, error) {
+	jsonreq, err := json.Marshal(request)
+	approved, err := r.checkApproval("ApproveSignBLS", jsonreq, err)
+	if err != nil {
+		log.Info("Rule-based approval error, going to manual", "error", err)
+		return r.next.ApproveSignBLS(request)
+	}
+	if approved {
+		return core.SignBLSResponse{Approved: true}, nil
+	}
+	return core.SignBLSResponse{Approved: false}, err
+}
+
+func (r *rulesetUI) ApproveDecrypt(request *core.DecryptRequest) (core.DecryptResponse, error) {
+	jsonreq, err := json.Marshal(request)
+	approved, err := r.checkApproval("ApproveDecrypt", jsonreq, err)
+	if err != nil {
+		log.Info("Rule-based approval error, going to manual", "error", err)
+		return r.next.ApproveDecrypt(request)
+	}
+	if approved {
+		return core.DecryptResponse{Approved: true}, nil
+	}
+	return core.DecryptResponse{Approved: false}, err
+}
+
+func (r *rulesetUI) ApproveProofOfPossession(request *core.ProofOfPossessionRequest) (core.ProofOfPossessionResponse, error) {
+	jsonreq, err := json.Marshal(request)
+	approved, err := r.checkApproval("ApproveProofOfPossession", jsonreq, err)
+	if err != nil {
+		log.Info("Rule-based approval error, going to manual", "error", err)
+		return r.next.ApproveProofOfPossession(request)
+	}
+	if approved {
+		return core.ProofOfPossessionResponse{Approved: true}, nil
+	}
+	return core.ProofOfPossessionResponse{Approved: false}, err
+}
+
 // OnInputRequired not handled by rules
 func (r *rulesetUI) OnInputRequired(info core.UserInputRequest) (core.UserInputResponse, error) {
 	return r.next.OnInputRequired(info)
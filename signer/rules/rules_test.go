@@ -92,6 +92,18 @@ func (alwaysDenyUI) ApproveSignData(request *core.SignDataRequest) (core.SignDat
 	return core.SignDataResponse{Approved: false}, nil
 }
 
+func (alwaysDenyUI) ApproveSignBLS(request *core.SignBLSRequest) (core.SignBLSResponse, error) {
+	return core.SignBLSResponse{Approved: false}, nil
+}
+
+func (alwaysDenyUI) ApproveDecrypt(request *core.DecryptRequest) (core.DecryptResponse, error) {
+	return core.DecryptResponse{Approved: false}, nil
+}
+
+func (alwaysDenyUI) ApproveProofOfPossession(request *core.ProofOfPossessionRequest) (core.ProofOfPossessionResponse, error) {
+	return core.ProofOfPossessionResponse{Approved: false}, nil
+}
+
 func (alwaysDenyUI) ApproveListing(request *core.ListRequest) (core.ListResponse, error) {
 	return core.ListResponse{Accounts: nil}, nil
 }
@@ -195,6 +207,60 @@ func TestSignTxRequest(t *testing.T) {
 	}
 }
 
+func TestSignTxRequestCeloFields(t *testing.T) {
+	js := `
+	function big(str) {
+		if (str.slice(0, 2) == "0x") {
+			return new BigNumber(str.slice(2), 16)
+		}
+		return new BigNumber(str)
+	}
+	function ApproveTx(r){
+		var tx = r.transaction
+		if (tx.feeCurrency == null || tx.feeCurrency.toLowerCase() != "0x765de816845861e75a25fca122bb6898b8b1282a") {
+			return "Reject"
+		}
+		// At most 0.01 cUSD in fees, gateway fee included
+		var fee = big(tx.gas).times(big(tx.gasPrice)).plus(big(tx.gatewayFee))
+		if (fee.lte(new BigNumber("1e16"))) {
+			return "Approve"
+		}
+		return "Reject"
+	}`
+
+	r, err := initRuleEngine(js)
+	if err != nil {
+		t.Fatalf("Couldn't create evaluator %v", err)
+	}
+	cusd, _ := mixAddr("0x765DE816845861e75A25fCA122bb6898B8B1282a")
+	gateway, _ := mixAddr("0x000000000000000000000000000000000000dEaD")
+
+	tests := []struct {
+		name        string
+		feeCurrency *common.MixedcaseAddress
+		gatewayFee  int64
+		approved    bool
+	}{
+		{"native fees", nil, 0, false},
+		{"fees in cUSD", cusd, 0, true},
+		{"gateway fee within limit", cusd, 1e15, true},
+		{"gateway fee over limit", cusd, 1e16, false},
+	}
+	for _, tt := range tests {
+		req := dummyTxWithV(0)
+		req.Transaction.FeeCurrency = tt.feeCurrency
+		req.Transaction.GatewayFeeRecipient = gateway
+		req.Transaction.GatewayFee = hexutil.Big(*big.NewInt(tt.gatewayFee))
+		resp, err := r.ApproveTx(req)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+		if resp.Approved != tt.approved {
+			t.Errorf("%s: have approved %v, want %v", tt.name, resp.Approved, tt.approved)
+		}
+	}
+}
+
 type dummyUI struct {
 	calls []string
 }
@@ -218,6 +284,21 @@ func (d *dummyUI) ApproveSignData(request *core.SignDataRequest) (core.SignDataR
 	return core.SignDataResponse{}, core.ErrRequestDenied
 }
 
+func (d *dummyUI) ApproveSignBLS(request *core.SignBLSRequest) (core.SignBLSResponse, error) {
+	d.calls = append(d.calls, "ApproveSignBLS")
+	return core.SignBLSResponse{}, core.ErrRequestDenied
+}
+
+func (d *dummyUI) ApproveDecrypt(request *core.DecryptRequest) (core.DecryptResponse, error) {
+	d.calls = append(d.calls, "ApproveDecrypt")
+	return core.DecryptResponse{}, core.ErrRequestDenied
+}
+
+func (d *dummyUI) ApproveProofOfPossession(request *core.ProofOfPossessionRequest) (core.ProofOfPossessionResponse, error) {
+	d.calls = append(d.calls, "ApproveProofOfPossession")
+	return core.ProofOfPossessionResponse{}, core.ErrRequestDenied
+}
+
 func (d *dummyUI) ApproveListing(request *core.ListRequest) (core.ListResponse, error) {
 	d.calls = append(d.calls, "ApproveListing")
 	return core.ListResponse{}, core.ErrRequestDenied
@@ -523,6 +604,21 @@ func (d *dontCallMe) ApproveSignData(request *core.SignDataRequest) (core.SignDa
 	return core.SignDataResponse{}, core.ErrRequestDenied
 }
 
+func (d *dontCallMe) ApproveSignBLS(request *core.SignBLSRequest) (core.SignBLSResponse, error) {
+	d.t.Fatalf("Did not expect next-handler to be called")
+	return core.SignBLSResponse{}, core.ErrRequestDenied
+}
+
+func (d *dontCallMe) ApproveDecrypt(request *core.DecryptRequest) (core.DecryptResponse, error) {
+	d.t.Fatalf("Did not expect next-handler to be called")
+	return core.DecryptResponse{}, core.ErrRequestDenied
+}
+
+func (d *dontCallMe) ApproveProofOfPossession(request *core.ProofOfPossessionRequest) (core.ProofOfPossessionResponse, error) {
+	d.t.Fatalf("Did not expect next-handler to be called")
+	return core.ProofOfPossessionResponse{}, core.ErrRequestDenied
+}
+
 func (d *dontCallMe) ApproveListing(request *core.ListRequest) (core.ListResponse, error) {
 	d.t.Fatalf("Did not expect next-handler to be called")
 	return core.ListResponse{}, core.ErrRequestDenied
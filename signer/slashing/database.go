@@ -0,0 +1,299 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package slashing implements a slashing-protection database for validator keys
+// held by the signer. It records the highest consensus view at which each key
+// signed istanbul messages and seals, and refuses to sign anything that could
+// conflict with them, e.g. when a primary and a replica validator accidentally
+// share a key.
+package slashing
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"sync"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+	"github.com/aaronwinter/celo-blockchain/log"
+	"github.com/aaronwinter/celo-blockchain/rlp"
+)
+
+var (
+	// ErrStaleView is returned when asked to sign for a view lower than one
+	// already signed.
+	ErrStaleView = errors.New("view lower than previously signed one")
+
+	// ErrConflictingDigest is returned when asked to sign a different block at a
+	// sequence or view already signed.
+	ErrConflictingDigest = errors.New("conflicts with previously signed block")
+
+	// ErrUnexpectedSeal is returned when asked for a BLS seal which doesn't
+	// follow the PREPARE or commit seal it is bound to.
+	ErrUnexpectedSeal = errors.New("seal does not follow a matching signed message")
+
+	errInvalidView = errors.New("invalid consensus view")
+)
+
+// Record is the view and block digest of a signed consensus message or seal.
+// The digest is left empty for round changes.
+type Record struct {
+	Sequence uint64      `json:"sequence,string"`
+	Round    uint64      `json:"round,string"`
+	Digest   common.Hash `json:"digest"`
+}
+
+// Cmp compares the views of r and other, returning -1, 0 or +1.
+func (r *Record) Cmp(other *Record) int {
+	switch {
+	case r.Sequence < other.Sequence:
+		return -1
+	case r.Sequence > other.Sequence:
+		return 1
+	case r.Round < other.Round:
+		return -1
+	case r.Round > other.Round:
+		return 1
+	}
+	return 0
+}
+
+// History is the highest view signed by a validator key for each kind of
+// consensus message and seal.
+type History struct {
+	Prepare     *Record `json:"prepare,omitempty"`
+	Commit      *Record `json:"commit,omitempty"`
+	RoundChange *Record `json:"roundChange,omitempty"`
+	CommitSeal  *Record `json:"commitSeal,omitempty"`
+	EpochSeal   *Record `json:"epochSeal,omitempty"`
+}
+
+// Database keeps the signing history of validator keys, persisted to a file in
+// the interchange format if a path is given.
+type Database struct {
+	path    string
+	chainID *big.Int
+
+	lock    sync.Mutex
+	history map[common.Address]*History
+}
+
+// Open loads the slashing-protection database of the given chain from path,
+// creating an empty one if the file doesn't exist yet. An empty path keeps the
+// database in memory only.
+func Open(path string, chainID *big.Int) (*Database, error) {
+	db := &Database{
+		path:    path,
+		chainID: chainID,
+		history: make(map[common.Address]*History),
+	}
+	if path == "" {
+		return db, nil
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return db, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if err := db.Import(f); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// History returns the signing history of addr.
+func (db *Database) History(addr common.Address) History {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if h, ok := db.history[addr]; ok {
+		return *h
+	}
+	return History{}
+}
+
+// CheckMessage verifies that signing the given istanbul message payload with the
+// ECDSA key of addr can't conflict with previously signed consensus messages,
+// and records it if so. Payloads which aren't PREPARE, COMMIT or ROUND CHANGE
+// messages are not tracked.
+func (db *Database) CheckMessage(addr common.Address, payload []byte) error {
+	var msg istanbul.Message
+	if err := rlp.DecodeBytes(payload, &msg); err != nil {
+		return nil
+	}
+	var (
+		view   *istanbul.View
+		digest common.Hash
+	)
+	switch {
+	case msg.Code == istanbul.MsgPrepare && msg.Prepare() != nil:
+		view, digest = msg.Prepare().View, msg.Prepare().Digest
+	case msg.Code == istanbul.MsgCommit && msg.Commit() != nil && msg.Commit().Subject != nil:
+		view, digest = msg.Commit().Subject.View, msg.Commit().Subject.Digest
+	case msg.Code == istanbul.MsgRoundChange && msg.RoundChange() != nil:
+		view = msg.RoundChange().View
+	default:
+		return nil
+	}
+	rec, err := newRecord(view, digest)
+	if err != nil {
+		return err
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	h := db.historyOf(addr)
+	switch msg.Code {
+	case istanbul.MsgPrepare:
+		// PREPAREs for a higher round may be for another block, but never for the same view
+		if err := checkView(h.Prepare, rec, false); err != nil {
+			return err
+		}
+		h.Prepare = rec
+	case istanbul.MsgCommit:
+		if err := checkView(h.Commit, rec, true); err != nil {
+			return err
+		}
+		h.Commit = rec
+	case istanbul.MsgRoundChange:
+		// Round changes are resent for the same view, and carry no block
+		if h.RoundChange != nil && rec.Cmp(h.RoundChange) < 0 {
+			return ErrStaleView
+		}
+		h.RoundChange = rec
+	}
+	return db.save()
+}
+
+// CheckBLS verifies that signing the given message with the BLS key of addr
+// can't conflict with previously signed seals, and records it if so.
+//
+// BLS seals don't carry their sequence, so they are bound to the messages signed
+// just before them: istanbul only seals a block after having sent a PREPARE for
+// it, and signs the epoch validator set seal right after the commit seal.
+// Composite messages are tracked as epoch seals, and direct ones as commit seals
+// when they are in the committed seal format.
+func (db *Database) CheckBLS(addr common.Address, msg []byte, useComposite bool) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	h := db.historyOf(addr)
+	if useComposite {
+		if h.CommitSeal == nil {
+			return ErrUnexpectedSeal
+		}
+		rec := *h.CommitSeal
+		if h.EpochSeal != nil && rec.Cmp(h.EpochSeal) < 0 {
+			return ErrStaleView
+		}
+		h.EpochSeal = &rec
+		return db.save()
+	}
+	digest, round, ok := parseCommittedSeal(msg)
+	if !ok {
+		return nil
+	}
+	if h.Prepare == nil || h.Prepare.Digest != digest || h.Prepare.Round != round {
+		return ErrUnexpectedSeal
+	}
+	rec := *h.Prepare
+	if err := checkView(h.CommitSeal, &rec, true); err != nil {
+		return err
+	}
+	h.CommitSeal = &rec
+	return db.save()
+}
+
+// historyOf returns the signing history of addr, creating it if needed. The
+// lock must be held.
+func (db *Database) historyOf(addr common.Address) *History {
+	h, ok := db.history[addr]
+	if !ok {
+		h = new(History)
+		db.history[addr] = h
+	}
+	return h
+}
+
+// save persists the database if it is backed by a file. The lock must be held.
+func (db *Database) save() error {
+	if db.path == "" {
+		return nil
+	}
+	tmp := db.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := db.export(f); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, db.path); err != nil {
+		log.Error("Failed to save slashing-protection database", "path", db.path, "err", err)
+		return err
+	}
+	return nil
+}
+
+// newRecord converts an istanbul view to a record.
+func newRecord(view *istanbul.View, digest common.Hash) (*Record, error) {
+	if view == nil || view.Sequence == nil || view.Round == nil || !view.Sequence.IsUint64() || !view.Round.IsUint64() {
+		return nil, errInvalidView
+	}
+	return &Record{Sequence: view.Sequence.Uint64(), Round: view.Round.Uint64(), Digest: digest}, nil
+}
+
+// checkView verifies that rec may be signed after last. Signing a lower view is
+// refused, as well as signing another block at the same view, or at the same
+// sequence if perSequence is set.
+func checkView(last, rec *Record, perSequence bool) error {
+	if last == nil {
+		return nil
+	}
+	if rec.Cmp(last) < 0 {
+		return ErrStaleView
+	}
+	sameView := rec.Cmp(last) == 0
+	if (sameView || (perSequence && rec.Sequence == last.Sequence)) && rec.Digest != last.Digest {
+		return ErrConflictingDigest
+	}
+	return nil
+}
+
+// parseCommittedSeal extracts the block digest and round from a message in the
+// format of istanbul's committed seals: digest || round || MsgCommit.
+func parseCommittedSeal(msg []byte) (common.Hash, uint64, bool) {
+	if len(msg) < common.HashLength+1 || len(msg) > common.HashLength+9 || msg[len(msg)-1] != byte(istanbul.MsgCommit) {
+		return common.Hash{}, 0, false
+	}
+	roundBytes := msg[common.HashLength : len(msg)-1]
+	// Rounds are encoded as big integers, without leading zeros
+	if len(roundBytes) > 0 && roundBytes[0] == 0 {
+		return common.Hash{}, 0, false
+	}
+	round := new(big.Int).SetBytes(roundBytes)
+	return common.BytesToHash(msg[:common.HashLength]), round.Uint64(), true
+}
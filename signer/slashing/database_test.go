@@ -0,0 +1,191 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package slashing
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/consensus/istanbul"
+)
+
+var (
+	validator = common.HexToAddress("0x01")
+	digest1   = common.HexToHash("0xd1")
+	digest2   = common.HexToHash("0xd2")
+)
+
+func view(seq, round int64) *istanbul.View {
+	return &istanbul.View{Sequence: big.NewInt(seq), Round: big.NewInt(round)}
+}
+
+func payload(t *testing.T, msg *istanbul.Message) []byte {
+	data, err := msg.PayloadNoSig()
+	if err != nil {
+		t.Fatalf("failed to encode message: %v", err)
+	}
+	return data
+}
+
+func prepare(t *testing.T, seq, round int64, digest common.Hash) []byte {
+	return payload(t, istanbul.NewPrepareMessage(&istanbul.Subject{View: view(seq, round), Digest: digest}, validator))
+}
+
+func commit(t *testing.T, seq, round int64, digest common.Hash) []byte {
+	return payload(t, istanbul.NewCommitMessage(&istanbul.CommittedSubject{
+		Subject: &istanbul.Subject{View: view(seq, round), Digest: digest},
+	}, validator))
+}
+
+func roundChange(t *testing.T, seq, round int64) []byte {
+	return payload(t, istanbul.NewRoundChangeMessage(&istanbul.RoundChange{View: view(seq, round), PreparedCertificate: istanbul.EmptyPreparedCertificate()}, validator))
+}
+
+func committedSeal(digest common.Hash, round int64) []byte {
+	return append(append(digest.Bytes(), big.NewInt(round).Bytes()...), byte(istanbul.MsgCommit))
+}
+
+func TestCheckMessage(t *testing.T) {
+	db, _ := Open("", nil)
+
+	tests := []struct {
+		name    string
+		payload []byte
+		err     error
+	}{
+		{"prepare", prepare(t, 10, 0, digest1), nil},
+		{"same prepare", prepare(t, 10, 0, digest1), nil},
+		{"conflicting prepare", prepare(t, 10, 0, digest2), ErrConflictingDigest},
+		{"stale prepare", prepare(t, 9, 3, digest2), ErrStaleView},
+		{"prepare at higher round", prepare(t, 10, 1, digest2), nil},
+		{"commit", commit(t, 10, 1, digest2), nil},
+		{"commit of another block at the sequence", commit(t, 10, 2, digest1), ErrConflictingDigest},
+		{"commit at next sequence", commit(t, 11, 0, digest1), nil},
+		{"round change", roundChange(t, 11, 1), nil},
+		{"resent round change", roundChange(t, 11, 1), nil},
+		{"stale round change", roundChange(t, 11, 0), ErrStaleView},
+		{"other payload", []byte{0xde, 0xad}, nil},
+		{"preprepare", payload(t, istanbul.NewPreprepareMessage(&istanbul.Preprepare{View: view(1, 0), Proposal: istanbul.EmptyPreparedCertificate().Proposal}, validator)), nil},
+	}
+	for _, tt := range tests {
+		if err := db.CheckMessage(validator, tt.payload); err != tt.err {
+			t.Errorf("%s: have error %v, want %v", tt.name, err, tt.err)
+		}
+	}
+	want := Record{Sequence: 11, Round: 0, Digest: digest1}
+	if h := db.History(validator); *h.Commit != want {
+		t.Errorf("commit history mismatch: have %+v, want %+v", *h.Commit, want)
+	}
+	if h := db.History(common.HexToAddress("0x02")); h.Prepare != nil {
+		t.Errorf("unexpected history for other key: %+v", h)
+	}
+}
+
+func TestCheckBLS(t *testing.T) {
+	db, _ := Open("", nil)
+
+	// Seals must follow a PREPARE for the same block
+	if err := db.CheckBLS(validator, committedSeal(digest1, 0), false); err != ErrUnexpectedSeal {
+		t.Fatalf("seal without prepare: have error %v, want %v", err, ErrUnexpectedSeal)
+	}
+	if err := db.CheckBLS(validator, []byte("epoch data"), true); err != ErrUnexpectedSeal {
+		t.Fatalf("epoch seal without commit seal: have error %v, want %v", err, ErrUnexpectedSeal)
+	}
+	if err := db.CheckMessage(validator, prepare(t, 10, 0, digest1)); err != nil {
+		t.Fatalf("failed to record prepare: %v", err)
+	}
+	if err := db.CheckBLS(validator, committedSeal(digest2, 0), false); err != ErrUnexpectedSeal {
+		t.Fatalf("seal of another block: have error %v, want %v", err, ErrUnexpectedSeal)
+	}
+	if err := db.CheckBLS(validator, committedSeal(digest1, 0), false); err != nil {
+		t.Fatalf("failed to record seal: %v", err)
+	}
+	if err := db.CheckBLS(validator, []byte("epoch data"), true); err != nil {
+		t.Fatalf("failed to record epoch seal: %v", err)
+	}
+	// Sealing another block at the same sequence is refused, even at a higher round
+	if err := db.CheckMessage(validator, prepare(t, 10, 1, digest2)); err != nil {
+		t.Fatalf("failed to record prepare: %v", err)
+	}
+	if err := db.CheckBLS(validator, committedSeal(digest2, 1), false); err != ErrConflictingDigest {
+		t.Fatalf("conflicting seal: have error %v, want %v", err, ErrConflictingDigest)
+	}
+	// Other direct BLS messages are not tracked
+	if err := db.CheckBLS(validator, []byte{0x01, 0x02}, false); err != nil {
+		t.Fatalf("untracked message: have error %v", err)
+	}
+	h := db.History(validator)
+	want := Record{Sequence: 10, Round: 0, Digest: digest1}
+	if *h.CommitSeal != want || *h.EpochSeal != want {
+		t.Errorf("seal history mismatch: have %+v and %+v, want %+v", *h.CommitSeal, *h.EpochSeal, want)
+	}
+}
+
+func TestInterchange(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slashing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "slashing_protection.json")
+
+	// Signing histories are persisted across restarts
+	db, err := Open(path, big.NewInt(44787))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	if err := db.CheckMessage(validator, prepare(t, 10, 0, digest1)); err != nil {
+		t.Fatalf("failed to record prepare: %v", err)
+	}
+	if db, err = Open(path, big.NewInt(44787)); err != nil {
+		t.Fatalf("failed to reopen database: %v", err)
+	}
+	if err := db.CheckMessage(validator, prepare(t, 10, 0, digest2)); err != ErrConflictingDigest {
+		t.Fatalf("conflicting prepare after restart: have error %v, want %v", err, ErrConflictingDigest)
+	}
+
+	// Imports keep the highest views, and are bound to the chain
+	var exported bytes.Buffer
+	if err := db.Export(&exported); err != nil {
+		t.Fatalf("failed to export: %v", err)
+	}
+	if other, _ := Open("", big.NewInt(1)); other.Import(bytes.NewReader(exported.Bytes())) == nil {
+		t.Fatalf("imported history of another chain")
+	}
+	other, _ := Open("", big.NewInt(44787))
+	if err := other.CheckMessage(validator, prepare(t, 12, 0, digest2)); err != nil {
+		t.Fatalf("failed to record prepare: %v", err)
+	}
+	if err := other.CheckMessage(validator, roundChange(t, 9, 0)); err != nil {
+		t.Fatalf("failed to record round change: %v", err)
+	}
+	if err := other.Import(&exported); err != nil {
+		t.Fatalf("failed to import: %v", err)
+	}
+	h := other.History(validator)
+	if want := (Record{Sequence: 12, Digest: digest2}); *h.Prepare != want {
+		t.Errorf("prepare mismatch: have %+v, want %+v", *h.Prepare, want)
+	}
+	if want := (Record{Sequence: 9}); *h.RoundChange != want {
+		t.Errorf("round change mismatch: have %+v, want %+v", *h.RoundChange, want)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2021 The Celo Authors
+// This file is part of the celo library.
+//
+// The celo library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The celo library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the celo library. If not, see <http://www.gnu.org/licenses/>.
+
+package slashing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/aaronwinter/celo-blockchain/common"
+	"github.com/aaronwinter/celo-blockchain/common/math"
+)
+
+// InterchangeFormatVersion is the version of the interchange format written by Export.
+const InterchangeFormatVersion = "1"
+
+// Interchange is the format used to persist the database and to migrate signing
+// histories between signers. It is documented in cmd/clef/README.md.
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []InterchangeEntry  `json:"data"`
+}
+
+// InterchangeMetadata describes the origin of an interchange file.
+type InterchangeMetadata struct {
+	Version string                `json:"interchangeFormatVersion"`
+	ChainID *math.HexOrDecimal256 `json:"chainId,omitempty"`
+}
+
+// InterchangeEntry is the signing history of a validator key.
+type InterchangeEntry struct {
+	Address common.Address `json:"address"`
+	History
+}
+
+// Export writes the signing history of all keys in the interchange format.
+func (db *Database) Export(w io.Writer) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	return db.export(w)
+}
+
+func (db *Database) export(w io.Writer) error {
+	interchange := Interchange{
+		Metadata: InterchangeMetadata{Version: InterchangeFormatVersion},
+		Data:     make([]InterchangeEntry, 0, len(db.history)),
+	}
+	if db.chainID != nil {
+		interchange.Metadata.ChainID = (*math.HexOrDecimal256)(db.chainID)
+	}
+	for addr, h := range db.history {
+		interchange.Data = append(interchange.Data, InterchangeEntry{Address: addr, History: *h})
+	}
+	sort.Slice(interchange.Data, func(i, j int) bool {
+		return interchange.Data[i].Address.Hash().Big().Cmp(interchange.Data[j].Address.Hash().Big()) < 0
+	})
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(&interchange)
+}
+
+// Import merges the signing histories read from r in the interchange format into
+// the database, keeping the highest view of each kind of message and seal.
+func (db *Database) Import(r io.Reader) error {
+	var interchange Interchange
+	if err := json.NewDecoder(r).Decode(&interchange); err != nil {
+		return err
+	}
+	if interchange.Metadata.Version != InterchangeFormatVersion {
+		return fmt.Errorf("unsupported interchange format version %q", interchange.Metadata.Version)
+	}
+	if chainID := interchange.Metadata.ChainID; chainID != nil && db.chainID != nil && (*big.Int)(chainID).Cmp(db.chainID) != 0 {
+		return fmt.Errorf("interchange chain id %v does not match %v", (*big.Int)(chainID), db.chainID)
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	for _, entry := range interchange.Data {
+		h := db.historyOf(entry.Address)
+		h.Prepare = mergeRecord(h.Prepare, entry.Prepare)
+		h.Commit = mergeRecord(h.Commit, entry.Commit)
+		h.RoundChange = mergeRecord(h.RoundChange, entry.RoundChange)
+		h.CommitSeal = mergeRecord(h.CommitSeal, entry.CommitSeal)
+		h.EpochSeal = mergeRecord(h.EpochSeal, entry.EpochSeal)
+	}
+	return db.save()
+}
+
+// mergeRecord returns the record with the highest view, preferring the current
+// one if both are at the same view.
+func mergeRecord(current, imported *Record) *Record {
+	if current == nil || (imported != nil && imported.Cmp(current) > 0) {
+		return imported
+	}
+	return current
+}